@@ -1,9 +1,16 @@
 package aggregator
 
-import "sort"
+import (
+	"math"
+	"sort"
+)
 
 const minNumberOfElementsToComputeMedian = 1
 
+// weightsTolerance is the relative tolerance used when checking if the cumulated weight splits the total weight
+// in two equal halves
+const weightsTolerance = 1e-9
+
 func computeMedian(nums []float64) (float64, error) {
 	if len(nums) < minNumberOfElementsToComputeMedian {
 		return 0, ErrInvalidNumOfElementsToComputeMedian
@@ -20,3 +27,37 @@ func computeMedian(nums []float64) (float64, error) {
 
 	return (nums[mid-1] + nums[mid]) / 2, nil
 }
+
+// computeWeightedMedian returns the value where the cumulated weight of the sorted values reaches half of the total
+// weight. If the weights split exactly in two halves, the mean of the two values around the split is returned, so
+// that equal weights behave as the plain median
+func computeWeightedMedian(nums []float64, weights []float64) (float64, error) {
+	if len(nums) < minNumberOfElementsToComputeMedian || len(nums) != len(weights) {
+		return 0, ErrInvalidNumOfElementsToComputeMedian
+	}
+
+	indexes := make([]int, len(nums))
+	totalWeight := 0.0
+	for idx := range nums {
+		indexes[idx] = idx
+		totalWeight += weights[idx]
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return nums[indexes[i]] < nums[indexes[j]]
+	})
+
+	halfWeight := totalWeight / 2
+	cumulatedWeight := 0.0
+	for i, idx := range indexes {
+		cumulatedWeight += weights[idx]
+		isLast := i == len(indexes)-1
+		if !isLast && math.Abs(cumulatedWeight-halfWeight) <= weightsTolerance*totalWeight {
+			return (nums[idx] + nums[indexes[i+1]]) / 2, nil
+		}
+		if cumulatedWeight > halfWeight {
+			return nums[idx], nil
+		}
+	}
+
+	return nums[indexes[len(indexes)-1]], nil
+}
@@ -50,3 +50,44 @@ func TestComputeMedian(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestComputeWeightedMedian(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil slice should err", func(t *testing.T) {
+		t.Parallel()
+
+		median, err := aggregator.ComputeWeightedMedian(nil, nil)
+		assert.Equal(t, 0.0, median)
+		assert.Equal(t, aggregator.ErrInvalidNumOfElementsToComputeMedian, err)
+	})
+	t.Run("different lengths should err", func(t *testing.T) {
+		t.Parallel()
+
+		median, err := aggregator.ComputeWeightedMedian([]float64{1, 2}, []float64{1})
+		assert.Equal(t, 0.0, median)
+		assert.Equal(t, aggregator.ErrInvalidNumOfElementsToComputeMedian, err)
+	})
+	t.Run("equal weights should return the median", func(t *testing.T) {
+		t.Parallel()
+
+		median, err := aggregator.ComputeWeightedMedian([]float64{1.0047, 1.0045}, []float64{2, 2})
+		assert.Equal(t, 1.0046, median)
+		assert.Nil(t, err)
+
+		median, err = aggregator.ComputeWeightedMedian([]float64{1.0049, 1.0045, 1.0047}, []float64{1, 1, 1})
+		assert.Equal(t, 1.0047, median)
+		assert.Nil(t, err)
+	})
+	t.Run("heavier value should shift the median", func(t *testing.T) {
+		t.Parallel()
+
+		median, err := aggregator.ComputeWeightedMedian([]float64{1.0045, 1.0047, 1.0049}, []float64{1, 1, 3})
+		assert.Equal(t, 1.0049, median)
+		assert.Nil(t, err)
+
+		median, err = aggregator.ComputeWeightedMedian([]float64{1.0045, 1.0047, 1.0049}, []float64{4, 1, 1})
+		assert.Equal(t, 1.0045, median)
+		assert.Nil(t, err)
+	})
+}
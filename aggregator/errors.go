@@ -35,4 +35,30 @@ var (
 	ErrPairNotSupported = errors.New("pair not supported")
 	// ErrNilAuthClient signals that a nil auth client was provided
 	ErrNilAuthClient = errors.New("nil auth client")
+	// ErrInvalidWeight signals that an invalid price fetcher weight was provided
+	ErrInvalidWeight = errors.New("invalid weight")
+	// ErrInvalidMaxDeviation signals that an invalid maximum deviation value was provided
+	ErrInvalidMaxDeviation = errors.New("invalid maximum deviation")
+	// ErrInvalidMaxPriceAge signals that an invalid maximum price age was provided
+	ErrInvalidMaxPriceAge = errors.New("invalid maximum price age")
+	// ErrInvalidRetentionPeriod signals that an invalid price history retention period was provided
+	ErrInvalidRetentionPeriod = errors.New("invalid retention period")
+	// ErrInvalidWindow signals that an invalid average price window was provided
+	ErrInvalidWindow = errors.New("invalid window")
+	// ErrInvalidPricePoint signals that an invalid price point was provided
+	ErrInvalidPricePoint = errors.New("invalid price point")
+	// ErrOutOfOrderPricePoint signals that a price point older than the last stored one was provided
+	ErrOutOfOrderPricePoint = errors.New("out of order price point")
+	// ErrNoPriceHistory signals that no price points were found for the pair
+	ErrNoPriceHistory = errors.New("no price history")
+	// ErrNoPriceVolume signals that the price points of the pair have no volume
+	ErrNoPriceVolume = errors.New("no price volume")
+	// ErrPairsPriceUnavailable signals that the price of some pairs could not be computed
+	ErrPairsPriceUnavailable = errors.New("pairs price unavailable")
+	// ErrNilPriceHistory signals that a nil price history was provided
+	ErrNilPriceHistory = errors.New("nil price history")
+	// ErrMultipleAveragePriceWindows signals that both the TWAP and the VWAP windows were set for a pair
+	ErrMultipleAveragePriceWindows = errors.New("both TWAP and VWAP windows were set")
+	// ErrNilVolumeFetcher signals that a nil volume fetcher was provided
+	ErrNilVolumeFetcher = errors.New("nil volume fetcher")
 )
@@ -7,6 +7,16 @@ func ComputeMedian(nums []float64) (float64, error) {
 	return computeMedian(nums)
 }
 
+// ComputeWeightedMedian -
+func ComputeWeightedMedian(nums []float64, weights []float64) (float64, error) {
+	return computeWeightedMedian(nums, weights)
+}
+
+// SetTimeSinceHandler -
+func (pa *priceAggregator) SetTimeSinceHandler(handler func(time time.Time) time.Duration) {
+	pa.timeSinceHandler = handler
+}
+
 // SetLastNotifiedPrices -
 func (pn *priceNotifier) SetLastNotifiedPrices(lastNotifiedPrices []float64) {
 	pn.mut.Lock()
@@ -23,3 +33,16 @@ func (pn *priceNotifier) SetTimeSinceHandler(handler func(time time.Time) time.D
 func (pn *priceNotifier) LastTimeAutoSent() time.Time {
 	return pn.lastTimeAutoSent
 }
+
+// SetTimeSinceHandler -
+func (ph *priceHistory) SetTimeSinceHandler(handler func(time time.Time) time.Duration) {
+	ph.timeSinceHandler = handler
+}
+
+// NumPricePoints -
+func (ph *priceHistory) NumPricePoints(base string, quote string) int {
+	ph.mut.RLock()
+	defer ph.mut.RUnlock()
+
+	return len(ph.series[pairKey(base, quote)])
+}
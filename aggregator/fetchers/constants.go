@@ -22,6 +22,14 @@ const (
 	OkxName = "Okx"
 	// XExchangeName defines the XExchange name
 	XExchangeName = "XExchange"
+	// XExchangeOnChainName defines the XExchange fetcher that reads the prices directly from the pair contracts
+	XExchangeOnChainName = "XExchange on-chain"
+	// BinanceStreamName defines the Binance fetcher that receives the prices over WebSocket
+	BinanceStreamName = "Binance stream"
+	// KrakenStreamName defines the Kraken fetcher that receives the prices over WebSocket
+	KrakenStreamName = "Kraken stream"
+	// OkxStreamName defines the Okx fetcher that receives the prices over WebSocket
+	OkxStreamName = "Okx stream"
 	// EVMGasPriceStation defines an EVM gas station that will push gas prices as a full token pair price
 	EVMGasPriceStation = "EVM gas price station"
 )
@@ -0,0 +1,301 @@
+package fetchers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/multiversx/mx-sdk-go/aggregator"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	getReservesAndTotalSupplyFunction = "getReservesAndTotalSupply"
+	numReservesAndTotalSupplyValues   = 3
+	minRouteLength                    = 2
+	// swapPrecisionDecimals are the extra decimals of the amounts swapped along a route, so the integer division of
+	// each hop does not truncate the equivalent of one base token (e.g. a low priced token quoted in a 6 decimals token)
+	swapPrecisionDecimals = 18
+)
+
+// DEXPool defines an xExchange pair contract and the identifiers of the tokens it holds, in the contract order
+type DEXPool struct {
+	Address     string
+	FirstToken  string
+	SecondToken string
+}
+
+// DEXPriceFetcherConfig represents the config DTO used for the DEX price fetcher
+type DEXPriceFetcherConfig struct {
+	Pools []DEXPool
+	// TokensDecimals holds the number of decimals of each token identifier used in the routes
+	TokensDecimals map[string]uint32
+	// Routes holds, for each base-quote pair, the token identifiers to swap through, from the base token to the
+	// quote token. Example: "MEX-USD": {"MEX-455c57", "WEGLD-bd4d79", "USDC-c76f1f"}
+	Routes map[string][]string
+	// MinLiquidity rejects the prices of the routes with a liquidity depth, in quote units, lower than this value.
+	// 0 disables the check
+	MinLiquidity float64
+}
+
+// DEXHopInfo holds the details of a route hop, the reserves being expressed in token units
+type DEXHopInfo struct {
+	PoolAddress string
+	TokenIn     string
+	TokenOut    string
+	ReserveIn   float64
+	ReserveOut  float64
+	Price       float64
+}
+
+// DEXPriceInfo holds the price computed on a DEX route together with the route details. The liquidity depth is the
+// value, in quote units, of the out token reserve of the shallowest pool of the route
+type DEXPriceInfo struct {
+	Price          float64
+	LiquidityDepth float64
+	Hops           []*DEXHopInfo
+}
+
+type dexPool struct {
+	address     core.AddressHandler
+	firstToken  string
+	secondToken string
+}
+
+type dexPriceFetcher struct {
+	vmQueryGetter  aggregator.VmQueryGetter
+	pools          map[string]*dexPool
+	tokensDecimals map[string]uint32
+	routes         map[string][]string
+	minLiquidity   float64
+	baseFetcher
+}
+
+func newDEXPriceFetcher(vmQueryGetter aggregator.VmQueryGetter, config DEXPriceFetcherConfig) (*dexPriceFetcher, error) {
+	if config.MinLiquidity < 0 || math.IsNaN(config.MinLiquidity) {
+		return nil, fmt.Errorf("%w: %v", errInvalidMinLiquidity, config.MinLiquidity)
+	}
+
+	fetcher := &dexPriceFetcher{
+		vmQueryGetter:  vmQueryGetter,
+		pools:          make(map[string]*dexPool),
+		tokensDecimals: config.TokensDecimals,
+		routes:         config.Routes,
+		minLiquidity:   config.MinLiquidity,
+		baseFetcher:    newBaseFetcher(),
+	}
+
+	for _, pool := range config.Pools {
+		err := fetcher.addPool(pool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for pair, route := range config.Routes {
+		err := fetcher.checkRoute(route)
+		if err != nil {
+			return nil, fmt.Errorf("%w, pair %s", err, pair)
+		}
+	}
+
+	return fetcher, nil
+}
+
+func (fetcher *dexPriceFetcher) addPool(pool DEXPool) error {
+	address, err := data.NewAddressFromBech32String(pool.Address)
+	if err != nil {
+		return fmt.Errorf("%w %s: %s", errInvalidPoolAddress, pool.Address, err.Error())
+	}
+	if len(pool.FirstToken) == 0 || len(pool.SecondToken) == 0 || pool.FirstToken == pool.SecondToken {
+		return fmt.Errorf("%w %s: first token %s, second token %s", errInvalidPool, pool.Address, pool.FirstToken, pool.SecondToken)
+	}
+
+	fetcher.pools[poolKey(pool.FirstToken, pool.SecondToken)] = &dexPool{
+		address:     address,
+		firstToken:  pool.FirstToken,
+		secondToken: pool.SecondToken,
+	}
+
+	return nil
+}
+
+func (fetcher *dexPriceFetcher) checkRoute(route []string) error {
+	if len(route) < minRouteLength {
+		return fmt.Errorf("%w, route %v", errInvalidRoute, route)
+	}
+
+	for idx, token := range route {
+		_, found := fetcher.tokensDecimals[token]
+		if !found {
+			return fmt.Errorf("%w for token %s", errMissingTokenDecimals, token)
+		}
+		if idx == 0 {
+			continue
+		}
+
+		_, found = fetcher.pools[poolKey(route[idx-1], token)]
+		if !found {
+			return fmt.Errorf("%w for tokens %s and %s", errMissingPool, route[idx-1], token)
+		}
+	}
+
+	return nil
+}
+
+// FetchPrice will fetch the price by querying the pair contracts of the configured route
+func (fetcher *dexPriceFetcher) FetchPrice(ctx context.Context, base string, quote string) (float64, error) {
+	priceInfo, err := fetcher.FetchPriceInfo(ctx, base, quote)
+	if err != nil {
+		return 0, err
+	}
+
+	return priceInfo.Price, nil
+}
+
+// FetchPriceInfo will compute the price of one base token by swapping it along the configured route. Each hop is
+// computed from the pool reserves returned by a single query, so the price and the reported liquidity depth of a hop
+// are based on the same pool state
+func (fetcher *dexPriceFetcher) FetchPriceInfo(ctx context.Context, base string, quote string) (*DEXPriceInfo, error) {
+	if !fetcher.hasPair(base, quote) {
+		return nil, aggregator.ErrPairNotSupported
+	}
+
+	route, ok := fetcher.routes[fetcher.getPairKey(base, quote)]
+	if !ok {
+		return nil, errInvalidPair
+	}
+
+	priceInfo := &DEXPriceInfo{
+		Hops: make([]*DEXHopInfo, 0, len(route)-1),
+	}
+	amount := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(fetcher.tokensDecimals[route[0]]+swapPrecisionDecimals)), nil)
+	for idx := 1; idx < len(route); idx++ {
+		hop, amountOut, err := fetcher.swap(ctx, route[idx-1], route[idx], amount)
+		if err != nil {
+			return nil, err
+		}
+
+		priceInfo.Hops = append(priceInfo.Hops, hop)
+		amount = amountOut
+	}
+
+	priceInfo.Price = fetcher.toScaledUnits(amount, route[len(route)-1])
+	priceInfo.LiquidityDepth = computeLiquidityDepth(priceInfo.Hops)
+	if priceInfo.LiquidityDepth < fetcher.minLiquidity {
+		return nil, fmt.Errorf("%w for %s-%s: depth %v, minimum %v", errInsufficientLiquidity,
+			base, quote, priceInfo.LiquidityDepth, fetcher.minLiquidity)
+	}
+
+	return priceInfo, nil
+}
+
+// swap computes the equivalent of the provided amount the same way the getEquivalent view of the pair contract does
+// (amountIn * reserveOut / reserveIn), but on the reserves already fetched for the hop, instead of issuing a second
+// query that might observe a different pool state. The amounts are scaled by swapPrecisionDecimals
+func (fetcher *dexPriceFetcher) swap(ctx context.Context, tokenIn string, tokenOut string, amountIn *big.Int) (*DEXHopInfo, *big.Int, error) {
+	pool := fetcher.pools[poolKey(tokenIn, tokenOut)]
+
+	reserveIn, reserveOut, err := fetcher.getReserves(ctx, pool, tokenIn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	amountOut := big.NewInt(0).Mul(amountIn, reserveOut)
+	amountOut.Quo(amountOut, reserveIn)
+	if amountOut.Sign() == 0 {
+		return nil, nil, fmt.Errorf("%w: zero equivalent of %s in %s", errInvalidResponseData, tokenIn, tokenOut)
+	}
+
+	hop := &DEXHopInfo{
+		PoolAddress: addressAsBech32(pool.address),
+		TokenIn:     tokenIn,
+		TokenOut:    tokenOut,
+		ReserveIn:   fetcher.toUnits(reserveIn, tokenIn),
+		ReserveOut:  fetcher.toUnits(reserveOut, tokenOut),
+	}
+	hop.Price = fetcher.toScaledUnits(amountOut, tokenOut) / fetcher.toScaledUnits(amountIn, tokenIn)
+
+	return hop, amountOut, nil
+}
+
+// getReserves returns the pool reserves, ordered as the in and the out tokens of the swap
+func (fetcher *dexPriceFetcher) getReserves(ctx context.Context, pool *dexPool, tokenIn string) (*big.Int, *big.Int, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(pool.address).
+		Function(getReservesAndTotalSupplyFunction)
+	response, err := fetcher.vmQueryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(response) != numReservesAndTotalSupplyValues {
+		return nil, nil, fmt.Errorf("%w: %s returned %d values", errInvalidResponseData,
+			getReservesAndTotalSupplyFunction, len(response))
+	}
+
+	firstReserve := big.NewInt(0).SetBytes(response[0])
+	secondReserve := big.NewInt(0).SetBytes(response[1])
+	if firstReserve.Sign() == 0 || secondReserve.Sign() == 0 {
+		return nil, nil, fmt.Errorf("%w: empty reserves in pool %s", errInsufficientLiquidity, addressAsBech32(pool.address))
+	}
+
+	if tokenIn == pool.firstToken {
+		return firstReserve, secondReserve, nil
+	}
+
+	return secondReserve, firstReserve, nil
+}
+
+func (fetcher *dexPriceFetcher) toUnits(value *big.Int, token string) float64 {
+	return toUnitsWithDecimals(value, fetcher.tokensDecimals[token])
+}
+
+func (fetcher *dexPriceFetcher) toScaledUnits(value *big.Int, token string) float64 {
+	return toUnitsWithDecimals(value, fetcher.tokensDecimals[token]+swapPrecisionDecimals)
+}
+
+func toUnitsWithDecimals(value *big.Int, decimals uint32) float64 {
+	denomination := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	units, _ := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(value), big.NewFloat(0).SetInt(denomination)).Float64()
+
+	return units
+}
+
+// computeLiquidityDepth values the out token reserve of each hop in quote units, using the prices of the following
+// hops, and returns the smallest one
+func computeLiquidityDepth(hops []*DEXHopInfo) float64 {
+	depth := math.Inf(1)
+	priceInQuote := 1.0
+	for idx := len(hops) - 1; idx >= 0; idx-- {
+		depth = math.Min(depth, hops[idx].ReserveOut*priceInQuote)
+		priceInQuote *= hops[idx].Price
+	}
+
+	return depth
+}
+
+func poolKey(firstToken string, secondToken string) string {
+	if firstToken > secondToken {
+		firstToken, secondToken = secondToken, firstToken
+	}
+
+	return fmt.Sprintf("%s|%s", firstToken, secondToken)
+}
+
+func addressAsBech32(address core.AddressHandler) string {
+	bech32Address, _ := address.AddressAsBech32String()
+
+	return bech32Address
+}
+
+// Name returns the name
+func (fetcher *dexPriceFetcher) Name() string {
+	return XExchangeOnChainName
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fetcher *dexPriceFetcher) IsInterfaceNil() bool {
+	return fetcher == nil
+}
@@ -0,0 +1,278 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/aggregator"
+	"github.com/multiversx/mx-sdk-go/aggregator/mock"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ DEXPriceFetcher = (*dexPriceFetcher)(nil)
+
+const (
+	mexWegldPoolAddress  = "erd1lta2vgd0tkeqqadkvgef73y0efs6n3xe5ss589ufhvmt6tcur8kq34qkwr"
+	wegldUsdcPoolAddress = "erd1p5jgz605m47fq5mlqklpcjth9hdl3au53dg8a5tlkgegfnep3d7stdk09x"
+	mexToken             = "MEX-455c57"
+	wegldToken           = "WEGLD-bd4d79"
+	usdcToken            = "USDC-c76f1f"
+)
+
+func createMockDEXConfig() DEXPriceFetcherConfig {
+	return DEXPriceFetcherConfig{
+		Pools: []DEXPool{
+			{Address: mexWegldPoolAddress, FirstToken: mexToken, SecondToken: wegldToken},
+			{Address: wegldUsdcPoolAddress, FirstToken: wegldToken, SecondToken: usdcToken},
+		},
+		TokensDecimals: map[string]uint32{
+			mexToken:   18,
+			wegldToken: 18,
+			usdcToken:  6,
+		},
+		Routes: map[string][]string{
+			"MEX-USD":  {mexToken, wegldToken, usdcToken},
+			"USD-EGLD": {usdcToken, wegldToken},
+		},
+	}
+}
+
+func toDenominated(units int64, decimals int64) *big.Int {
+	denomination := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(decimals), nil)
+	return big.NewInt(0).Mul(big.NewInt(units), denomination)
+}
+
+// createPoolsVmQueryGetter returns a VM query getter for the pools: 100M MEX - 50 WEGLD and 1000 WEGLD - 40000 USDC
+func createPoolsVmQueryGetter(tb testing.TB) *mock.VmQueryGetterStub {
+	reserves := map[string][]*big.Int{
+		mexWegldPoolAddress:  {toDenominated(100000000, 18), toDenominated(50, 18)},
+		wegldUsdcPoolAddress: {toDenominated(1000, 18), toDenominated(40000, 6)},
+	}
+	return &mock.VmQueryGetterStub{
+		ExecuteQueryFromBuilderCalled: func(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
+			request, err := builder.ToVmValueRequest()
+			require.Nil(tb, err)
+
+			if request.FuncName != getReservesAndTotalSupplyFunction {
+				return nil, errors.New("unknown function")
+			}
+
+			poolReserves := reserves[request.Address]
+			return [][]byte{poolReserves[0].Bytes(), poolReserves[1].Bytes(), big.NewInt(1).Bytes()}, nil
+		},
+	}
+}
+
+func TestNewDEXPriceFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid min liquidity should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createMockDEXConfig()
+		config.MinLiquidity = -1
+		fetcher, err := newDEXPriceFetcher(&mock.VmQueryGetterStub{}, config)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidMinLiquidity))
+	})
+	t.Run("invalid pool address should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createMockDEXConfig()
+		config.Pools[0].Address = "invalid"
+		fetcher, err := newDEXPriceFetcher(&mock.VmQueryGetterStub{}, config)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidPoolAddress))
+	})
+	t.Run("invalid pool tokens should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createMockDEXConfig()
+		config.Pools[0].SecondToken = mexToken
+		fetcher, err := newDEXPriceFetcher(&mock.VmQueryGetterStub{}, config)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidPool))
+	})
+	t.Run("too short route should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createMockDEXConfig()
+		config.Routes["EGLD-USD"] = []string{wegldToken}
+		fetcher, err := newDEXPriceFetcher(&mock.VmQueryGetterStub{}, config)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidRoute))
+	})
+	t.Run("missing token decimals should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createMockDEXConfig()
+		delete(config.TokensDecimals, usdcToken)
+		fetcher, err := newDEXPriceFetcher(&mock.VmQueryGetterStub{}, config)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errMissingTokenDecimals))
+	})
+	t.Run("missing pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createMockDEXConfig()
+		config.Routes["MEX-USD"] = []string{mexToken, usdcToken}
+		fetcher, err := newDEXPriceFetcher(&mock.VmQueryGetterStub{}, config)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errMissingPool))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := newDEXPriceFetcher(&mock.VmQueryGetterStub{}, createMockDEXConfig())
+		assert.False(t, check.IfNil(fetcher))
+		assert.Nil(t, err)
+		assert.Equal(t, XExchangeOnChainName, fetcher.Name())
+	})
+}
+
+func TestDEXPriceFetcher_FetchPriceInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pair not added should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := newDEXPriceFetcher(createPoolsVmQueryGetter(t), createMockDEXConfig())
+		price, err := fetcher.FetchPrice(context.Background(), "MEX", "USD")
+		assert.Equal(t, aggregator.ErrPairNotSupported, err)
+		assert.Equal(t, 0.0, price)
+	})
+	t.Run("pair without route should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := newDEXPriceFetcher(createPoolsVmQueryGetter(t), createMockDEXConfig())
+		fetcher.AddPair("BTC", "USD")
+		priceInfo, err := fetcher.FetchPriceInfo(context.Background(), "BTC", "USD")
+		assert.Equal(t, errInvalidPair, err)
+		assert.Nil(t, priceInfo)
+	})
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		fetcher, _ := newDEXPriceFetcher(&mock.VmQueryGetterStub{
+			ExecuteQueryFromBuilderCalled: func(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}, createMockDEXConfig())
+		fetcher.AddPair("MEX", "USD")
+		priceInfo, err := fetcher.FetchPriceInfo(context.Background(), "MEX", "USD")
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, priceInfo)
+	})
+	t.Run("invalid reserves response should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := newDEXPriceFetcher(&mock.VmQueryGetterStub{
+			ExecuteQueryFromBuilderCalled: func(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
+				return [][]byte{{1}}, nil
+			},
+		}, createMockDEXConfig())
+		fetcher.AddPair("MEX", "USD")
+		priceInfo, err := fetcher.FetchPriceInfo(context.Background(), "MEX", "USD")
+		assert.True(t, errors.Is(err, errInvalidResponseData))
+		assert.Nil(t, priceInfo)
+	})
+	t.Run("multi hop route should work", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := newDEXPriceFetcher(createPoolsVmQueryGetter(t), createMockDEXConfig())
+		fetcher.AddPair("MEX", "USD")
+		priceInfo, err := fetcher.FetchPriceInfo(context.Background(), "MEX", "USD")
+		require.Nil(t, err)
+
+		assert.InDelta(t, 0.00002, priceInfo.Price, 1e-12)
+		assert.InDelta(t, 2000, priceInfo.LiquidityDepth, 1e-6)
+		require.Len(t, priceInfo.Hops, 2)
+		assert.Equal(t, &DEXHopInfo{
+			PoolAddress: mexWegldPoolAddress,
+			TokenIn:     mexToken,
+			TokenOut:    wegldToken,
+			ReserveIn:   100000000,
+			ReserveOut:  50,
+			Price:       0.0000005,
+		}, priceInfo.Hops[0])
+		assert.InDelta(t, 40, priceInfo.Hops[1].Price, 1e-9)
+
+		price, err := fetcher.FetchPrice(context.Background(), "MEX", "USD")
+		assert.Nil(t, err)
+		assert.Equal(t, priceInfo.Price, price)
+	})
+	t.Run("low price quoted in a token with few decimals should work", func(t *testing.T) {
+		t.Parallel()
+
+		lowPriceToken := "LOW-123456"
+		lowUsdcPoolAddress := mexWegldPoolAddress
+		config := DEXPriceFetcherConfig{
+			Pools:          []DEXPool{{Address: lowUsdcPoolAddress, FirstToken: lowPriceToken, SecondToken: usdcToken}},
+			TokensDecimals: map[string]uint32{lowPriceToken: 18, usdcToken: 6},
+			Routes:         map[string][]string{"LOW-USD": {lowPriceToken, usdcToken}},
+		}
+		// 1000B LOW - 10 USDC: one LOW is worth 0.00000000001 USDC, less than the smallest USDC denomination
+		vmQueryGetter := &mock.VmQueryGetterStub{
+			ExecuteQueryFromBuilderCalled: func(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
+				return [][]byte{toDenominated(1000000000000, 18).Bytes(), toDenominated(10, 6).Bytes(), big.NewInt(1).Bytes()}, nil
+			},
+		}
+		fetcher, _ := newDEXPriceFetcher(vmQueryGetter, config)
+		fetcher.AddPair("LOW", "USD")
+		priceInfo, err := fetcher.FetchPriceInfo(context.Background(), "LOW", "USD")
+		require.Nil(t, err)
+
+		assert.InDelta(t, 0.00000000001, priceInfo.Price, 1e-20)
+		assert.InDelta(t, 0.00000000001, priceInfo.Hops[0].Price, 1e-20)
+		assert.InDelta(t, 10, priceInfo.LiquidityDepth, 1e-9)
+	})
+	t.Run("should query each pool of the route once", func(t *testing.T) {
+		t.Parallel()
+
+		queriedPools := make([]string, 0)
+		vmQueryGetter := createPoolsVmQueryGetter(t)
+		executeQuery := vmQueryGetter.ExecuteQueryFromBuilderCalled
+		vmQueryGetter.ExecuteQueryFromBuilderCalled = func(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
+			request, _ := builder.ToVmValueRequest()
+			queriedPools = append(queriedPools, request.Address)
+
+			return executeQuery(ctx, builder)
+		}
+		fetcher, _ := newDEXPriceFetcher(vmQueryGetter, createMockDEXConfig())
+		fetcher.AddPair("MEX", "USD")
+		_, err := fetcher.FetchPriceInfo(context.Background(), "MEX", "USD")
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{mexWegldPoolAddress, wegldUsdcPoolAddress}, queriedPools)
+	})
+	t.Run("route against the pool order should work", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := newDEXPriceFetcher(createPoolsVmQueryGetter(t), createMockDEXConfig())
+		fetcher.AddPair("USD", "EGLD")
+		priceInfo, err := fetcher.FetchPriceInfo(context.Background(), "USD", "EGLD")
+		require.Nil(t, err)
+
+		assert.InDelta(t, 0.025, priceInfo.Price, 1e-12)
+		assert.InDelta(t, 1000, priceInfo.LiquidityDepth, 1e-9)
+		assert.Equal(t, 40000.0, priceInfo.Hops[0].ReserveIn)
+		assert.Equal(t, 1000.0, priceInfo.Hops[0].ReserveOut)
+	})
+	t.Run("insufficient liquidity should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createMockDEXConfig()
+		config.MinLiquidity = 2500
+		fetcher, _ := newDEXPriceFetcher(createPoolsVmQueryGetter(t), config)
+		fetcher.AddPair("MEX", "USD")
+		priceInfo, err := fetcher.FetchPriceInfo(context.Background(), "MEX", "USD")
+		assert.True(t, errors.Is(err, errInsufficientLiquidity))
+		assert.Nil(t, priceInfo)
+	})
+}
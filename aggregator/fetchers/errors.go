@@ -11,4 +11,17 @@ var (
 	errInvalidPair             = errors.New("invalid pair")
 	errInvalidGraphqlResponse  = errors.New("invalid graphql response")
 	errInvalidGasPriceSelector = errors.New("invalid gas price selector")
+	errNilVmQueryGetter        = errors.New("nil vm query getter")
+	errInvalidPoolAddress      = errors.New("invalid pool address")
+	errInvalidPool             = errors.New("invalid pool")
+	errInvalidRoute            = errors.New("invalid route")
+	errMissingPool             = errors.New("missing pool")
+	errMissingTokenDecimals    = errors.New("missing token decimals")
+	errInsufficientLiquidity   = errors.New("insufficient liquidity")
+	errInvalidMinLiquidity     = errors.New("invalid minimum liquidity")
+	errInvalidRetryInterval    = errors.New("invalid retry interval")
+	errInvalidReadTimeout      = errors.New("invalid read timeout")
+	errInvalidMaxPriceAge      = errors.New("invalid max price age")
+	errFetcherClosed           = errors.New("fetcher closed")
+	errNoStreamedPrice         = errors.New("no recent streamed price")
 )
@@ -3,6 +3,7 @@ package fetchers
 import (
 	"fmt"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-sdk-go/aggregator"
 )
 
@@ -19,6 +20,8 @@ type ArgsPriceFetcher struct {
 	GraphqlGetter      aggregator.GraphqlGetter
 	XExchangeTokensMap map[string]XExchangeTokensPair
 	EVMGasConfig       EVMGasPriceFetcherConfig
+	VmQueryGetter      aggregator.VmQueryGetter
+	DEXConfig          DEXPriceFetcherConfig
 }
 
 // NewPriceFetcher returns a new price fetcher of the type provided
@@ -32,6 +35,9 @@ func NewPriceFetcher(args ArgsPriceFetcher) (aggregator.PriceFetcher, error) {
 	if args.XExchangeTokensMap == nil && args.FetcherName == XExchangeName {
 		return nil, errNilXExchangeTokensMap
 	}
+	if check.IfNil(args.VmQueryGetter) && args.FetcherName == XExchangeOnChainName {
+		return nil, errNilVmQueryGetter
+	}
 
 	return createFetcher(args)
 }
@@ -84,6 +90,12 @@ func createFetcher(args ArgsPriceFetcher) (aggregator.PriceFetcher, error) {
 			baseFetcher:        newBaseFetcher(),
 			xExchangeTokensMap: args.XExchangeTokensMap,
 		}, nil
+	case XExchangeOnChainName:
+		fetcher, err := newDEXPriceFetcher(args.VmQueryGetter, args.DEXConfig)
+		if err != nil {
+			return nil, err
+		}
+		return fetcher, nil
 	case EVMGasPriceStation:
 		return &evmGasPriceFetcher{
 			ResponseGetter: args.ResponseGetter,
@@ -17,6 +17,8 @@ func createMockArgsPriceFetcher() ArgsPriceFetcher {
 		GraphqlGetter:      &mock.GraphqlResponseGetterStub{},
 		XExchangeTokensMap: createMockMap(),
 		EVMGasConfig:       EVMGasPriceFetcherConfig{},
+		VmQueryGetter:      &mock.VmQueryGetterStub{},
+		DEXConfig:          createMockDEXConfig(),
 	}
 }
 
@@ -62,6 +64,26 @@ func TestNewPriceFetcher(t *testing.T) {
 		assert.Nil(t, pf)
 		assert.True(t, errors.Is(err, errNilXExchangeTokensMap))
 	})
+	t.Run("nil vm query getter for xExchange on-chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceFetcher()
+		args.FetcherName = XExchangeOnChainName
+		args.VmQueryGetter = nil
+		pf, err := NewPriceFetcher(args)
+		assert.Nil(t, pf)
+		assert.Equal(t, errNilVmQueryGetter, err)
+	})
+	t.Run("invalid DEX config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceFetcher()
+		args.FetcherName = XExchangeOnChainName
+		args.DEXConfig.MinLiquidity = -1
+		pf, err := NewPriceFetcher(args)
+		assert.Nil(t, pf)
+		assert.True(t, errors.Is(err, errInvalidMinLiquidity))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -146,6 +168,15 @@ func TestNewPriceFetcher(t *testing.T) {
 			assert.Equal(t, "*fetchers.xExchange", fmt.Sprintf("%T", pf))
 			assert.Nil(t, err)
 		})
+		t.Run("xExchange on-chain", func(t *testing.T) {
+			t.Parallel()
+
+			args := createMockArgsPriceFetcher()
+			args.FetcherName = XExchangeOnChainName
+			pf, err := NewPriceFetcher(args)
+			assert.Equal(t, "*fetchers.dexPriceFetcher", fmt.Sprintf("%T", pf))
+			assert.Nil(t, err)
+		})
 		t.Run("EVM gas price", func(t *testing.T) {
 			t.Parallel()
 
@@ -0,0 +1,21 @@
+package fetchers
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-sdk-go/aggregator"
+)
+
+// DEXPriceFetcher defines the behavior of a price fetcher able to report the route and the liquidity details of the
+// prices computed on a DEX
+type DEXPriceFetcher interface {
+	aggregator.PriceFetcher
+	FetchPriceInfo(ctx context.Context, base string, quote string) (*DEXPriceInfo, error)
+}
+
+// StreamingPriceFetcher defines the behavior of a price fetcher that receives the prices over a WebSocket connection
+type StreamingPriceFetcher interface {
+	aggregator.TimestampedPriceFetcher
+	Start() error
+	Close() error
+}
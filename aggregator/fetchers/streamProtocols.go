@@ -0,0 +1,278 @@
+package fetchers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	binanceStreamUrl = "wss://stream.binance.com:9443/ws"
+	krakenStreamUrl  = "wss://ws.kraken.com/v2"
+	okxStreamUrl     = "wss://ws.okx.com:8443/ws/v5/public"
+
+	binanceTickerEvent = "24hrTicker"
+	krakenTickerEvent  = "ticker"
+	okxTickerEvent     = "tickers"
+	okxPingMessage     = "ping"
+)
+
+// streamedTicker is a price update parsed from an exchange WebSocket message. A zero timestamp means that the
+// exchange did not provide the update time
+type streamedTicker struct {
+	symbol    string
+	price     float64
+	timestamp time.Time
+}
+
+// streamProtocol holds the exchange specific WebSocket messages formats
+type streamProtocol interface {
+	// exchangeName returns the name of the exchange, used to normalize the quote names as its REST fetcher does
+	exchangeName() string
+	defaultURL() string
+	symbol(base string, quote string) string
+	subscribeMessage(symbols []string) ([]byte, error)
+	// parseMessage returns the tickers from the message, or none if the message is not a ticker update
+	parseMessage(buff []byte) ([]*streamedTicker, error)
+	// pingMessage returns the application level keep alive message, if the exchange requires one
+	pingMessage() []byte
+}
+
+func createStreamProtocol(fetcherName string) (streamProtocol, error) {
+	switch fetcherName {
+	case BinanceStreamName:
+		return &binanceStreamProtocol{}, nil
+	case KrakenStreamName:
+		return &krakenStreamProtocol{}, nil
+	case OkxStreamName:
+		return &okxStreamProtocol{}, nil
+	}
+
+	return nil, fmt.Errorf("%w, fetcherName %s", errInvalidFetcherName, fetcherName)
+}
+
+type binanceStreamProtocol struct{}
+
+type binanceSubscription struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+	ID     uint64   `json:"id"`
+}
+
+type binanceTicker struct {
+	Event     string `json:"e"`
+	EventTime int64  `json:"E"`
+	Symbol    string `json:"s"`
+	Price     string `json:"c"`
+}
+
+func (protocol *binanceStreamProtocol) exchangeName() string {
+	return BinanceName
+}
+
+func (protocol *binanceStreamProtocol) defaultURL() string {
+	return binanceStreamUrl
+}
+
+func (protocol *binanceStreamProtocol) symbol(base string, quote string) string {
+	return base + quote
+}
+
+func (protocol *binanceStreamProtocol) subscribeMessage(symbols []string) ([]byte, error) {
+	params := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		params = append(params, strings.ToLower(symbol)+"@ticker")
+	}
+
+	return json.Marshal(&binanceSubscription{
+		Method: "SUBSCRIBE",
+		Params: params,
+		ID:     uint64(time.Now().UnixNano()),
+	})
+}
+
+func (protocol *binanceStreamProtocol) parseMessage(buff []byte) ([]*streamedTicker, error) {
+	ticker := &binanceTicker{}
+	err := json.Unmarshal(buff, ticker)
+	if err != nil {
+		return nil, err
+	}
+	if ticker.Event != binanceTickerEvent {
+		return nil, nil
+	}
+
+	price, err := StrToPositiveFloat64(ticker.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*streamedTicker{{
+		symbol:    ticker.Symbol,
+		price:     price,
+		timestamp: time.UnixMilli(ticker.EventTime),
+	}}, nil
+}
+
+func (protocol *binanceStreamProtocol) pingMessage() []byte {
+	return nil
+}
+
+type krakenStreamProtocol struct{}
+
+type krakenSubscription struct {
+	Method string                   `json:"method"`
+	Params krakenSubscriptionParams `json:"params"`
+}
+
+type krakenSubscriptionParams struct {
+	Channel string   `json:"channel"`
+	Symbol  []string `json:"symbol"`
+}
+
+type krakenTickerMessage struct {
+	Channel string `json:"channel"`
+	Data    []struct {
+		Symbol string  `json:"symbol"`
+		Last   float64 `json:"last"`
+	} `json:"data"`
+}
+
+func (protocol *krakenStreamProtocol) exchangeName() string {
+	return KrakenName
+}
+
+func (protocol *krakenStreamProtocol) defaultURL() string {
+	return krakenStreamUrl
+}
+
+func (protocol *krakenStreamProtocol) symbol(base string, quote string) string {
+	return base + "/" + quote
+}
+
+func (protocol *krakenStreamProtocol) subscribeMessage(symbols []string) ([]byte, error) {
+	return json.Marshal(&krakenSubscription{
+		Method: "subscribe",
+		Params: krakenSubscriptionParams{
+			Channel: krakenTickerEvent,
+			Symbol:  symbols,
+		},
+	})
+}
+
+func (protocol *krakenStreamProtocol) parseMessage(buff []byte) ([]*streamedTicker, error) {
+	message := &krakenTickerMessage{}
+	err := json.Unmarshal(buff, message)
+	if err != nil {
+		return nil, err
+	}
+	if message.Channel != krakenTickerEvent {
+		return nil, nil
+	}
+
+	tickers := make([]*streamedTicker, 0, len(message.Data))
+	for _, ticker := range message.Data {
+		if ticker.Last <= 0 {
+			return nil, errInvalidResponseData
+		}
+
+		tickers = append(tickers, &streamedTicker{
+			symbol: ticker.Symbol,
+			price:  ticker.Last,
+		})
+	}
+
+	return tickers, nil
+}
+
+func (protocol *krakenStreamProtocol) pingMessage() []byte {
+	return nil
+}
+
+type okxStreamProtocol struct{}
+
+type okxSubscription struct {
+	Op   string               `json:"op"`
+	Args []okxSubscriptionArg `json:"args"`
+}
+
+type okxSubscriptionArg struct {
+	Channel string `json:"channel"`
+	InstID  string `json:"instId"`
+}
+
+type okxTickerMessage struct {
+	Arg  okxSubscriptionArg `json:"arg"`
+	Data []struct {
+		InstID    string `json:"instId"`
+		Last      string `json:"last"`
+		Timestamp string `json:"ts"`
+	} `json:"data"`
+}
+
+func (protocol *okxStreamProtocol) exchangeName() string {
+	return OkxName
+}
+
+func (protocol *okxStreamProtocol) defaultURL() string {
+	return okxStreamUrl
+}
+
+func (protocol *okxStreamProtocol) symbol(base string, quote string) string {
+	return base + "-" + quote
+}
+
+func (protocol *okxStreamProtocol) subscribeMessage(symbols []string) ([]byte, error) {
+	args := make([]okxSubscriptionArg, 0, len(symbols))
+	for _, symbol := range symbols {
+		args = append(args, okxSubscriptionArg{
+			Channel: okxTickerEvent,
+			InstID:  symbol,
+		})
+	}
+
+	return json.Marshal(&okxSubscription{
+		Op:   "subscribe",
+		Args: args,
+	})
+}
+
+func (protocol *okxStreamProtocol) parseMessage(buff []byte) ([]*streamedTicker, error) {
+	if string(buff) == "pong" {
+		return nil, nil
+	}
+
+	message := &okxTickerMessage{}
+	err := json.Unmarshal(buff, message)
+	if err != nil {
+		return nil, err
+	}
+	if message.Arg.Channel != okxTickerEvent || len(message.Data) == 0 {
+		return nil, nil
+	}
+
+	tickers := make([]*streamedTicker, 0, len(message.Data))
+	for _, ticker := range message.Data {
+		price, errConvert := StrToPositiveFloat64(ticker.Last)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		timestampMs, errConvert := strconv.ParseInt(ticker.Timestamp, 10, 64)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		tickers = append(tickers, &streamedTicker{
+			symbol:    ticker.InstID,
+			price:     price,
+			timestamp: time.UnixMilli(timestampMs),
+		})
+	}
+
+	return tickers, nil
+}
+
+func (protocol *okxStreamProtocol) pingMessage() []byte {
+	return []byte(okxPingMessage)
+}
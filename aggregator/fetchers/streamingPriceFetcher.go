@@ -0,0 +1,425 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/aggregator"
+)
+
+const (
+	minStreamRetryInterval   = time.Millisecond
+	defaultStreamReadTimeout = time.Minute
+	defaultStreamMaxPriceAge = time.Minute * 5
+	// streamPingsPerReadTimeout is the number of pings sent during a read timeout, so that a lost pong does not
+	// close a healthy connection
+	streamPingsPerReadTimeout = 3
+	streamWriteTimeout        = time.Second * 10
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/aggregator/fetchers")
+
+// ArgsStreamingPriceFetcher represents the arguments for the NewStreamingPriceFetcher function
+type ArgsStreamingPriceFetcher struct {
+	FetcherName string
+	// URL overrides the default WebSocket endpoint of the exchange
+	URL string
+	// MinRetryInterval is the delay before the first reconnection attempt. The delay doubles after each failed
+	// attempt, up to MaxRetryInterval, and it is reset once a connection is established
+	MinRetryInterval time.Duration
+	MaxRetryInterval time.Duration
+	// ReadTimeout is optional, defaultStreamReadTimeout being used if not provided. The connection is considered
+	// lost and it is reopened if no message, ping or pong is received for this duration
+	ReadTimeout time.Duration
+	// MaxPriceAge is optional, defaultStreamMaxPriceAge being used if not provided. The streamed prices older than
+	// this are not returned, so that the last price received before losing the connection is not served for long
+	MaxPriceAge time.Duration
+}
+
+type streamedPrice struct {
+	price     float64
+	timestamp time.Time
+}
+
+// streamingPriceFetcher subscribes to the ticker channel of an exchange WebSocket endpoint and keeps the latest price
+// of each added pair in memory, so that the prices are read without any request to the exchange
+type streamingPriceFetcher struct {
+	baseFetcher
+	name             string
+	url              string
+	protocol         streamProtocol
+	minRetryInterval time.Duration
+	maxRetryInterval time.Duration
+	readTimeout      time.Duration
+	maxPriceAge      time.Duration
+
+	mutPrices sync.RWMutex
+	prices    map[string]*streamedPrice
+
+	mutState sync.Mutex
+	symbols  map[string]struct{}
+	conn     *websocket.Conn
+	started  bool
+	closed   bool
+
+	mutWrite  sync.Mutex
+	ctx       context.Context
+	cancel    func()
+	loopGroup sync.WaitGroup
+}
+
+// NewStreamingPriceFetcher returns a new streaming price fetcher of the exchange provided. The supported fetchers are
+// BinanceStreamName, KrakenStreamName and OkxStreamName. The fetcher should be started before use and closed afterwards
+func NewStreamingPriceFetcher(args ArgsStreamingPriceFetcher) (*streamingPriceFetcher, error) {
+	protocol, err := createStreamProtocol(args.FetcherName)
+	if err != nil {
+		return nil, err
+	}
+	if args.MinRetryInterval < minStreamRetryInterval {
+		return nil, fmt.Errorf("%w, MinRetryInterval: %v", errInvalidRetryInterval, args.MinRetryInterval)
+	}
+	if args.MaxRetryInterval < args.MinRetryInterval {
+		return nil, fmt.Errorf("%w, MaxRetryInterval: %v, MinRetryInterval: %v", errInvalidRetryInterval,
+			args.MaxRetryInterval, args.MinRetryInterval)
+	}
+	if args.ReadTimeout < 0 {
+		return nil, fmt.Errorf("%w, ReadTimeout: %v", errInvalidReadTimeout, args.ReadTimeout)
+	}
+	readTimeout := args.ReadTimeout
+	if readTimeout == 0 {
+		readTimeout = defaultStreamReadTimeout
+	}
+	if args.MaxPriceAge < 0 {
+		return nil, fmt.Errorf("%w, MaxPriceAge: %v", errInvalidMaxPriceAge, args.MaxPriceAge)
+	}
+	maxPriceAge := args.MaxPriceAge
+	if maxPriceAge == 0 {
+		maxPriceAge = defaultStreamMaxPriceAge
+	}
+
+	url := args.URL
+	if len(url) == 0 {
+		url = protocol.defaultURL()
+	}
+
+	fetcher := &streamingPriceFetcher{
+		baseFetcher:      newBaseFetcher(),
+		name:             args.FetcherName,
+		url:              url,
+		protocol:         protocol,
+		minRetryInterval: args.MinRetryInterval,
+		maxRetryInterval: args.MaxRetryInterval,
+		readTimeout:      readTimeout,
+		maxPriceAge:      maxPriceAge,
+		prices:           make(map[string]*streamedPrice),
+		symbols:          make(map[string]struct{}),
+	}
+	fetcher.ctx, fetcher.cancel = context.WithCancel(context.Background())
+
+	return fetcher, nil
+}
+
+// Start connects to the exchange and starts receiving the prices of the added pairs. It can be called only once
+func (fetcher *streamingPriceFetcher) Start() error {
+	fetcher.mutState.Lock()
+	defer fetcher.mutState.Unlock()
+
+	if fetcher.closed {
+		return errFetcherClosed
+	}
+	if fetcher.started {
+		return nil
+	}
+	fetcher.started = true
+
+	fetcher.loopGroup.Add(1)
+	go fetcher.processLoop()
+
+	return nil
+}
+
+// AddPair adds the specified base-quote pair and subscribes to its ticker, if already connected
+func (fetcher *streamingPriceFetcher) AddPair(base, quote string) {
+	fetcher.baseFetcher.AddPair(base, quote)
+	symbol := fetcher.symbolOf(base, quote)
+
+	fetcher.mutState.Lock()
+	fetcher.symbols[symbol] = struct{}{}
+	conn := fetcher.conn
+	fetcher.mutState.Unlock()
+
+	if conn == nil {
+		return
+	}
+
+	err := fetcher.subscribe(conn, []string{symbol})
+	if err != nil {
+		log.Debug("streamingPriceFetcher: could not subscribe", "exchange", fetcher.name, "symbol", symbol, "error", err)
+	}
+}
+
+// FetchPrice returns the latest streamed price of the pair
+func (fetcher *streamingPriceFetcher) FetchPrice(ctx context.Context, base string, quote string) (float64, error) {
+	price, _, err := fetcher.FetchPriceWithTimestamp(ctx, base, quote)
+
+	return price, err
+}
+
+// FetchPriceWithTimestamp returns the latest streamed price of the pair together with its update time, if the price
+// is not older than the maximum price age
+func (fetcher *streamingPriceFetcher) FetchPriceWithTimestamp(_ context.Context, base string, quote string) (float64, time.Time, error) {
+	if !fetcher.hasPair(base, quote) {
+		return 0, time.Time{}, aggregator.ErrPairNotSupported
+	}
+
+	fetcher.mutPrices.RLock()
+	price, found := fetcher.prices[fetcher.symbolOf(base, quote)]
+	fetcher.mutPrices.RUnlock()
+	if !found {
+		return 0, time.Time{}, fmt.Errorf("%w for %s-%s", errNoStreamedPrice, base, quote)
+	}
+	if time.Since(price.timestamp) > fetcher.maxPriceAge {
+		return 0, time.Time{}, fmt.Errorf("%w for %s-%s, the latest one is from %v, older than %v",
+			errNoStreamedPrice, base, quote, price.timestamp, fetcher.maxPriceAge)
+	}
+
+	return price.price, price.timestamp, nil
+}
+
+func (fetcher *streamingPriceFetcher) symbolOf(base string, quote string) string {
+	return fetcher.protocol.symbol(base, fetcher.normalizeQuoteName(quote, fetcher.protocol.exchangeName()))
+}
+
+func (fetcher *streamingPriceFetcher) processLoop() {
+	defer fetcher.loopGroup.Done()
+
+	retryInterval := fetcher.minRetryInterval
+	timer := time.NewTimer(retryInterval)
+	defer timer.Stop()
+
+	for {
+		connected, err := fetcher.connectAndListen()
+		if fetcher.ctx.Err() != nil {
+			return
+		}
+		if connected {
+			retryInterval = fetcher.minRetryInterval
+		}
+		log.Debug("streamingPriceFetcher: connection error, reconnecting", "exchange", fetcher.name,
+			"retry interval", retryInterval, "error", err)
+
+		timer.Reset(retryInterval)
+		select {
+		case <-timer.C:
+		case <-fetcher.ctx.Done():
+			return
+		}
+		retryInterval = nextRetryInterval(retryInterval, fetcher.maxRetryInterval)
+	}
+}
+
+func nextRetryInterval(retryInterval time.Duration, maxRetryInterval time.Duration) time.Duration {
+	retryInterval *= 2
+	if retryInterval > maxRetryInterval {
+		return maxRetryInterval
+	}
+
+	return retryInterval
+}
+
+// connectAndListen returns whether the connection was established, together with the error that ended it
+func (fetcher *streamingPriceFetcher) connectAndListen() (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(fetcher.ctx, fetcher.url, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	fetcher.mutState.Lock()
+	if fetcher.closed {
+		fetcher.mutState.Unlock()
+		return false, errFetcherClosed
+	}
+	fetcher.conn = conn
+	symbols := make([]string, 0, len(fetcher.symbols))
+	for symbol := range fetcher.symbols {
+		symbols = append(symbols, symbol)
+	}
+	fetcher.mutState.Unlock()
+	defer fetcher.clearConnection(conn)
+
+	err = fetcher.setReadDeadlineHandlers(conn)
+	if err != nil {
+		return true, err
+	}
+
+	if len(symbols) > 0 {
+		err = fetcher.subscribe(conn, symbols)
+		if err != nil {
+			return true, err
+		}
+	}
+
+	chDone := make(chan struct{})
+	defer close(chDone)
+	go fetcher.keepAlive(conn, chDone)
+
+	log.Debug("streamingPriceFetcher: connected", "exchange", fetcher.name, "url", fetcher.url)
+	for {
+		_, message, errRead := conn.ReadMessage()
+		if errRead != nil {
+			return true, errRead
+		}
+		errRead = fetcher.refreshReadDeadline(conn)
+		if errRead != nil {
+			return true, errRead
+		}
+
+		errProcess := fetcher.processMessage(message)
+		if errProcess != nil {
+			log.Trace("streamingPriceFetcher: error processing message", "exchange", fetcher.name, "error", errProcess)
+		}
+	}
+}
+
+// setReadDeadlineHandlers sets the initial read deadline and refreshes it on each ping and pong, so that a half-open
+// connection, which would block the reads forever, is detected and reopened
+func (fetcher *streamingPriceFetcher) setReadDeadlineHandlers(conn *websocket.Conn) error {
+	conn.SetPongHandler(func(_ string) error {
+		return fetcher.refreshReadDeadline(conn)
+	})
+	conn.SetPingHandler(func(appData string) error {
+		err := fetcher.refreshReadDeadline(conn)
+		if err != nil {
+			return err
+		}
+
+		err = conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(streamWriteTimeout))
+		if errors.Is(err, websocket.ErrCloseSent) {
+			return nil
+		}
+
+		return err
+	})
+
+	return fetcher.refreshReadDeadline(conn)
+}
+
+func (fetcher *streamingPriceFetcher) refreshReadDeadline(conn *websocket.Conn) error {
+	return conn.SetReadDeadline(time.Now().Add(fetcher.readTimeout))
+}
+
+func (fetcher *streamingPriceFetcher) clearConnection(conn *websocket.Conn) {
+	fetcher.mutState.Lock()
+	if fetcher.conn == conn {
+		fetcher.conn = nil
+	}
+	fetcher.mutState.Unlock()
+}
+
+func (fetcher *streamingPriceFetcher) subscribe(conn *websocket.Conn, symbols []string) error {
+	message, err := fetcher.protocol.subscribeMessage(symbols)
+	if err != nil {
+		return err
+	}
+
+	return fetcher.writeMessage(conn, message)
+}
+
+// keepAlive sends WebSocket pings, answered by the exchanges with pongs that refresh the read deadline, together with
+// the application level ping of the exchange, if it requires one
+func (fetcher *streamingPriceFetcher) keepAlive(conn *websocket.Conn, chDone chan struct{}) {
+	pingMessage := fetcher.protocol.pingMessage()
+
+	ticker := time.NewTicker(fetcher.readTimeout / streamPingsPerReadTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout))
+			if err != nil {
+				log.Debug("streamingPriceFetcher: could not send ping", "exchange", fetcher.name, "error", err)
+			}
+			if len(pingMessage) == 0 {
+				continue
+			}
+
+			err = fetcher.writeMessage(conn, pingMessage)
+			if err != nil {
+				log.Debug("streamingPriceFetcher: could not send ping", "exchange", fetcher.name, "error", err)
+			}
+		case <-chDone:
+			return
+		}
+	}
+}
+
+// writeMessage serializes the writes, as the WebSocket connection supports only one concurrent writer
+func (fetcher *streamingPriceFetcher) writeMessage(conn *websocket.Conn, message []byte) error {
+	fetcher.mutWrite.Lock()
+	defer fetcher.mutWrite.Unlock()
+
+	return conn.WriteMessage(websocket.TextMessage, message)
+}
+
+func (fetcher *streamingPriceFetcher) processMessage(message []byte) error {
+	tickers, err := fetcher.protocol.parseMessage(message)
+	if err != nil {
+		return err
+	}
+
+	receivedTime := time.Now()
+	fetcher.mutPrices.Lock()
+	defer fetcher.mutPrices.Unlock()
+
+	for _, ticker := range tickers {
+		timestamp := ticker.timestamp
+		if timestamp.IsZero() {
+			timestamp = receivedTime
+		}
+
+		fetcher.prices[ticker.symbol] = &streamedPrice{
+			price:     ticker.price,
+			timestamp: timestamp,
+		}
+	}
+
+	return nil
+}
+
+// Close closes the connection and stops the reconnection attempts
+func (fetcher *streamingPriceFetcher) Close() error {
+	fetcher.mutState.Lock()
+	if fetcher.closed {
+		fetcher.mutState.Unlock()
+		return nil
+	}
+	fetcher.closed = true
+	fetcher.cancel()
+	if fetcher.conn != nil {
+		_ = fetcher.conn.Close()
+	}
+	fetcher.mutState.Unlock()
+
+	fetcher.loopGroup.Wait()
+
+	return nil
+}
+
+// Name returns the name
+func (fetcher *streamingPriceFetcher) Name() string {
+	return fetcher.name
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fetcher *streamingPriceFetcher) IsInterfaceNil() bool {
+	return fetcher == nil
+}
@@ -0,0 +1,308 @@
+package fetchers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/aggregator"
+	"github.com/multiversx/mx-sdk-go/testsCommon/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ StreamingPriceFetcher = (*streamingPriceFetcher)(nil)
+
+const streamTestTimeout = time.Second * 5
+
+func createMockArgsStreamingPriceFetcher(url string) ArgsStreamingPriceFetcher {
+	return ArgsStreamingPriceFetcher{
+		FetcherName:      BinanceStreamName,
+		URL:              url,
+		MinRetryInterval: time.Millisecond * 10,
+		MaxRetryInterval: time.Millisecond * 50,
+	}
+}
+
+func waitStreamSignal(tb testing.TB, ch <-chan struct{}) {
+	select {
+	case <-ch:
+	case <-time.After(streamTestTimeout):
+		require.Fail(tb, "timeout waiting for the server signal")
+	}
+}
+
+func TestNewStreamingPriceFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported exchange should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, name := range []string{GeminiName, BinanceName} {
+			args := createMockArgsStreamingPriceFetcher("")
+			args.FetcherName = name
+			fetcher, err := NewStreamingPriceFetcher(args)
+			assert.True(t, check.IfNil(fetcher))
+			assert.True(t, errors.Is(err, errInvalidFetcherName))
+		}
+	})
+	t.Run("invalid read timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStreamingPriceFetcher("")
+		args.ReadTimeout = -time.Second
+		fetcher, err := NewStreamingPriceFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidReadTimeout))
+	})
+	t.Run("invalid max price age should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStreamingPriceFetcher("")
+		args.MaxPriceAge = -time.Second
+		fetcher, err := NewStreamingPriceFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidMaxPriceAge))
+	})
+	t.Run("invalid min retry interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStreamingPriceFetcher("")
+		args.MinRetryInterval = 0
+		fetcher, err := NewStreamingPriceFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidRetryInterval))
+	})
+	t.Run("max retry interval lower than the min should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStreamingPriceFetcher("")
+		args.MaxRetryInterval = time.Millisecond
+		fetcher, err := NewStreamingPriceFetcher(args)
+		assert.True(t, check.IfNil(fetcher))
+		assert.True(t, errors.Is(err, errInvalidRetryInterval))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, name := range []string{BinanceStreamName, KrakenStreamName, OkxStreamName} {
+			args := createMockArgsStreamingPriceFetcher("")
+			args.FetcherName = name
+			fetcher, err := NewStreamingPriceFetcher(args)
+			assert.False(t, check.IfNil(fetcher))
+			assert.Nil(t, err)
+			assert.Equal(t, name, fetcher.Name())
+			assert.NotEmpty(t, fetcher.url)
+			assert.Equal(t, defaultStreamReadTimeout, fetcher.readTimeout)
+			assert.Equal(t, defaultStreamMaxPriceAge, fetcher.maxPriceAge)
+			assert.Nil(t, fetcher.Close())
+		}
+	})
+}
+
+func TestNextRetryInterval(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Second*2, nextRetryInterval(time.Second, time.Second*5))
+	assert.Equal(t, time.Second*5, nextRetryInterval(time.Second*4, time.Second*5))
+}
+
+func TestStreamingPriceFetcher_FetchPrice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pair not added should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := NewStreamingPriceFetcher(createMockArgsStreamingPriceFetcher("ws://localhost"))
+		price, err := fetcher.FetchPrice(context.Background(), "EGLD", "USD")
+		assert.Equal(t, aggregator.ErrPairNotSupported, err)
+		assert.Equal(t, 0.0, price)
+	})
+	t.Run("no price received yet should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := NewStreamingPriceFetcher(createMockArgsStreamingPriceFetcher("ws://localhost"))
+		fetcher.AddPair("EGLD", "USD")
+		price, err := fetcher.FetchPrice(context.Background(), "EGLD", "USD")
+		assert.True(t, errors.Is(err, errNoStreamedPrice))
+		assert.Equal(t, 0.0, price)
+	})
+	t.Run("price older than the max price age should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStreamingPriceFetcher("ws://localhost")
+		args.MaxPriceAge = time.Minute
+		fetcher, _ := NewStreamingPriceFetcher(args)
+		fetcher.AddPair("EGLD", "USDT")
+		fetcher.AddPair("BTC", "USDT")
+		fetcher.prices[fetcher.symbolOf("EGLD", "USDT")] = &streamedPrice{
+			price:     40,
+			timestamp: time.Now().Add(-time.Minute * 2),
+		}
+		fetcher.prices[fetcher.symbolOf("BTC", "USDT")] = &streamedPrice{
+			price:     60000,
+			timestamp: time.Now().Add(-time.Second * 30),
+		}
+
+		price, err := fetcher.FetchPrice(context.Background(), "EGLD", "USDT")
+		assert.True(t, errors.Is(err, errNoStreamedPrice))
+		assert.Equal(t, 0.0, price)
+
+		price, err = fetcher.FetchPrice(context.Background(), "BTC", "USDT")
+		assert.Nil(t, err)
+		assert.Equal(t, 60000.0, price)
+	})
+	t.Run("should subscribe, stream the prices and resubscribe after reconnecting", func(t *testing.T) {
+		t.Parallel()
+
+		wsServer := server.NewWebSocketServerMock()
+		defer wsServer.Close()
+
+		fetcher, _ := NewStreamingPriceFetcher(createMockArgsStreamingPriceFetcher(wsServer.URL()))
+		fetcher.AddPair("EGLD", "USD")
+		require.Nil(t, fetcher.Start())
+		defer func() {
+			_ = fetcher.Close()
+		}()
+
+		waitStreamSignal(t, wsServer.Connected())
+		waitStreamSignal(t, wsServer.Received())
+		subscription := &binanceSubscription{}
+		require.Nil(t, json.Unmarshal(wsServer.ReceivedMessages()[0], subscription))
+		assert.Equal(t, "SUBSCRIBE", subscription.Method)
+		assert.Equal(t, []string{"egldusdt@ticker"}, subscription.Params)
+
+		fetcher.AddPair("BTC", "USD")
+		waitStreamSignal(t, wsServer.Received())
+		require.Nil(t, json.Unmarshal(wsServer.ReceivedMessages()[1], subscription))
+		assert.Equal(t, []string{"btcusdt@ticker"}, subscription.Params)
+
+		eventTime := time.Now().UnixMilli()
+		wsServer.SendMessage([]byte(fmt.Sprintf(`{"e":"24hrTicker","E":%d,"s":"EGLDUSDT","c":"42.5"}`, eventTime)))
+		require.Eventually(t, func() bool {
+			price, timestamp, err := fetcher.FetchPriceWithTimestamp(context.Background(), "EGLD", "USD")
+			return err == nil && price == 42.5 && timestamp.Equal(time.UnixMilli(eventTime))
+		}, streamTestTimeout, time.Millisecond*5)
+
+		wsServer.CloseConnections()
+		waitStreamSignal(t, wsServer.Connected())
+		waitStreamSignal(t, wsServer.Received())
+		assert.Equal(t, 2, wsServer.NumConnections())
+
+		wsServer.SendMessage([]byte(fmt.Sprintf(`{"e":"24hrTicker","E":%d,"s":"EGLDUSDT","c":"43"}`, time.Now().UnixMilli())))
+		require.Eventually(t, func() bool {
+			price, err := fetcher.FetchPrice(context.Background(), "EGLD", "USD")
+			return err == nil && price == 43.0
+		}, streamTestTimeout, time.Millisecond*5)
+	})
+	t.Run("half-open connection should be reopened", func(t *testing.T) {
+		t.Parallel()
+
+		wsServer := server.NewWebSocketServerMock()
+		defer wsServer.Close()
+		wsServer.IgnorePings()
+
+		args := createMockArgsStreamingPriceFetcher(wsServer.URL())
+		args.ReadTimeout = time.Millisecond * 100
+		fetcher, _ := NewStreamingPriceFetcher(args)
+		require.Nil(t, fetcher.Start())
+		defer func() {
+			_ = fetcher.Close()
+		}()
+
+		waitStreamSignal(t, wsServer.Connected())
+		waitStreamSignal(t, wsServer.Connected())
+		assert.GreaterOrEqual(t, wsServer.NumConnections(), 2)
+	})
+	t.Run("answered pings should keep the connection open", func(t *testing.T) {
+		t.Parallel()
+
+		wsServer := server.NewWebSocketServerMock()
+		defer wsServer.Close()
+
+		args := createMockArgsStreamingPriceFetcher(wsServer.URL())
+		args.ReadTimeout = time.Millisecond * 100
+		fetcher, _ := NewStreamingPriceFetcher(args)
+		require.Nil(t, fetcher.Start())
+		defer func() {
+			_ = fetcher.Close()
+		}()
+
+		waitStreamSignal(t, wsServer.Connected())
+		time.Sleep(args.ReadTimeout * 5)
+		assert.Equal(t, 1, wsServer.NumConnections())
+	})
+	t.Run("start after close should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _ := NewStreamingPriceFetcher(createMockArgsStreamingPriceFetcher("ws://localhost"))
+		assert.Nil(t, fetcher.Close())
+		assert.Equal(t, errFetcherClosed, fetcher.Start())
+	})
+}
+
+func TestStreamProtocols_ParseMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("binance", func(t *testing.T) {
+		t.Parallel()
+
+		protocol := &binanceStreamProtocol{}
+		tickers, err := protocol.parseMessage([]byte(`{"result":null,"id":1}`))
+		assert.Nil(t, err)
+		assert.Empty(t, tickers)
+
+		tickers, err = protocol.parseMessage([]byte(`{"e":"24hrTicker","E":1700000000000,"s":"EGLDUSDT","c":"0"}`))
+		assert.Equal(t, errInvalidResponseData, err)
+		assert.Nil(t, tickers)
+
+		tickers, err = protocol.parseMessage([]byte(`{"e":"24hrTicker","E":1700000000000,"s":"EGLDUSDT","c":"42.5"}`))
+		assert.Nil(t, err)
+		assert.Equal(t, []*streamedTicker{{symbol: "EGLDUSDT", price: 42.5, timestamp: time.UnixMilli(1700000000000)}}, tickers)
+	})
+	t.Run("kraken", func(t *testing.T) {
+		t.Parallel()
+
+		protocol := &krakenStreamProtocol{}
+		assert.Equal(t, "EGLD/USD", protocol.symbol("EGLD", "USD"))
+
+		tickers, err := protocol.parseMessage([]byte(`{"channel":"heartbeat"}`))
+		assert.Nil(t, err)
+		assert.Empty(t, tickers)
+
+		tickers, err = protocol.parseMessage([]byte(`{"channel":"ticker","type":"update","data":[{"symbol":"EGLD/USD","last":42.5}]}`))
+		assert.Nil(t, err)
+		assert.Equal(t, []*streamedTicker{{symbol: "EGLD/USD", price: 42.5}}, tickers)
+
+		message, err := protocol.subscribeMessage([]string{"EGLD/USD"})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"method":"subscribe","params":{"channel":"ticker","symbol":["EGLD/USD"]}}`, string(message))
+	})
+	t.Run("okx", func(t *testing.T) {
+		t.Parallel()
+
+		protocol := &okxStreamProtocol{}
+		assert.Equal(t, "EGLD-USDT", protocol.symbol("EGLD", "USDT"))
+		assert.Equal(t, []byte("ping"), protocol.pingMessage())
+
+		tickers, err := protocol.parseMessage([]byte("pong"))
+		assert.Nil(t, err)
+		assert.Empty(t, tickers)
+
+		tickers, err = protocol.parseMessage([]byte(`{"event":"subscribe","arg":{"channel":"tickers","instId":"EGLD-USDT"}}`))
+		assert.Nil(t, err)
+		assert.Empty(t, tickers)
+
+		tickers, err = protocol.parseMessage([]byte(`{"arg":{"channel":"tickers","instId":"EGLD-USDT"},"data":[{"instId":"EGLD-USDT","last":"42.5","ts":"1700000000000"}]}`))
+		assert.Nil(t, err)
+		assert.Equal(t, []*streamedTicker{{symbol: "EGLD-USDT", price: 42.5, timestamp: time.UnixMilli(1700000000000)}}, tickers)
+
+		message, err := protocol.subscribeMessage([]string{"EGLD-USDT"})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"op":"subscribe","args":[{"channel":"tickers","instId":"EGLD-USDT"}]}`, string(message))
+	})
+}
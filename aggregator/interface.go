@@ -1,6 +1,11 @@
 package aggregator
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/builders"
+)
 
 // ResponseGetter is the component able to execute a get operation on the provided URL
 type ResponseGetter interface {
@@ -12,6 +17,12 @@ type GraphqlGetter interface {
 	Query(ctx context.Context, url string, query string, variables string) ([]byte, error)
 }
 
+// VmQueryGetter is the component able to execute VM queries on the MultiversX chain
+type VmQueryGetter interface {
+	ExecuteQueryFromBuilder(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
 // basePriceFetcher defines the behavior of a component able to query the price
 type basePriceFetcher interface {
 	Name() string
@@ -31,6 +42,32 @@ type PriceFetcher interface {
 	AddPair(base, quote string)
 }
 
+// TimestampedPriceFetcher defines the behavior of a price fetcher able to report when the fetched price was last
+// updated by its source, so that the price aggregator can detect the stale sources
+type TimestampedPriceFetcher interface {
+	PriceFetcher
+	FetchPriceWithTimestamp(ctx context.Context, base string, quote string) (float64, time.Time, error)
+}
+
+// VolumeFetcher defines the behavior of a component able to report the volume traded for a pair since its previous
+// call, recorded by the price notifier along with the spot price so that the volume weighted average price can be
+// computed
+type VolumeFetcher interface {
+	FetchVolume(ctx context.Context, base string, quote string) (float64, error)
+	IsInterfaceNil() bool
+}
+
+// PriceHistory defines the behavior of a component able to store the price series of the pairs and to compute the
+// average prices over time windows
+type PriceHistory interface {
+	AddPrice(base string, quote string, point PricePoint) error
+	GetPriceSeries(base string, quote string, window time.Duration) ([]PricePoint, error)
+	ComputeTWAP(base string, quote string, window time.Duration) (float64, error)
+	ComputeVWAP(base string, quote string, window time.Duration) (float64, error)
+	RetentionPeriod() time.Duration
+	IsInterfaceNil() bool
+}
+
 // ArgsPriceChanged is the argument used when notifying the notifee instance
 type ArgsPriceChanged struct {
 	Base             string
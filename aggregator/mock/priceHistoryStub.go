@@ -0,0 +1,66 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/aggregator"
+)
+
+// PriceHistoryStub -
+type PriceHistoryStub struct {
+	AddPriceCalled        func(base string, quote string, point aggregator.PricePoint) error
+	GetPriceSeriesCalled  func(base string, quote string, window time.Duration) ([]aggregator.PricePoint, error)
+	ComputeTWAPCalled     func(base string, quote string, window time.Duration) (float64, error)
+	ComputeVWAPCalled     func(base string, quote string, window time.Duration) (float64, error)
+	RetentionPeriodCalled func() time.Duration
+}
+
+// AddPrice -
+func (stub *PriceHistoryStub) AddPrice(base string, quote string, point aggregator.PricePoint) error {
+	if stub.AddPriceCalled != nil {
+		return stub.AddPriceCalled(base, quote, point)
+	}
+
+	return nil
+}
+
+// GetPriceSeries -
+func (stub *PriceHistoryStub) GetPriceSeries(base string, quote string, window time.Duration) ([]aggregator.PricePoint, error) {
+	if stub.GetPriceSeriesCalled != nil {
+		return stub.GetPriceSeriesCalled(base, quote, window)
+	}
+
+	return nil, nil
+}
+
+// ComputeTWAP -
+func (stub *PriceHistoryStub) ComputeTWAP(base string, quote string, window time.Duration) (float64, error) {
+	if stub.ComputeTWAPCalled != nil {
+		return stub.ComputeTWAPCalled(base, quote, window)
+	}
+
+	return 0, nil
+}
+
+// ComputeVWAP -
+func (stub *PriceHistoryStub) ComputeVWAP(base string, quote string, window time.Duration) (float64, error) {
+	if stub.ComputeVWAPCalled != nil {
+		return stub.ComputeVWAPCalled(base, quote, window)
+	}
+
+	return 0, nil
+}
+
+// RetentionPeriod -
+func (stub *PriceHistoryStub) RetentionPeriod() time.Duration {
+	if stub.RetentionPeriodCalled != nil {
+		return stub.RetentionPeriodCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *PriceHistoryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+	"time"
+)
+
+// TimestampedPriceFetcherStub -
+type TimestampedPriceFetcherStub struct {
+	PriceFetcherStub
+	FetchPriceWithTimestampCalled func(ctx context.Context, base string, quote string) (float64, time.Time, error)
+}
+
+// FetchPriceWithTimestamp -
+func (stub *TimestampedPriceFetcherStub) FetchPriceWithTimestamp(ctx context.Context, base string, quote string) (float64, time.Time, error) {
+	if stub.FetchPriceWithTimestampCalled != nil {
+		return stub.FetchPriceWithTimestampCalled(ctx, base, quote)
+	}
+
+	return 1, time.Now(), nil
+}
+
+// IsInterfaceNil -
+func (stub *TimestampedPriceFetcherStub) IsInterfaceNil() bool {
+	return stub == nil
+}
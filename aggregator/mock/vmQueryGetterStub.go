@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-sdk-go/builders"
+)
+
+// VmQueryGetterStub -
+type VmQueryGetterStub struct {
+	ExecuteQueryFromBuilderCalled func(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error)
+}
+
+// ExecuteQueryFromBuilder -
+func (stub *VmQueryGetterStub) ExecuteQueryFromBuilder(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
+	if stub.ExecuteQueryFromBuilderCalled != nil {
+		return stub.ExecuteQueryFromBuilderCalled(ctx, builder)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *VmQueryGetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"context"
+)
+
+// VolumeFetcherStub -
+type VolumeFetcherStub struct {
+	FetchVolumeCalled func(ctx context.Context, base string, quote string) (float64, error)
+}
+
+// FetchVolume -
+func (stub *VolumeFetcherStub) FetchVolume(ctx context.Context, base string, quote string) (float64, error) {
+	if stub.FetchVolumeCalled != nil {
+		return stub.FetchVolumeCalled(ctx, base, quote)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *VolumeFetcherStub) IsInterfaceNil() bool {
+	return stub == nil
+}
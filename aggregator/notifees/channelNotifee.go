@@ -0,0 +1,60 @@
+package notifees
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-sdk-go/aggregator"
+)
+
+// ArgsChannelNotifee is the argument DTO for the NewChannelNotifee function
+type ArgsChannelNotifee struct {
+	BufferSize int
+}
+
+type channelNotifee struct {
+	chPriceChanges chan []*aggregator.ArgsPriceChanged
+}
+
+// NewChannelNotifee will create a new instance of channelNotifee that publishes the price changes on a channel, for
+// the in-process consumers
+func NewChannelNotifee(args ArgsChannelNotifee) (*channelNotifee, error) {
+	if args.BufferSize < 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidBufferSize, args.BufferSize)
+	}
+
+	return &channelNotifee{
+		chPriceChanges: make(chan []*aggregator.ArgsPriceChanged, args.BufferSize),
+	}, nil
+}
+
+// PriceChanged publishes a copy of the price changes on the channel. It blocks while the channel buffer is full,
+// until the context is done
+func (cn *channelNotifee) PriceChanged(ctx context.Context, priceChanges []*aggregator.ArgsPriceChanged) error {
+	priceChangesCopy := make([]*aggregator.ArgsPriceChanged, 0, len(priceChanges))
+	for _, priceChange := range priceChanges {
+		if priceChange == nil {
+			continue
+		}
+
+		priceChangeCopy := *priceChange
+		priceChangesCopy = append(priceChangesCopy, &priceChangeCopy)
+	}
+
+	select {
+	case cn.chPriceChanges <- priceChangesCopy:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// PriceChanges returns the channel on which the price changes are published
+func (cn *channelNotifee) PriceChanges() <-chan []*aggregator.ArgsPriceChanged {
+	return cn.chPriceChanges
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cn *channelNotifee) IsInterfaceNil() bool {
+	return cn == nil
+}
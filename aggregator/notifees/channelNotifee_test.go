@@ -0,0 +1,58 @@
+package notifees
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChannelNotifee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("negative buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := NewChannelNotifee(ArgsChannelNotifee{BufferSize: -1})
+		assert.True(t, check.IfNil(cn))
+		assert.True(t, errors.Is(err, errInvalidBufferSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := NewChannelNotifee(ArgsChannelNotifee{BufferSize: 1})
+		assert.False(t, check.IfNil(cn))
+		assert.Nil(t, err)
+	})
+}
+
+func TestChannelNotifee_PriceChanged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should publish a copy of the price changes", func(t *testing.T) {
+		t.Parallel()
+
+		cn, _ := NewChannelNotifee(ArgsChannelNotifee{BufferSize: 1})
+		priceChanges := createMockPriceChanges()
+		require.Nil(t, cn.PriceChanged(context.Background(), priceChanges))
+		priceChanges[0].DenominatedPrice = 1
+
+		published := <-cn.PriceChanges()
+		require.Len(t, published, 2)
+		assert.Equal(t, *createMockPriceChanges()[0], *published[0])
+		assert.Equal(t, *createMockPriceChanges()[1], *published[1])
+	})
+	t.Run("full buffer should block until the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		cn, _ := NewChannelNotifee(ArgsChannelNotifee{})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := cn.PriceChanged(ctx, createMockPriceChanges())
+		assert.Equal(t, context.Canceled, err)
+	})
+}
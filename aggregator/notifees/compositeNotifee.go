@@ -0,0 +1,104 @@
+package notifees
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/aggregator"
+)
+
+// ArgsCompositeNotifee is the argument DTO for the NewCompositeNotifee function
+type ArgsCompositeNotifee struct {
+	Notifees []aggregator.PriceNotifee
+	// NotifeeTimeout limits the duration of each notifee call, so that a slow notifee does not delay the others
+	// indefinitely. 0 disables the limit
+	NotifeeTimeout time.Duration
+}
+
+type compositeNotifee struct {
+	notifees       []aggregator.PriceNotifee
+	notifeeTimeout time.Duration
+}
+
+// NewCompositeNotifee will create a new instance of compositeNotifee that fans out the price changes to all the
+// provided notifees
+func NewCompositeNotifee(args ArgsCompositeNotifee) (*compositeNotifee, error) {
+	if len(args.Notifees) == 0 {
+		return nil, errEmptyNotifees
+	}
+	for idx, notifee := range args.Notifees {
+		if check.IfNil(notifee) {
+			return nil, fmt.Errorf("%w, index %d", errNilNotifee, idx)
+		}
+	}
+	if args.NotifeeTimeout < 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidNotifeeTimeout, args.NotifeeTimeout)
+	}
+
+	return &compositeNotifee{
+		notifees:       append(make([]aggregator.PriceNotifee, 0, len(args.Notifees)), args.Notifees...),
+		notifeeTimeout: args.NotifeeTimeout,
+	}, nil
+}
+
+// PriceChanged calls all the notifees concurrently. A failing or panicking notifee does not prevent the others from
+// being notified, the errors being gathered in the returned error
+func (cn *compositeNotifee) PriceChanged(ctx context.Context, priceChanges []*aggregator.ArgsPriceChanged) error {
+	errs := make([]error, len(cn.notifees))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(cn.notifees))
+	for idx, notifee := range cn.notifees {
+		go func(idx int, notifee aggregator.PriceNotifee) {
+			defer wg.Done()
+
+			errs[idx] = cn.notify(ctx, notifee, priceChanges)
+		}(idx, notifee)
+	}
+	wg.Wait()
+
+	return gatherNotifeesErrors(errs)
+}
+
+func (cn *compositeNotifee) notify(ctx context.Context, notifee aggregator.PriceNotifee, priceChanges []*aggregator.ArgsPriceChanged) (err error) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			err = fmt.Errorf("notifee panicked: %v", r)
+		}
+	}()
+
+	if cn.notifeeTimeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, cn.notifeeTimeout)
+		defer cancel()
+	}
+
+	return notifee.PriceChanged(ctx, priceChanges)
+}
+
+func gatherNotifeesErrors(errs []error) error {
+	messages := make([]string, 0, len(errs))
+	for idx, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		log.Debug("compositeNotifee: notifee failed", "index", idx, "error", err)
+		messages = append(messages, fmt.Sprintf("index %d: %s", idx, err.Error()))
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w, %d out of %d: %s", errNotifeesFailed, len(messages), len(errs), strings.Join(messages, "; "))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cn *compositeNotifee) IsInterfaceNil() bool {
+	return cn == nil
+}
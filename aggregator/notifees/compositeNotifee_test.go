@@ -0,0 +1,132 @@
+package notifees
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/aggregator"
+	"github.com/multiversx/mx-sdk-go/aggregator/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCompositeNotifee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty notifees should error", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := NewCompositeNotifee(ArgsCompositeNotifee{})
+		assert.True(t, check.IfNil(cn))
+		assert.Equal(t, errEmptyNotifees, err)
+	})
+	t.Run("nil notifee should error", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := NewCompositeNotifee(ArgsCompositeNotifee{
+			Notifees: []aggregator.PriceNotifee{&mock.PriceNotifeeStub{}, nil},
+		})
+		assert.True(t, check.IfNil(cn))
+		assert.True(t, errors.Is(err, errNilNotifee))
+		assert.True(t, strings.Contains(err.Error(), "index 1"))
+	})
+	t.Run("negative notifee timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := NewCompositeNotifee(ArgsCompositeNotifee{
+			Notifees:       []aggregator.PriceNotifee{&mock.PriceNotifeeStub{}},
+			NotifeeTimeout: -time.Second,
+		})
+		assert.True(t, check.IfNil(cn))
+		assert.True(t, errors.Is(err, errInvalidNotifeeTimeout))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := NewCompositeNotifee(ArgsCompositeNotifee{
+			Notifees: []aggregator.PriceNotifee{&mock.PriceNotifeeStub{}},
+		})
+		assert.False(t, check.IfNil(cn))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCompositeNotifee_PriceChanged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should notify all", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		notifee := &mock.PriceNotifeeStub{
+			PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+				assert.Equal(t, createMockPriceChanges(), args)
+				atomic.AddUint32(&numCalls, 1)
+				return nil
+			},
+		}
+		cn, _ := NewCompositeNotifee(ArgsCompositeNotifee{
+			Notifees: []aggregator.PriceNotifee{notifee, notifee, notifee},
+		})
+
+		err := cn.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("failing notifees should not prevent the others from being notified", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		wasCalled := false
+		cn, _ := NewCompositeNotifee(ArgsCompositeNotifee{
+			Notifees: []aggregator.PriceNotifee{
+				&mock.PriceNotifeeStub{
+					PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+						return expectedErr
+					},
+				},
+				&mock.PriceNotifeeStub{
+					PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+						panic("notifee panic")
+					},
+				},
+				&mock.PriceNotifeeStub{
+					PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+						wasCalled = true
+						return nil
+					},
+				},
+			},
+		})
+
+		err := cn.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.True(t, errors.Is(err, errNotifeesFailed))
+		assert.True(t, strings.Contains(err.Error(), "2 out of 3"))
+		assert.True(t, strings.Contains(err.Error(), "index 0: expected error"))
+		assert.True(t, strings.Contains(err.Error(), "index 1: notifee panicked: notifee panic"))
+		assert.True(t, wasCalled)
+	})
+	t.Run("slow notifee should be interrupted after the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		cn, _ := NewCompositeNotifee(ArgsCompositeNotifee{
+			Notifees: []aggregator.PriceNotifee{
+				&mock.PriceNotifeeStub{
+					PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+						<-ctx.Done()
+						return ctx.Err()
+					},
+				},
+			},
+			NotifeeTimeout: time.Millisecond * 10,
+		})
+
+		err := cn.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.True(t, errors.Is(err, errNotifeesFailed))
+		assert.True(t, strings.Contains(err.Error(), context.DeadlineExceeded.Error()))
+	})
+}
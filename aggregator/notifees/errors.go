@@ -10,4 +10,13 @@ var (
 	errInvalidContractAddress    = errors.New("invalid contract address")
 	errInvalidBaseGasLimit       = errors.New("invalid base gas limit")
 	errInvalidGasLimitForEach    = errors.New("invalid gas limit for each price change")
+	errInvalidWebhookURL         = errors.New("invalid webhook URL")
+	errEmptyWebhookSecret        = errors.New("empty webhook secret")
+	errWebhookResponse           = errors.New("webhook responded with an error status")
+	errEmptyFilePath             = errors.New("empty file path")
+	errInvalidBufferSize         = errors.New("invalid buffer size")
+	errNilNotifee                = errors.New("nil notifee")
+	errEmptyNotifees             = errors.New("empty notifees slice")
+	errInvalidNotifeeTimeout     = errors.New("invalid notifee timeout")
+	errNotifeesFailed            = errors.New("notifees failed")
 )
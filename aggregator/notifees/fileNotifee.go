@@ -0,0 +1,71 @@
+package notifees
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/multiversx/mx-sdk-go/aggregator"
+)
+
+const filePermissions = 0644
+
+// ArgsFileNotifee is the argument DTO for the NewFileNotifee function
+type ArgsFileNotifee struct {
+	FilePath string
+}
+
+type fileNotifee struct {
+	mut      sync.Mutex
+	filePath string
+}
+
+// NewFileNotifee will create a new instance of fileNotifee that appends each price change as a JSON line
+func NewFileNotifee(args ArgsFileNotifee) (*fileNotifee, error) {
+	if len(args.FilePath) == 0 {
+		return nil, errEmptyFilePath
+	}
+
+	return &fileNotifee{
+		filePath: args.FilePath,
+	}, nil
+}
+
+// PriceChanged appends the price changes to the file, one JSON record per line. The file is created if missing and it
+// is opened on each call, so that it can be rotated by external tools
+func (fn *fileNotifee) PriceChanged(_ context.Context, priceChanges []*aggregator.ArgsPriceChanged) error {
+	buff := bytes.Buffer{}
+	encoder := json.NewEncoder(&buff)
+	for _, record := range newPriceChangeRecords(priceChanges) {
+		err := encoder.Encode(record)
+		if err != nil {
+			return err
+		}
+	}
+	if buff.Len() == 0 {
+		return nil
+	}
+
+	fn.mut.Lock()
+	defer fn.mut.Unlock()
+
+	file, err := os.OpenFile(fn.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff.Bytes())
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fn *fileNotifee) IsInterfaceNil() bool {
+	return fn == nil
+}
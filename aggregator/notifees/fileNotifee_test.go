@@ -0,0 +1,75 @@
+package notifees
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileNotifee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		fn, err := NewFileNotifee(ArgsFileNotifee{})
+		assert.True(t, check.IfNil(fn))
+		assert.Equal(t, errEmptyFilePath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fn, err := NewFileNotifee(ArgsFileNotifee{FilePath: "prices.jsonl"})
+		assert.False(t, check.IfNil(fn))
+		assert.Nil(t, err)
+	})
+}
+
+func TestFileNotifee_PriceChanged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "missing", "prices.jsonl")
+		fn, _ := NewFileNotifee(ArgsFileNotifee{FilePath: filePath})
+		err := fn.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.NotNil(t, err)
+	})
+	t.Run("should append a JSON line for each price change", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "prices.jsonl")
+		fn, _ := NewFileNotifee(ArgsFileNotifee{FilePath: filePath})
+		require.Nil(t, fn.PriceChanged(context.Background(), createMockPriceChanges()))
+		require.Nil(t, fn.PriceChanged(context.Background(), createMockPriceChanges()[:1]))
+
+		file, err := os.Open(filePath)
+		require.Nil(t, err)
+		defer func() {
+			_ = file.Close()
+		}()
+
+		records := make([]*PriceChangeRecord, 0)
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			record := &PriceChangeRecord{}
+			require.Nil(t, json.Unmarshal(scanner.Bytes(), record))
+			records = append(records, record)
+		}
+		require.Nil(t, scanner.Err())
+
+		require.Len(t, records, 3)
+		assert.Equal(t, "ETH", records[0].Quote)
+		assert.Equal(t, "BTC", records[1].Quote)
+		assert.Equal(t, uint64(47000000000), records[1].DenominatedPrice)
+		assert.Equal(t, "ETH", records[2].Quote)
+	})
+}
@@ -2,6 +2,7 @@ package notifees
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/core"
@@ -30,3 +31,9 @@ type TransactionNonceHandler interface {
 	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
 	IsInterfaceNil() bool
 }
+
+// HttpClient defines the component able to execute an HTTP request
+type HttpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,31 @@
+package notifees
+
+import "github.com/multiversx/mx-sdk-go/aggregator"
+
+// PriceChangeRecord is the JSON representation of a price change, as sent to the webhooks and written in the files
+type PriceChangeRecord struct {
+	Base             string `json:"base"`
+	Quote            string `json:"quote"`
+	DenominatedPrice uint64 `json:"denominatedPrice"`
+	Decimals         uint64 `json:"decimals"`
+	Timestamp        int64  `json:"timestamp"`
+}
+
+func newPriceChangeRecords(priceChanges []*aggregator.ArgsPriceChanged) []*PriceChangeRecord {
+	records := make([]*PriceChangeRecord, 0, len(priceChanges))
+	for _, priceChange := range priceChanges {
+		if priceChange == nil {
+			continue
+		}
+
+		records = append(records, &PriceChangeRecord{
+			Base:             priceChange.Base,
+			Quote:            priceChange.Quote,
+			DenominatedPrice: priceChange.DenominatedPrice,
+			Decimals:         priceChange.Decimals,
+			Timestamp:        priceChange.Timestamp,
+		})
+	}
+
+	return records
+}
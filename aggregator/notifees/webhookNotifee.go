@@ -0,0 +1,152 @@
+package notifees
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/aggregator"
+)
+
+const (
+	// WebhookSignatureHeader is the header holding the hex encoded HMAC-SHA256 signature of the webhook request
+	WebhookSignatureHeader = "X-Signature"
+	// WebhookTimestampHeader is the header holding the unix time, in seconds, when the webhook request was signed
+	WebhookTimestampHeader = "X-Signature-Timestamp"
+
+	contentTypeHeader        = "Content-Type"
+	jsonContentType          = "application/json"
+	maxWebhookErrorBodyBytes = 512
+)
+
+// ArgsWebhookNotifee is the argument DTO for the NewWebhookNotifee function
+type ArgsWebhookNotifee struct {
+	URL string
+	// Secret is the key used to sign the requests, so that the receiver can check their origin
+	Secret string
+	// HttpClient is optional, the default HTTP client being used if not provided
+	HttpClient HttpClient
+}
+
+// WebhookPayload is the JSON body of the webhook requests
+type WebhookPayload struct {
+	PriceChanges []*PriceChangeRecord `json:"priceChanges"`
+}
+
+type webhookNotifee struct {
+	url              string
+	secret           []byte
+	httpClient       HttpClient
+	currentTimestamp func() int64
+}
+
+// defaultHttpClient adapts the standard library HTTP client to the HttpClient interface
+type defaultHttpClient struct {
+	*http.Client
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *defaultHttpClient) IsInterfaceNil() bool {
+	return client == nil
+}
+
+// NewWebhookNotifee will create a new instance of webhookNotifee
+func NewWebhookNotifee(args ArgsWebhookNotifee) (*webhookNotifee, error) {
+	err := checkArgsWebhookNotifee(args)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := args.HttpClient
+	if check.IfNil(httpClient) {
+		httpClient = &defaultHttpClient{
+			Client: http.DefaultClient,
+		}
+	}
+
+	return &webhookNotifee{
+		url:        args.URL,
+		secret:     []byte(args.Secret),
+		httpClient: httpClient,
+		currentTimestamp: func() int64 {
+			return time.Now().Unix()
+		},
+	}, nil
+}
+
+func checkArgsWebhookNotifee(args ArgsWebhookNotifee) error {
+	parsedURL, err := url.Parse(args.URL)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidWebhookURL, err.Error())
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" || len(parsedURL.Host) == 0 {
+		return fmt.Errorf("%w: %s", errInvalidWebhookURL, args.URL)
+	}
+	if len(args.Secret) == 0 {
+		return errEmptyWebhookSecret
+	}
+
+	return nil
+}
+
+// PriceChanged posts the price changes to the webhook. The request is signed with HMAC-SHA256 over the timestamp
+// header value, a dot and the request body
+func (wn *webhookNotifee) PriceChanged(ctx context.Context, priceChanges []*aggregator.ArgsPriceChanged) error {
+	body, err := json.Marshal(&WebhookPayload{
+		PriceChanges: newPriceChangeRecords(priceChanges),
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, wn.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(wn.currentTimestamp(), 10)
+	request.Header.Set(contentTypeHeader, jsonContentType)
+	request.Header.Set(WebhookTimestampHeader, timestamp)
+	request.Header.Set(WebhookSignatureHeader, ComputeWebhookSignature(wn.secret, timestamp, body))
+
+	response, err := wn.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		responseBody, _ := io.ReadAll(io.LimitReader(response.Body, maxWebhookErrorBodyBytes))
+		return fmt.Errorf("%w: status %d, response %s", errWebhookResponse, response.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
+
+// ComputeWebhookSignature returns the hex encoded HMAC-SHA256 signature of a webhook request. It can be used by the
+// receivers to check the requests
+func ComputeWebhookSignature(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wn *webhookNotifee) IsInterfaceNil() bool {
+	return wn == nil
+}
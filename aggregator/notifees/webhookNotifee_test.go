@@ -0,0 +1,168 @@
+package notifees
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsWebhookNotifee() ArgsWebhookNotifee {
+	return ArgsWebhookNotifee{
+		URL:    "https://localhost/prices",
+		Secret: "secret",
+	}
+}
+
+func TestNewWebhookNotifee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, url := range []string{"", "localhost", "ftp://localhost", "http://"} {
+			args := createMockArgsWebhookNotifee()
+			args.URL = url
+			wn, err := NewWebhookNotifee(args)
+			assert.True(t, check.IfNil(wn))
+			assert.True(t, errors.Is(err, errInvalidWebhookURL), url)
+		}
+	})
+	t.Run("empty secret should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifee()
+		args.Secret = ""
+		wn, err := NewWebhookNotifee(args)
+		assert.True(t, check.IfNil(wn))
+		assert.Equal(t, errEmptyWebhookSecret, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wn, err := NewWebhookNotifee(createMockArgsWebhookNotifee())
+		assert.False(t, check.IfNil(wn))
+		assert.Nil(t, err)
+		assert.Equal(t, &defaultHttpClient{Client: http.DefaultClient}, wn.httpClient)
+	})
+	t.Run("nil http client should use the default one", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifee()
+		var nilHttpClient *httpClientStub
+		args.HttpClient = nilHttpClient
+		wn, err := NewWebhookNotifee(args)
+		assert.False(t, check.IfNil(wn))
+		assert.Nil(t, err)
+		assert.Equal(t, &defaultHttpClient{Client: http.DefaultClient}, wn.httpClient)
+	})
+}
+
+func TestWebhookNotifee_PriceChanged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error status should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("invalid signature"))
+		}))
+		defer server.Close()
+
+		args := createMockArgsWebhookNotifee()
+		args.URL = server.URL
+		wn, _ := NewWebhookNotifee(args)
+		err := wn.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.True(t, errors.Is(err, errWebhookResponse))
+		assert.True(t, strings.Contains(err.Error(), "invalid signature"))
+	})
+	t.Run("http client error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsWebhookNotifee()
+		args.HttpClient = &httpClientStub{
+			DoCalled: func(req *http.Request) (*http.Response, error) {
+				return nil, expectedErr
+			},
+		}
+		wn, _ := NewWebhookNotifee(args)
+		err := wn.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should post the signed price changes", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			wasCalled = true
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, jsonContentType, r.Header.Get(contentTypeHeader))
+			assert.Equal(t, "1700000000", r.Header.Get(WebhookTimestampHeader))
+
+			body, err := io.ReadAll(r.Body)
+			require.Nil(t, err)
+			expectedSignature := ComputeWebhookSignature([]byte("secret"), "1700000000", body)
+			assert.Equal(t, expectedSignature, r.Header.Get(WebhookSignatureHeader))
+
+			payload := &WebhookPayload{}
+			require.Nil(t, json.Unmarshal(body, payload))
+			require.Len(t, payload.PriceChanges, 2)
+			assert.Equal(t, &PriceChangeRecord{
+				Base:             "USD",
+				Quote:            "ETH",
+				DenominatedPrice: 380000,
+				Decimals:         2,
+				Timestamp:        200,
+			}, payload.PriceChanges[0])
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		args := createMockArgsWebhookNotifee()
+		args.URL = server.URL
+		wn, _ := NewWebhookNotifee(args)
+		wn.currentTimestamp = func() int64 {
+			return 1700000000
+		}
+		err := wn.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.Nil(t, err)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestComputeWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	signature := ComputeWebhookSignature([]byte("secret"), "1700000000", []byte(`{"priceChanges":[]}`))
+	assert.Len(t, signature, 64)
+	assert.Equal(t, signature, ComputeWebhookSignature([]byte("secret"), "1700000000", []byte(`{"priceChanges":[]}`)))
+	assert.NotEqual(t, signature, ComputeWebhookSignature([]byte("secret"), "1700000001", []byte(`{"priceChanges":[]}`)))
+	assert.NotEqual(t, signature, ComputeWebhookSignature([]byte("other"), "1700000000", []byte(`{"priceChanges":[]}`)))
+}
+
+type httpClientStub struct {
+	DoCalled func(req *http.Request) (*http.Response, error)
+}
+
+func (stub *httpClientStub) Do(req *http.Request) (*http.Response, error) {
+	if stub.DoCalled != nil {
+		return stub.DoCalled(req)
+	}
+
+	return nil, errors.New("not implemented")
+}
+
+func (stub *httpClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
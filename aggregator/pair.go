@@ -3,6 +3,7 @@ package aggregator
 import (
 	"fmt"
 	"math"
+	"time"
 )
 
 const (
@@ -17,6 +18,13 @@ type ArgsPair struct {
 	PercentDifferenceToNotify uint32
 	Decimals                  uint64
 	Exchanges                 map[string]struct{}
+	// TWAPWindow, if set, makes the price notifier publish the time weighted average price over this window instead
+	// of the spot median price. It requires a price history in the price notifier
+	TWAPWindow time.Duration
+	// VWAPWindow, if set, makes the price notifier publish the volume weighted average price over this window instead
+	// of the spot median price. It requires a price history and a volume fetcher in the price notifier and it can not
+	// be set together with the TWAPWindow
+	VWAPWindow time.Duration
 }
 
 type pair struct {
@@ -27,6 +35,8 @@ type pair struct {
 	trimPrecision             float64
 	denominationFactor        uint64
 	exchanges                 map[string]struct{}
+	twapWindow                time.Duration
+	vwapWindow                time.Duration
 }
 
 func newPair(args *ArgsPair) (*pair, error) {
@@ -44,6 +54,8 @@ func newPair(args *ArgsPair) (*pair, error) {
 		trimPrecision:             float64(1) / denominationFactorAsFloat64,
 		denominationFactor:        uint64(denominationFactorAsFloat64),
 		exchanges:                 args.Exchanges,
+		twapWindow:                args.TWAPWindow,
+		vwapWindow:                args.VWAPWindow,
 	}, nil
 }
 
@@ -61,10 +73,30 @@ func checkPairArgs(args *ArgsPair) error {
 	if len(args.Exchanges) == 0 {
 		return ErrNilExchanges
 	}
+	if args.TWAPWindow < 0 {
+		return fmt.Errorf("%w, got %v for pair %s-%s", ErrInvalidWindow,
+			args.TWAPWindow, args.Base, args.Quote)
+	}
+	if args.VWAPWindow < 0 {
+		return fmt.Errorf("%w, got %v for pair %s-%s", ErrInvalidWindow,
+			args.VWAPWindow, args.Base, args.Quote)
+	}
+	if args.TWAPWindow > 0 && args.VWAPWindow > 0 {
+		return fmt.Errorf("%w for pair %s-%s", ErrMultipleAveragePriceWindows, args.Base, args.Quote)
+	}
 
 	return nil
 }
 
+// averagePriceWindow returns the window of the published average price, 0 if the spot price is published
+func (p *pair) averagePriceWindow() time.Duration {
+	if p.vwapWindow > 0 {
+		return p.vwapWindow
+	}
+
+	return p.twapWindow
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (p *pair) IsInterfaceNil() bool {
 	return p == nil
@@ -3,6 +3,7 @@ package aggregator
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/stretchr/testify/assert"
@@ -61,6 +62,37 @@ func TestNewPair(t *testing.T) {
 		assert.True(t, check.IfNil(pn))
 		assert.Equal(t, ErrNilExchanges, err)
 	})
+	t.Run("negative TWAP window", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPair()
+		args.TWAPWindow = -time.Second
+
+		pn, err := newPair(args)
+		assert.True(t, check.IfNil(pn))
+		assert.True(t, errors.Is(err, ErrInvalidWindow))
+	})
+	t.Run("negative VWAP window", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPair()
+		args.VWAPWindow = -time.Second
+
+		pn, err := newPair(args)
+		assert.True(t, check.IfNil(pn))
+		assert.True(t, errors.Is(err, ErrInvalidWindow))
+	})
+	t.Run("both TWAP and VWAP windows", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPair()
+		args.TWAPWindow = time.Minute
+		args.VWAPWindow = time.Minute
+
+		pn, err := newPair(args)
+		assert.True(t, check.IfNil(pn))
+		assert.True(t, errors.Is(err, ErrMultipleAveragePriceWindows))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
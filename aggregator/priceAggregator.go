@@ -3,26 +3,84 @@ package aggregator
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
 const minResultsNum = 1
+const defaultWeight = 1.0
 
 var log = logger.GetOrCreate("mx-sdk-go/aggregator")
 
+// SourceStatus describes how the price of a source was handled by the price aggregator
+type SourceStatus string
+
+const (
+	// SourceUsed marks a source whose price was used when computing the aggregated price
+	SourceUsed SourceStatus = "used"
+	// SourceNotSupported marks a source that does not support the pair
+	SourceNotSupported SourceStatus = "not supported"
+	// SourceFailed marks a source that could not provide the price
+	SourceFailed SourceStatus = "failed"
+	// SourceInvalidPrice marks a source that provided a non-positive or a non-finite price
+	SourceInvalidPrice SourceStatus = "invalid price"
+	// SourceStale marks a source whose price is older than the maximum accepted age
+	SourceStale SourceStatus = "stale"
+	// SourceOutlier marks a source whose price deviates too much from the median of the other sources
+	SourceOutlier SourceStatus = "outlier"
+)
+
 // ArgsPriceAggregator is the DTO used in the NewPriceAggregator function
 type ArgsPriceAggregator struct {
 	PriceFetchers []PriceFetcher
+	// MinResultsNum is the minimum number of sources that should remain after rejecting the failed, stale and
+	// outlier sources
 	MinResultsNum int
+	// Weights holds the weight of each price fetcher, by name, used when computing the weighted median. The
+	// fetchers that are not found here have a weight of 1
+	Weights map[string]float64
+	// MaxDeviationPercent rejects the prices deviating from the median by more than this percent. 0 disables the check
+	MaxDeviationPercent float64
+	// MaxDeviationMADs rejects the prices deviating from the median by more than this number of median absolute
+	// deviations. 0 disables the check
+	MaxDeviationMADs float64
+	// MaxPriceAge rejects the prices older than this duration, for the fetchers able to report the price timestamp.
+	// 0 disables the check
+	MaxPriceAge time.Duration
+}
+
+// SourcePrice holds the price provided by a source and how it was handled by the price aggregator
+type SourcePrice struct {
+	Name      string
+	Price     float64
+	Weight    float64
+	Timestamp time.Time
+	Status    SourceStatus
+	Err       error
+}
+
+// PriceResult holds the aggregated price together with the details about all the queried sources
+type PriceResult struct {
+	Base           string
+	Quote          string
+	Price          float64
+	NumUsedSources int
+	Sources        []*SourcePrice
 }
 
 type priceAggregator struct {
-	priceFetchers []PriceFetcher
-	minResultsNum int
+	priceFetchers       []PriceFetcher
+	minResultsNum       int
+	weights             map[string]float64
+	maxDeviationPercent float64
+	maxDeviationMADs    float64
+	maxPriceAge         time.Duration
+	timeSinceHandler    func(t time.Time) time.Duration
 }
 
 // NewPriceAggregator creates a new priceAggregator instance
@@ -32,9 +90,19 @@ func NewPriceAggregator(args ArgsPriceAggregator) (*priceAggregator, error) {
 		return nil, err
 	}
 
+	weights := make(map[string]float64, len(args.Weights))
+	for name, weight := range args.Weights {
+		weights[name] = weight
+	}
+
 	return &priceAggregator{
-		priceFetchers: args.PriceFetchers,
-		minResultsNum: args.MinResultsNum,
+		priceFetchers:       args.PriceFetchers,
+		minResultsNum:       args.MinResultsNum,
+		weights:             weights,
+		maxDeviationPercent: args.MaxDeviationPercent,
+		maxDeviationMADs:    args.MaxDeviationMADs,
+		maxPriceAge:         args.MaxPriceAge,
+		timeSinceHandler:    time.Since,
 	}, nil
 }
 
@@ -51,56 +119,218 @@ func checkArgs(args ArgsPriceAggregator) error {
 			return fmt.Errorf("%w, index: %d", ErrNilPriceFetcher, idx)
 		}
 	}
+	for name, weight := range args.Weights {
+		if !isValidPositiveValue(weight) {
+			return fmt.Errorf("%w, price fetcher: %s, weight: %v", ErrInvalidWeight, name, weight)
+		}
+	}
+	if args.MaxDeviationPercent < 0 || math.IsNaN(args.MaxDeviationPercent) {
+		return fmt.Errorf("%w, MaxDeviationPercent: %v", ErrInvalidMaxDeviation, args.MaxDeviationPercent)
+	}
+	if args.MaxDeviationMADs < 0 || math.IsNaN(args.MaxDeviationMADs) {
+		return fmt.Errorf("%w, MaxDeviationMADs: %v", ErrInvalidMaxDeviation, args.MaxDeviationMADs)
+	}
+	if args.MaxPriceAge < 0 {
+		return fmt.Errorf("%w, provided: %v", ErrInvalidMaxPriceAge, args.MaxPriceAge)
+	}
 
 	return nil
 }
 
 // FetchPrice will try to fetch the price based on the provided array of price fetchers
 func (pa *priceAggregator) FetchPrice(ctx context.Context, base string, quote string) (float64, error) {
-	var wg sync.WaitGroup
-	var mut sync.Mutex
-	var prices []float64
+	result, err := pa.FetchPriceResult(ctx, base, quote)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Price, nil
+}
+
+// FetchPriceResult fetches the price from all the price fetchers, rejects the failed, stale and outlier sources and
+// computes the weighted median of the remaining prices. The result describes how each source was handled and it is
+// returned even if there are not enough sources left to compute the price
+func (pa *priceAggregator) FetchPriceResult(ctx context.Context, base string, quote string) (*PriceResult, error) {
+	result := &PriceResult{
+		Base:    strings.ToUpper(base),
+		Quote:   strings.ToUpper(quote),
+		Sources: pa.fetchSourcePrices(ctx, strings.ToUpper(base), strings.ToUpper(quote)),
+	}
+
+	pa.rejectStaleSources(result.Sources)
+	pa.rejectOutliers(result.Sources)
+
+	prices, weights := usedPricesAndWeights(result.Sources)
+	result.NumUsedSources = len(prices)
+	if len(prices) < pa.minResultsNum {
+		return result, ErrNotEnoughResponses
+	}
+
+	price, err := computeWeightedMedian(prices, weights)
+	if err != nil {
+		return result, err
+	}
+	result.Price = price
+
+	return result, nil
+}
 
-	baseUpper := strings.ToUpper(base)
-	quoteUpper := strings.ToUpper(quote)
+func (pa *priceAggregator) fetchSourcePrices(ctx context.Context, base string, quote string) []*SourcePrice {
+	var wg sync.WaitGroup
+	sources := make([]*SourcePrice, len(pa.priceFetchers))
 
 	wg.Add(len(pa.priceFetchers))
-	for _, pf := range pa.priceFetchers {
-		go func(priceFetcher PriceFetcher) {
+	for idx, pf := range pa.priceFetchers {
+		go func(index int, priceFetcher PriceFetcher) {
 			defer wg.Done()
-			price, err := priceFetcher.FetchPrice(ctx, baseUpper, quoteUpper)
-
-			if err == ErrPairNotSupported {
-				log.Trace("pair not supported",
-					"price fetcher", priceFetcher.Name(),
-					"base", baseUpper,
-					"quote", quoteUpper,
-				)
-				return
-			}
-
-			if err != nil {
-				log.Debug("failed to fetch price",
-					"price fetcher", priceFetcher.Name(),
-					"base", baseUpper,
-					"quote", quoteUpper,
-					"err", err.Error(),
-				)
-				return
-			}
-
-			mut.Lock()
-			prices = append(prices, price)
-			mut.Unlock()
-		}(pf)
+			sources[index] = pa.fetchSourcePrice(ctx, priceFetcher, base, quote)
+		}(idx, pf)
 	}
 	wg.Wait()
 
-	if len(prices) < pa.minResultsNum {
-		return 0, ErrNotEnoughResponses
+	return sources
+}
+
+func (pa *priceAggregator) fetchSourcePrice(ctx context.Context, priceFetcher PriceFetcher, base string, quote string) *SourcePrice {
+	source := &SourcePrice{
+		Name:   priceFetcher.Name(),
+		Weight: pa.weightOf(priceFetcher.Name()),
+		Status: SourceUsed,
+	}
+
+	timestampedFetcher, ok := priceFetcher.(TimestampedPriceFetcher)
+	if ok {
+		source.Price, source.Timestamp, source.Err = timestampedFetcher.FetchPriceWithTimestamp(ctx, base, quote)
+	} else {
+		source.Price, source.Err = priceFetcher.FetchPrice(ctx, base, quote)
+	}
+
+	if source.Err == ErrPairNotSupported {
+		log.Trace("pair not supported",
+			"price fetcher", source.Name,
+			"base", base,
+			"quote", quote,
+		)
+		source.Status = SourceNotSupported
+		return source
+	}
+
+	if source.Err != nil {
+		log.Debug("failed to fetch price",
+			"price fetcher", source.Name,
+			"base", base,
+			"quote", quote,
+			"err", source.Err.Error(),
+		)
+		source.Status = SourceFailed
+		return source
 	}
 
-	return computeMedian(prices)
+	if !isValidPositiveValue(source.Price) {
+		log.Debug("invalid price fetched",
+			"price fetcher", source.Name,
+			"base", base,
+			"quote", quote,
+			"price", source.Price,
+		)
+		source.Status = SourceInvalidPrice
+	}
+
+	return source
+}
+
+func (pa *priceAggregator) weightOf(name string) float64 {
+	weight, found := pa.weights[name]
+	if !found {
+		return defaultWeight
+	}
+
+	return weight
+}
+
+func (pa *priceAggregator) rejectStaleSources(sources []*SourcePrice) {
+	if pa.maxPriceAge == 0 {
+		return
+	}
+
+	for _, source := range sources {
+		if source.Status != SourceUsed || source.Timestamp.IsZero() {
+			continue
+		}
+
+		age := pa.timeSinceHandler(source.Timestamp)
+		if age > pa.maxPriceAge {
+			log.Debug("stale price rejected", "price fetcher", source.Name, "age", age, "max age", pa.maxPriceAge)
+			source.Status = SourceStale
+		}
+	}
+}
+
+// rejectOutliers marks as outliers the sources whose price deviates from the weighted median of the used sources
+// by more than the maximum percent or by more than the maximum number of median absolute deviations. The MAD check
+// is skipped when more than half of the prices are equal, as the MAD is 0 in that case
+func (pa *priceAggregator) rejectOutliers(sources []*SourcePrice) {
+	if pa.maxDeviationPercent == 0 && pa.maxDeviationMADs == 0 {
+		return
+	}
+
+	prices, weights := usedPricesAndWeights(sources)
+	if len(prices) == 0 {
+		return
+	}
+
+	median, err := computeWeightedMedian(prices, weights)
+	if err != nil {
+		return
+	}
+
+	deviations := make([]float64, 0, len(prices))
+	for _, price := range prices {
+		deviations = append(deviations, math.Abs(price-median))
+	}
+	mad, err := computeMedian(deviations)
+	if err != nil {
+		return
+	}
+
+	for _, source := range sources {
+		if source.Status != SourceUsed {
+			continue
+		}
+
+		deviation := math.Abs(source.Price - median)
+		deviationPercent := deviation * 100 / median
+		isPercentOutlier := pa.maxDeviationPercent > 0 && deviationPercent > pa.maxDeviationPercent
+		isMADOutlier := pa.maxDeviationMADs > 0 && mad > 0 && deviation > pa.maxDeviationMADs*mad
+		if isPercentOutlier || isMADOutlier {
+			log.Debug("outlier price rejected",
+				"price fetcher", source.Name,
+				"price", source.Price,
+				"median", median,
+				"deviation percent", deviationPercent,
+			)
+			source.Status = SourceOutlier
+		}
+	}
+}
+
+func usedPricesAndWeights(sources []*SourcePrice) ([]float64, []float64) {
+	prices := make([]float64, 0, len(sources))
+	weights := make([]float64, 0, len(sources))
+	for _, source := range sources {
+		if source.Status != SourceUsed {
+			continue
+		}
+
+		prices = append(prices, source.Price)
+		weights = append(weights, source.Weight)
+	}
+
+	return prices, weights
+}
+
+func isValidPositiveValue(value float64) bool {
+	return value > 0 && !math.IsInf(value, 0) && !math.IsNaN(value)
 }
 
 // Name returns the name
@@ -3,7 +3,9 @@ package aggregator_test
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-sdk-go/aggregator"
@@ -129,3 +131,234 @@ func TestPriceAggregator_FetchPrice(t *testing.T) {
 		assert.Equal(t, 0.00, value)
 	})
 }
+
+func createPriceFetcherStub(name string, price float64, err error) *mock.PriceFetcherStub {
+	return &mock.PriceFetcherStub{
+		NameCalled: func() string {
+			return name
+		},
+		FetchPriceCalled: func(ctx context.Context, base string, quote string) (float64, error) {
+			return price, err
+		},
+	}
+}
+
+func sourceStatuses(result *aggregator.PriceResult) map[string]aggregator.SourceStatus {
+	statuses := make(map[string]aggregator.SourceStatus)
+	for _, source := range result.Sources {
+		statuses[source.Name] = source.Status
+	}
+
+	return statuses
+}
+
+func TestNewPriceAggregator_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid weight should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, weight := range []float64{0, -1, math.Inf(1), math.NaN()} {
+			args := createMockArgsPriceAggregator()
+			args.Weights = map[string]float64{"binance": weight}
+			pa, err := aggregator.NewPriceAggregator(args)
+
+			assert.True(t, check.IfNil(pa))
+			assert.True(t, errors.Is(err, aggregator.ErrInvalidWeight))
+		}
+	})
+	t.Run("negative max deviation percent should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.MaxDeviationPercent = -1
+		pa, err := aggregator.NewPriceAggregator(args)
+
+		assert.True(t, check.IfNil(pa))
+		assert.True(t, errors.Is(err, aggregator.ErrInvalidMaxDeviation))
+	})
+	t.Run("negative max deviation MADs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.MaxDeviationMADs = -1
+		pa, err := aggregator.NewPriceAggregator(args)
+
+		assert.True(t, check.IfNil(pa))
+		assert.True(t, errors.Is(err, aggregator.ErrInvalidMaxDeviation))
+	})
+	t.Run("negative max price age should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.MaxPriceAge = -time.Second
+		pa, err := aggregator.NewPriceAggregator(args)
+
+		assert.True(t, check.IfNil(pa))
+		assert.True(t, errors.Is(err, aggregator.ErrInvalidMaxPriceAge))
+	})
+}
+
+func TestPriceAggregator_FetchPriceResult(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should use the weights", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.PriceFetchers = []aggregator.PriceFetcher{
+			createPriceFetcherStub("a", 1.0045, nil),
+			createPriceFetcherStub("b", 1.0047, nil),
+			createPriceFetcherStub("c", 1.0049, nil),
+		}
+		args.Weights = map[string]float64{"c": 3}
+		pa, _ := aggregator.NewPriceAggregator(args)
+
+		result, err := pa.FetchPriceResult(context.Background(), "egld", "usd")
+		assert.Nil(t, err)
+		assert.Equal(t, 1.0049, result.Price)
+		assert.Equal(t, "EGLD", result.Base)
+		assert.Equal(t, "USD", result.Quote)
+		assert.Equal(t, 3, result.NumUsedSources)
+		assert.Equal(t, 3.0, result.Sources[2].Weight)
+		assert.Equal(t, 1.0, result.Sources[0].Weight)
+	})
+	t.Run("should report the failed, unsupported and invalid sources", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsPriceAggregator()
+		args.PriceFetchers = []aggregator.PriceFetcher{
+			createPriceFetcherStub("a", 1.0045, nil),
+			createPriceFetcherStub("b", 0, expectedErr),
+			createPriceFetcherStub("c", 0, aggregator.ErrPairNotSupported),
+			createPriceFetcherStub("d", 0, nil),
+			createPriceFetcherStub("e", math.NaN(), nil),
+		}
+		pa, _ := aggregator.NewPriceAggregator(args)
+
+		result, err := pa.FetchPriceResult(context.Background(), "egld", "usd")
+		assert.Nil(t, err)
+		assert.Equal(t, 1.0045, result.Price)
+		assert.Equal(t, map[string]aggregator.SourceStatus{
+			"a": aggregator.SourceUsed,
+			"b": aggregator.SourceFailed,
+			"c": aggregator.SourceNotSupported,
+			"d": aggregator.SourceInvalidPrice,
+			"e": aggregator.SourceInvalidPrice,
+		}, sourceStatuses(result))
+		assert.Equal(t, expectedErr, result.Sources[1].Err)
+	})
+	t.Run("should reject the percent deviation outliers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.PriceFetchers = []aggregator.PriceFetcher{
+			createPriceFetcherStub("a", 100, nil),
+			createPriceFetcherStub("b", 101, nil),
+			createPriceFetcherStub("c", 102, nil),
+			createPriceFetcherStub("d", 120, nil),
+		}
+		args.MaxDeviationPercent = 5
+		pa, _ := aggregator.NewPriceAggregator(args)
+
+		result, err := pa.FetchPriceResult(context.Background(), "egld", "usd")
+		assert.Nil(t, err)
+		assert.Equal(t, 101.0, result.Price)
+		assert.Equal(t, 3, result.NumUsedSources)
+		assert.Equal(t, aggregator.SourceOutlier, result.Sources[3].Status)
+	})
+	t.Run("should reject the MAD outliers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.PriceFetchers = []aggregator.PriceFetcher{
+			createPriceFetcherStub("a", 100, nil),
+			createPriceFetcherStub("b", 101, nil),
+			createPriceFetcherStub("c", 102, nil),
+			createPriceFetcherStub("d", 103, nil),
+			createPriceFetcherStub("e", 90, nil),
+		}
+		args.MaxDeviationMADs = 3
+		pa, _ := aggregator.NewPriceAggregator(args)
+
+		result, err := pa.FetchPriceResult(context.Background(), "egld", "usd")
+		assert.Nil(t, err)
+		assert.Equal(t, 101.5, result.Price)
+		assert.Equal(t, map[string]aggregator.SourceStatus{
+			"a": aggregator.SourceUsed,
+			"b": aggregator.SourceUsed,
+			"c": aggregator.SourceUsed,
+			"d": aggregator.SourceUsed,
+			"e": aggregator.SourceOutlier,
+		}, sourceStatuses(result))
+	})
+	t.Run("zero MAD should not reject the prices", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.PriceFetchers = []aggregator.PriceFetcher{
+			createPriceFetcherStub("a", 100, nil),
+			createPriceFetcherStub("b", 100, nil),
+			createPriceFetcherStub("c", 100.1, nil),
+		}
+		args.MaxDeviationMADs = 3
+		pa, _ := aggregator.NewPriceAggregator(args)
+
+		result, err := pa.FetchPriceResult(context.Background(), "egld", "usd")
+		assert.Nil(t, err)
+		assert.Equal(t, 3, result.NumUsedSources)
+	})
+	t.Run("should reject the stale sources", func(t *testing.T) {
+		t.Parallel()
+
+		updateTime := time.Unix(1000, 0)
+		args := createMockArgsPriceAggregator()
+		args.PriceFetchers = []aggregator.PriceFetcher{
+			createPriceFetcherStub("a", 100, nil),
+			&mock.TimestampedPriceFetcherStub{
+				PriceFetcherStub: mock.PriceFetcherStub{
+					NameCalled: func() string {
+						return "b"
+					},
+				},
+				FetchPriceWithTimestampCalled: func(ctx context.Context, base string, quote string) (float64, time.Time, error) {
+					return 150, updateTime, nil
+				},
+			},
+		}
+		args.MaxPriceAge = time.Minute
+		pa, _ := aggregator.NewPriceAggregator(args)
+		pa.SetTimeSinceHandler(func(t time.Time) time.Duration {
+			return time.Unix(1000, 0).Add(time.Hour).Sub(t)
+		})
+
+		result, err := pa.FetchPriceResult(context.Background(), "egld", "usd")
+		assert.Nil(t, err)
+		assert.Equal(t, 100.0, result.Price)
+		assert.Equal(t, aggregator.SourceStale, result.Sources[1].Status)
+		assert.Equal(t, updateTime, result.Sources[1].Timestamp)
+	})
+	t.Run("quorum not reached after rejections should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceAggregator()
+		args.PriceFetchers = []aggregator.PriceFetcher{
+			createPriceFetcherStub("a", 100, nil),
+			createPriceFetcherStub("b", 101, nil),
+			createPriceFetcherStub("c", 200, nil),
+		}
+		args.MinResultsNum = 3
+		args.MaxDeviationPercent = 10
+		pa, _ := aggregator.NewPriceAggregator(args)
+
+		result, err := pa.FetchPriceResult(context.Background(), "egld", "usd")
+		assert.Equal(t, aggregator.ErrNotEnoughResponses, err)
+		assert.Equal(t, 2, result.NumUsedSources)
+		assert.Equal(t, aggregator.SourceOutlier, result.Sources[2].Status)
+
+		price, err := pa.FetchPrice(context.Background(), "egld", "usd")
+		assert.Equal(t, aggregator.ErrNotEnoughResponses, err)
+		assert.Equal(t, 0.0, price)
+	})
+}
@@ -0,0 +1,194 @@
+package aggregator
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ArgsPriceHistory is the DTO used in the NewPriceHistory function
+type ArgsPriceHistory struct {
+	// RetentionPeriod is the maximum age of the stored price points and also the largest window accepted when
+	// computing the average prices
+	RetentionPeriod time.Duration
+}
+
+// PricePoint holds a price of a pair at a given time. The volume is optional and it is used only when computing
+// the volume weighted average price
+type PricePoint struct {
+	Price     float64
+	Volume    float64
+	Timestamp time.Time
+}
+
+type priceHistory struct {
+	mut              sync.RWMutex
+	series           map[string][]PricePoint
+	retentionPeriod  time.Duration
+	timeSinceHandler func(t time.Time) time.Duration
+}
+
+// NewPriceHistory creates a new price history instance that keeps the price series of each pair in memory
+func NewPriceHistory(args ArgsPriceHistory) (*priceHistory, error) {
+	if args.RetentionPeriod <= 0 {
+		return nil, fmt.Errorf("%w, provided: %v", ErrInvalidRetentionPeriod, args.RetentionPeriod)
+	}
+
+	return &priceHistory{
+		series:           make(map[string][]PricePoint),
+		retentionPeriod:  args.RetentionPeriod,
+		timeSinceHandler: time.Since,
+	}, nil
+}
+
+// AddPrice appends the price point to the series of the pair. The points should be added in chronological order
+func (ph *priceHistory) AddPrice(base string, quote string, point PricePoint) error {
+	if !isValidPositiveValue(point.Price) {
+		return fmt.Errorf("%w for %s-%s, price: %v", ErrInvalidPricePoint, base, quote, point.Price)
+	}
+	if point.Volume < 0 || math.IsInf(point.Volume, 0) || math.IsNaN(point.Volume) {
+		return fmt.Errorf("%w for %s-%s, volume: %v", ErrInvalidPricePoint, base, quote, point.Volume)
+	}
+
+	ph.mut.Lock()
+	defer ph.mut.Unlock()
+
+	key := pairKey(base, quote)
+	points := ph.series[key]
+	if len(points) > 0 && point.Timestamp.Before(points[len(points)-1].Timestamp) {
+		return fmt.Errorf("%w for %s-%s, timestamp: %v, last timestamp: %v", ErrOutOfOrderPricePoint,
+			base, quote, point.Timestamp, points[len(points)-1].Timestamp)
+	}
+
+	ph.series[key] = ph.prune(append(points, point))
+
+	return nil
+}
+
+// prune removes the points older than the retention period, keeping the newest of them as it holds the price at the
+// beginning of the retention period
+func (ph *priceHistory) prune(points []PricePoint) []PricePoint {
+	firstIndex := 0
+	for idx := range points {
+		if ph.timeSinceHandler(points[idx].Timestamp) <= ph.retentionPeriod {
+			break
+		}
+		firstIndex = idx
+	}
+	if firstIndex == 0 {
+		return points
+	}
+
+	return append(make([]PricePoint, 0, len(points)-firstIndex), points[firstIndex:]...)
+}
+
+// GetPriceSeries returns the price points of the pair that are not older than the provided window
+func (ph *priceHistory) GetPriceSeries(base string, quote string, window time.Duration) ([]PricePoint, error) {
+	err := ph.checkWindow(window)
+	if err != nil {
+		return nil, err
+	}
+
+	ph.mut.RLock()
+	defer ph.mut.RUnlock()
+
+	points := ph.series[pairKey(base, quote)]
+	firstIndex := len(points)
+	for firstIndex > 0 && ph.timeSinceHandler(points[firstIndex-1].Timestamp) <= window {
+		firstIndex--
+	}
+
+	return append(make([]PricePoint, 0, len(points)-firstIndex), points[firstIndex:]...), nil
+}
+
+// ComputeTWAP returns the time weighted average price of the pair over the provided window. Each price is weighted by
+// the time it was held, until the next price point or until now for the latest one. The price held at the beginning
+// of the window is the one of the newest point preceding the window
+func (ph *priceHistory) ComputeTWAP(base string, quote string, window time.Duration) (float64, error) {
+	err := ph.checkWindow(window)
+	if err != nil {
+		return 0, err
+	}
+
+	ph.mut.RLock()
+	defer ph.mut.RUnlock()
+
+	points := ph.series[pairKey(base, quote)]
+	if len(points) == 0 {
+		return 0, fmt.Errorf("%w for %s-%s", ErrNoPriceHistory, base, quote)
+	}
+
+	weightedSum := 0.0
+	totalDuration := time.Duration(0)
+	// the points are walked from the newest one, the ages marking the beginning and the end of the held intervals
+	intervalEnd := time.Duration(0)
+	for idx := len(points) - 1; idx >= 0; idx-- {
+		intervalStart := ph.timeSinceHandler(points[idx].Timestamp)
+		if intervalStart > window {
+			intervalStart = window
+		}
+
+		duration := intervalStart - intervalEnd
+		if duration > 0 {
+			weightedSum += points[idx].Price * duration.Seconds()
+			totalDuration += duration
+			intervalEnd = intervalStart
+		}
+		if intervalStart == window {
+			break
+		}
+	}
+
+	if totalDuration == 0 {
+		return points[len(points)-1].Price, nil
+	}
+
+	return weightedSum / totalDuration.Seconds(), nil
+}
+
+// ComputeVWAP returns the volume weighted average price of the pair, using the price points that are not older than
+// the provided window
+func (ph *priceHistory) ComputeVWAP(base string, quote string, window time.Duration) (float64, error) {
+	points, err := ph.GetPriceSeries(base, quote, window)
+	if err != nil {
+		return 0, err
+	}
+	if len(points) == 0 {
+		return 0, fmt.Errorf("%w for %s-%s in the last %v", ErrNoPriceHistory, base, quote, window)
+	}
+
+	weightedSum := 0.0
+	totalVolume := 0.0
+	for _, point := range points {
+		weightedSum += point.Price * point.Volume
+		totalVolume += point.Volume
+	}
+	if totalVolume == 0 {
+		return 0, fmt.Errorf("%w for %s-%s in the last %v", ErrNoPriceVolume, base, quote, window)
+	}
+
+	return weightedSum / totalVolume, nil
+}
+
+// RetentionPeriod returns the maximum age of the stored price points
+func (ph *priceHistory) RetentionPeriod() time.Duration {
+	return ph.retentionPeriod
+}
+
+func (ph *priceHistory) checkWindow(window time.Duration) error {
+	if window <= 0 || window > ph.retentionPeriod {
+		return fmt.Errorf("%w, provided: %v, retention period: %v", ErrInvalidWindow, window, ph.retentionPeriod)
+	}
+
+	return nil
+}
+
+func pairKey(base string, quote string) string {
+	return fmt.Sprintf("%s-%s", base, quote)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ph *priceHistory) IsInterfaceNil() bool {
+	return ph == nil
+}
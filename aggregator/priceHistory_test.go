@@ -0,0 +1,200 @@
+package aggregator_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/aggregator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPriceHistoryAt(tb testing.TB, now time.Time) aggregator.PriceHistory {
+	ph, err := aggregator.NewPriceHistory(aggregator.ArgsPriceHistory{
+		RetentionPeriod: time.Hour,
+	})
+	require.Nil(tb, err)
+	ph.SetTimeSinceHandler(func(t time.Time) time.Duration {
+		return now.Sub(t)
+	})
+
+	return ph
+}
+
+func TestNewPriceHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid retention period should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph, err := aggregator.NewPriceHistory(aggregator.ArgsPriceHistory{})
+		assert.True(t, check.IfNil(ph))
+		assert.True(t, errors.Is(err, aggregator.ErrInvalidRetentionPeriod))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ph, err := aggregator.NewPriceHistory(aggregator.ArgsPriceHistory{
+			RetentionPeriod: time.Hour,
+		})
+		assert.False(t, check.IfNil(ph))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Hour, ph.RetentionPeriod())
+	})
+}
+
+func TestPriceHistory_AddPrice(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("invalid price should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		for _, price := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+			err := ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: price, Timestamp: now})
+			assert.True(t, errors.Is(err, aggregator.ErrInvalidPricePoint))
+		}
+	})
+	t.Run("invalid volume should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		err := ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 1, Volume: -1, Timestamp: now})
+		assert.True(t, errors.Is(err, aggregator.ErrInvalidPricePoint))
+	})
+	t.Run("out of order point should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 1, Timestamp: now}))
+		err := ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 2, Timestamp: now.Add(-time.Second)})
+		assert.True(t, errors.Is(err, aggregator.ErrOutOfOrderPricePoint))
+
+		err = ph.AddPrice("BTC", "USD", aggregator.PricePoint{Price: 2, Timestamp: now.Add(-time.Second)})
+		assert.Nil(t, err)
+	})
+	t.Run("should prune the points older than the retention period", func(t *testing.T) {
+		t.Parallel()
+
+		ph, _ := aggregator.NewPriceHistory(aggregator.ArgsPriceHistory{
+			RetentionPeriod: time.Hour,
+		})
+		ph.SetTimeSinceHandler(func(t time.Time) time.Duration {
+			return now.Sub(t)
+		})
+		for _, age := range []time.Duration{time.Hour * 3, time.Hour * 2, time.Minute * 30, 0} {
+			require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 1, Timestamp: now.Add(-age)}))
+		}
+
+		assert.Equal(t, 3, ph.NumPricePoints("EGLD", "USD"))
+		points, err := ph.GetPriceSeries("EGLD", "USD", time.Hour)
+		assert.Nil(t, err)
+		require.Len(t, points, 2)
+		assert.Equal(t, now.Add(-time.Minute*30), points[0].Timestamp)
+		assert.Equal(t, now, points[1].Timestamp)
+	})
+}
+
+func TestPriceHistory_ComputeTWAP(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("invalid window should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		for _, window := range []time.Duration{0, time.Hour + time.Second} {
+			twap, err := ph.ComputeTWAP("EGLD", "USD", window)
+			assert.True(t, errors.Is(err, aggregator.ErrInvalidWindow))
+			assert.Equal(t, 0.0, twap)
+		}
+	})
+	t.Run("no history should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		twap, err := ph.ComputeTWAP("EGLD", "USD", time.Minute)
+		assert.True(t, errors.Is(err, aggregator.ErrNoPriceHistory))
+		assert.Equal(t, 0.0, twap)
+	})
+	t.Run("single point just added should return its price", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 42, Timestamp: now}))
+		twap, err := ph.ComputeTWAP("EGLD", "USD", time.Minute)
+		assert.Nil(t, err)
+		assert.Equal(t, 42.0, twap)
+	})
+	t.Run("should weight the prices by the time they were held", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		// 10 held from -20m to -10m (clipped at -15m by the window), 20 held from -10m to -5m, 40 held until now
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 10, Timestamp: now.Add(-time.Minute * 20)}))
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 20, Timestamp: now.Add(-time.Minute * 10)}))
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 40, Timestamp: now.Add(-time.Minute * 5)}))
+
+		twap, err := ph.ComputeTWAP("EGLD", "USD", time.Minute*15)
+		assert.Nil(t, err)
+		assert.InDelta(t, (10.0*5+20*5+40*5)/15, twap, 1e-9)
+
+		twap, err = ph.ComputeTWAP("EGLD", "USD", time.Minute*2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 40.0, twap, 1e-9)
+	})
+	t.Run("a spike should have a limited effect", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 40, Timestamp: now.Add(-time.Minute * 10)}))
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 400, Timestamp: now.Add(-time.Second * 6)}))
+
+		twap, err := ph.ComputeTWAP("EGLD", "USD", time.Minute*10)
+		assert.Nil(t, err)
+		assert.InDelta(t, 40.0*0.99+400*0.01, twap, 1e-9)
+	})
+}
+
+func TestPriceHistory_ComputeVWAP(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("no points in the window should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 10, Volume: 1, Timestamp: now.Add(-time.Minute * 20)}))
+		vwap, err := ph.ComputeVWAP("EGLD", "USD", time.Minute)
+		assert.True(t, errors.Is(err, aggregator.ErrNoPriceHistory))
+		assert.Equal(t, 0.0, vwap)
+	})
+	t.Run("no volume should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 10, Timestamp: now}))
+		vwap, err := ph.ComputeVWAP("EGLD", "USD", time.Minute)
+		assert.True(t, errors.Is(err, aggregator.ErrNoPriceVolume))
+		assert.Equal(t, 0.0, vwap)
+	})
+	t.Run("should weight the prices in the window by their volume", func(t *testing.T) {
+		t.Parallel()
+
+		ph := createPriceHistoryAt(t, now)
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 100, Volume: 100, Timestamp: now.Add(-time.Minute * 20)}))
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 10, Volume: 3, Timestamp: now.Add(-time.Minute * 10)}))
+		require.Nil(t, ph.AddPrice("EGLD", "USD", aggregator.PricePoint{Price: 20, Volume: 1, Timestamp: now}))
+
+		vwap, err := ph.ComputeVWAP("EGLD", "USD", time.Minute*15)
+		assert.Nil(t, err)
+		assert.InDelta(t, 12.5, vwap, 1e-9)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,6 +20,12 @@ type ArgsPriceNotifier struct {
 	Aggregator       PriceAggregator
 	Notifee          PriceNotifee
 	AutoSendInterval time.Duration
+	// PriceHistory, if set, records the spot price of each pair on every execution. It is required by the pairs
+	// publishing a time or volume weighted average price
+	PriceHistory PriceHistory
+	// VolumeFetcher provides the volume recorded along with the spot price of the pairs publishing a volume weighted
+	// average price and it is required only by them
+	VolumeFetcher VolumeFetcher
 }
 
 type priceInfo struct {
@@ -42,6 +49,8 @@ type priceNotifier struct {
 	autoSendInterval   time.Duration
 	lastTimeAutoSent   time.Time
 	timeSinceHandler   func(t time.Time) time.Duration
+	priceHistory       PriceHistory
+	volumeFetcher      VolumeFetcher
 }
 
 // NewPriceNotifier will create a new priceNotifier instance
@@ -60,6 +69,10 @@ func NewPriceNotifier(args ArgsPriceNotifier) (*priceNotifier, error) {
 		if err != nil {
 			return nil, err
 		}
+		err = checkPairAveragePriceWindow(pair, args)
+		if err != nil {
+			return nil, err
+		}
 		pairs = append(pairs, pair)
 	}
 
@@ -71,6 +84,8 @@ func NewPriceNotifier(args ArgsPriceNotifier) (*priceNotifier, error) {
 		autoSendInterval:   args.AutoSendInterval,
 		lastTimeAutoSent:   time.Now(),
 		timeSinceHandler:   time.Since,
+		priceHistory:       args.PriceHistory,
+		volumeFetcher:      args.VolumeFetcher,
 	}, nil
 }
 
@@ -92,37 +107,118 @@ func checkArgsPriceNotifier(args ArgsPriceNotifier) error {
 	return nil
 }
 
-// Execute will trigger the price fetching and notification if the new price exceeded provided percentage change
+func checkPairAveragePriceWindow(pair *pair, args ArgsPriceNotifier) error {
+	window := pair.averagePriceWindow()
+	if window == 0 {
+		return nil
+	}
+	if check.IfNil(args.PriceHistory) {
+		return fmt.Errorf("%w, pair %s-%s requires it for the average price", ErrNilPriceHistory, pair.base, pair.quote)
+	}
+	if window > args.PriceHistory.RetentionPeriod() {
+		return fmt.Errorf("%w for the pair %s-%s, average price window: %v, price history retention period: %v",
+			ErrInvalidWindow, pair.base, pair.quote, window, args.PriceHistory.RetentionPeriod())
+	}
+	if pair.vwapWindow > 0 && check.IfNil(args.VolumeFetcher) {
+		return fmt.Errorf("%w, pair %s-%s requires it for the VWAP", ErrNilVolumeFetcher, pair.base, pair.quote)
+	}
+
+	return nil
+}
+
+// Execute will trigger the price fetching and notification if the new price exceeded provided percentage change. A
+// pair whose published price can not be computed is skipped, the other pairs being notified before the error is returned
 func (pn *priceNotifier) Execute(ctx context.Context) error {
-	fetchedPrices, err := pn.getAllPrices(ctx)
+	spotPrices, err := pn.fetchAllPrices(ctx)
 	if err != nil {
 		return err
 	}
 
+	fetchedPrices, errPrices := pn.computeAllPrices(ctx, spotPrices)
 	notifyArgsSlice := pn.computeNotifyArgsSlice(fetchedPrices)
 
-	return pn.notify(ctx, notifyArgsSlice)
+	err = pn.notify(ctx, notifyArgsSlice)
+	if err != nil {
+		return err
+	}
+
+	return errPrices
 }
 
-func (pn *priceNotifier) getAllPrices(ctx context.Context) ([]priceInfo, error) {
-	fetchedPrices := make([]priceInfo, len(pn.pairs))
+func (pn *priceNotifier) fetchAllPrices(ctx context.Context) ([]float64, error) {
+	spotPrices := make([]float64, len(pn.pairs))
 	for idx, pair := range pn.pairs {
 		price, err := pn.priceAggregator.FetchPrice(ctx, pair.base, pair.quote)
 		if err != nil {
 			return nil, fmt.Errorf("%w while querying the pair %s-%s", err, pair.base, pair.quote)
 		}
 
-		fetchedPrice := priceInfo{
+		spotPrices[idx] = price
+	}
+
+	return spotPrices, nil
+}
+
+// computeAllPrices returns the prices to be published, a nil price marking a pair that could not be computed
+func (pn *priceNotifier) computeAllPrices(ctx context.Context, spotPrices []float64) ([]*priceInfo, error) {
+	fetchedPrices := make([]*priceInfo, len(pn.pairs))
+	failedPairs := make([]string, 0)
+	for idx, pair := range pn.pairs {
+		price, err := pn.computePairPrice(ctx, pair, spotPrices[idx])
+		if err != nil {
+			log.Warn("priceNotifier: could not compute the price, skipping the pair",
+				"base", pair.base, "quote", pair.quote, "error", err)
+			failedPairs = append(failedPairs, fmt.Sprintf("%s-%s: %s", pair.base, pair.quote, err.Error()))
+			continue
+		}
+
+		fetchedPrices[idx] = &priceInfo{
 			price:     trim(price, pair.trimPrecision),
 			timestamp: time.Now().Unix(),
 		}
-		fetchedPrices[idx] = fetchedPrice
+	}
+	if len(failedPairs) == 0 {
+		return fetchedPrices, nil
+	}
+
+	return fetchedPrices, fmt.Errorf("%w, %d out of %d: %s", ErrPairsPriceUnavailable,
+		len(failedPairs), len(pn.pairs), strings.Join(failedPairs, "; "))
+}
+
+// computePairPrice records the spot price in the price history, if any, and returns the price to be published
+func (pn *priceNotifier) computePairPrice(ctx context.Context, pair *pair, spotPrice float64) (float64, error) {
+	if check.IfNil(pn.priceHistory) {
+		return spotPrice, nil
+	}
+
+	point := PricePoint{
+		Price:     spotPrice,
+		Timestamp: time.Now(),
+	}
+	if pair.vwapWindow > 0 {
+		volume, err := pn.volumeFetcher.FetchVolume(ctx, pair.base, pair.quote)
+		if err != nil {
+			return 0, fmt.Errorf("%w while querying the volume", err)
+		}
+		point.Volume = volume
+	}
+
+	err := pn.priceHistory.AddPrice(pair.base, pair.quote, point)
+	if err != nil {
+		return 0, err
 	}
 
-	return fetchedPrices, nil
+	switch {
+	case pair.twapWindow > 0:
+		return pn.priceHistory.ComputeTWAP(pair.base, pair.quote, pair.twapWindow)
+	case pair.vwapWindow > 0:
+		return pn.priceHistory.ComputeVWAP(pair.base, pair.quote, pair.vwapWindow)
+	default:
+		return spotPrice, nil
+	}
 }
 
-func (pn *priceNotifier) computeNotifyArgsSlice(fetchedPrices []priceInfo) []*notifyArgs {
+func (pn *priceNotifier) computeNotifyArgsSlice(fetchedPrices []*priceInfo) []*notifyArgs {
 	pn.mut.Lock()
 	defer pn.mut.Unlock()
 
@@ -130,9 +226,13 @@ func (pn *priceNotifier) computeNotifyArgsSlice(fetchedPrices []priceInfo) []*no
 
 	result := make([]*notifyArgs, 0, len(pn.pairs))
 	for idx, pair := range pn.pairs {
+		if fetchedPrices[idx] == nil {
+			continue
+		}
+
 		notifyArgsValue := &notifyArgs{
 			pair:              pair,
-			newPrice:          fetchedPrices[idx],
+			newPrice:          *fetchedPrices[idx],
 			lastNotifiedPrice: pn.lastNotifiedPrices[idx],
 			index:             idx,
 		}
@@ -86,6 +86,62 @@ func TestNewPriceNotifier(t *testing.T) {
 		assert.True(t, check.IfNil(pn))
 		assert.Equal(t, aggregator.ErrNilPriceAggregator, err)
 	})
+	t.Run("TWAP pair without price history should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceNotifier()
+		args.Pairs[0].TWAPWindow = time.Minute
+
+		pn, err := aggregator.NewPriceNotifier(args)
+		assert.True(t, check.IfNil(pn))
+		assert.True(t, errors.Is(err, aggregator.ErrNilPriceHistory))
+	})
+	t.Run("TWAP window larger than the price history retention period should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceNotifier()
+		args.Pairs[0].TWAPWindow = time.Hour
+		args.PriceHistory = &mock.PriceHistoryStub{
+			RetentionPeriodCalled: func() time.Duration {
+				return time.Minute * 30
+			},
+		}
+
+		pn, err := aggregator.NewPriceNotifier(args)
+		assert.True(t, check.IfNil(pn))
+		assert.True(t, errors.Is(err, aggregator.ErrInvalidWindow))
+	})
+	t.Run("VWAP pair without volume fetcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceNotifier()
+		args.Pairs[0].VWAPWindow = time.Minute
+		args.PriceHistory = &mock.PriceHistoryStub{
+			RetentionPeriodCalled: func() time.Duration {
+				return time.Hour
+			},
+		}
+
+		pn, err := aggregator.NewPriceNotifier(args)
+		assert.True(t, check.IfNil(pn))
+		assert.True(t, errors.Is(err, aggregator.ErrNilVolumeFetcher))
+	})
+	t.Run("VWAP window larger than the price history retention period should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceNotifier()
+		args.Pairs[0].VWAPWindow = time.Hour
+		args.PriceHistory = &mock.PriceHistoryStub{
+			RetentionPeriodCalled: func() time.Duration {
+				return time.Minute * 30
+			},
+		}
+		args.VolumeFetcher = &mock.VolumeFetcherStub{}
+
+		pn, err := aggregator.NewPriceNotifier(args)
+		assert.True(t, check.IfNil(pn))
+		assert.True(t, errors.Is(err, aggregator.ErrInvalidWindow))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -341,4 +397,202 @@ func TestPriceNotifier_Execute(t *testing.T) {
 
 		assert.Equal(t, 2, numCalled)
 	})
+	t.Run("price history error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsPriceNotifier()
+		args.PriceHistory = &mock.PriceHistoryStub{
+			AddPriceCalled: func(base string, quote string, point aggregator.PricePoint) error {
+				return expectedErr
+			},
+		}
+		args.Notifee = &mock.PriceNotifeeStub{
+			PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+				assert.Fail(t, "should have not called notifee.PriceChanged")
+				return nil
+			},
+		}
+
+		pn, _ := aggregator.NewPriceNotifier(args)
+		err := pn.Execute(context.Background())
+		assert.True(t, errors.Is(err, aggregator.ErrPairsPriceUnavailable))
+		assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
+	})
+	t.Run("price history error for a pair should not block the other pairs", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsPriceNotifier()
+		args.Pairs = append(args.Pairs, &aggregator.ArgsPair{
+			Base:                      "BASE2",
+			Quote:                     "QUOTE2",
+			PercentDifferenceToNotify: 1,
+			Decimals:                  2,
+			Exchanges:                 map[string]struct{}{"Binance": {}},
+		})
+		args.Aggregator = &mock.PriceFetcherStub{
+			FetchPriceCalled: func(ctx context.Context, base string, quote string) (float64, error) {
+				return 1.987654321, nil
+			},
+		}
+		args.PriceHistory = &mock.PriceHistoryStub{
+			AddPriceCalled: func(base string, quote string, point aggregator.PricePoint) error {
+				if base == "BASE" {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+		wasCalled := false
+		args.Notifee = &mock.PriceNotifeeStub{
+			PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+				require.Equal(t, 1, len(args))
+				assert.Equal(t, "BASE2", args[0].Base)
+				assert.Equal(t, "QUOTE2", args[0].Quote)
+				assert.Equal(t, uint64(199), args[0].DenominatedPrice)
+				wasCalled = true
+
+				return nil
+			},
+		}
+
+		pn, _ := aggregator.NewPriceNotifier(args)
+		err := pn.Execute(context.Background())
+		assert.True(t, errors.Is(err, aggregator.ErrPairsPriceUnavailable))
+		assert.True(t, strings.Contains(err.Error(), "1 out of 2"))
+		assert.True(t, strings.Contains(err.Error(), "BASE-QUOTE"))
+		assert.True(t, wasCalled)
+	})
+	t.Run("should record the spot price and notify the TWAP", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceNotifier()
+		args.Pairs[0].TWAPWindow = time.Minute * 5
+		args.Aggregator = &mock.PriceFetcherStub{
+			FetchPriceCalled: func(ctx context.Context, base string, quote string) (float64, error) {
+				return 1.987654321, nil
+			},
+		}
+		var recordedPoints []aggregator.PricePoint
+		args.PriceHistory = &mock.PriceHistoryStub{
+			AddPriceCalled: func(base string, quote string, point aggregator.PricePoint) error {
+				assert.Equal(t, "BASE", base)
+				assert.Equal(t, "QUOTE", quote)
+				recordedPoints = append(recordedPoints, point)
+				return nil
+			},
+			ComputeTWAPCalled: func(base string, quote string, window time.Duration) (float64, error) {
+				assert.Equal(t, time.Minute*5, window)
+				return 1.5, nil
+			},
+			RetentionPeriodCalled: func() time.Duration {
+				return time.Hour
+			},
+		}
+		wasCalled := false
+		args.Notifee = &mock.PriceNotifeeStub{
+			PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+				require.Equal(t, 1, len(args))
+				assert.Equal(t, uint64(150), args[0].DenominatedPrice)
+				wasCalled = true
+
+				return nil
+			},
+		}
+
+		pn, _ := aggregator.NewPriceNotifier(args)
+		err := pn.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasCalled)
+		require.Len(t, recordedPoints, 1)
+		assert.Equal(t, 1.987654321, recordedPoints[0].Price)
+	})
+	t.Run("should record the spot price with its volume and notify the VWAP", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPriceNotifier()
+		args.Pairs[0].VWAPWindow = time.Minute * 5
+		args.Aggregator = &mock.PriceFetcherStub{
+			FetchPriceCalled: func(ctx context.Context, base string, quote string) (float64, error) {
+				return 1.987654321, nil
+			},
+		}
+		args.VolumeFetcher = &mock.VolumeFetcherStub{
+			FetchVolumeCalled: func(ctx context.Context, base string, quote string) (float64, error) {
+				assert.Equal(t, "BASE", base)
+				assert.Equal(t, "QUOTE", quote)
+				return 37, nil
+			},
+		}
+		var recordedPoints []aggregator.PricePoint
+		args.PriceHistory = &mock.PriceHistoryStub{
+			AddPriceCalled: func(base string, quote string, point aggregator.PricePoint) error {
+				recordedPoints = append(recordedPoints, point)
+				return nil
+			},
+			ComputeTWAPCalled: func(base string, quote string, window time.Duration) (float64, error) {
+				assert.Fail(t, "should have not called ComputeTWAP")
+				return 0, nil
+			},
+			ComputeVWAPCalled: func(base string, quote string, window time.Duration) (float64, error) {
+				assert.Equal(t, time.Minute*5, window)
+				return 1.25, nil
+			},
+			RetentionPeriodCalled: func() time.Duration {
+				return time.Hour
+			},
+		}
+		wasCalled := false
+		args.Notifee = &mock.PriceNotifeeStub{
+			PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+				require.Equal(t, 1, len(args))
+				assert.Equal(t, uint64(125), args[0].DenominatedPrice)
+				wasCalled = true
+
+				return nil
+			},
+		}
+
+		pn, _ := aggregator.NewPriceNotifier(args)
+		err := pn.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasCalled)
+		require.Len(t, recordedPoints, 1)
+		assert.Equal(t, 1.987654321, recordedPoints[0].Price)
+		assert.Equal(t, 37.0, recordedPoints[0].Volume)
+	})
+	t.Run("volume fetch error should skip the pair", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsPriceNotifier()
+		args.Pairs[0].VWAPWindow = time.Minute * 5
+		args.VolumeFetcher = &mock.VolumeFetcherStub{
+			FetchVolumeCalled: func(ctx context.Context, base string, quote string) (float64, error) {
+				return 0, expectedErr
+			},
+		}
+		args.PriceHistory = &mock.PriceHistoryStub{
+			AddPriceCalled: func(base string, quote string, point aggregator.PricePoint) error {
+				assert.Fail(t, "should have not recorded the price")
+				return nil
+			},
+			RetentionPeriodCalled: func() time.Duration {
+				return time.Hour
+			},
+		}
+		args.Notifee = &mock.PriceNotifeeStub{
+			PriceChangedCalled: func(ctx context.Context, args []*aggregator.ArgsPriceChanged) error {
+				assert.Fail(t, "should have not called notifee.PriceChanged")
+				return nil
+			},
+		}
+
+		pn, _ := aggregator.NewPriceNotifier(args)
+		err := pn.Execute(context.Background())
+		assert.True(t, errors.Is(err, aggregator.ErrPairsPriceUnavailable))
+		assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
+	})
 }
@@ -0,0 +1,12 @@
+package bls
+
+import "errors"
+
+// ErrNilPemKey signals that a nil PEM key was provided
+var ErrNilPemKey = errors.New("nil PEM key")
+
+// ErrNotBLSKey signals that the provided key is not a BLS key
+var ErrNotBLSKey = errors.New("not a BLS key")
+
+// ErrPublicKeyMismatch signals that the public key does not match the private key
+var ErrPublicKeyMismatch = errors.New("public key does not match the private key")
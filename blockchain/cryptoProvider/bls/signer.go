@@ -0,0 +1,102 @@
+package bls
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-sdk-go/interactors"
+)
+
+var (
+	keyGenerator = signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	singleSigner = &singlesig.BlsSingleSigner{}
+)
+
+// signer contains the primitives used to generate BLS keys and to sign and verify messages with them, the same way
+// the validators do
+type signer struct{}
+
+// NewSigner will create a new instance of signer
+func NewSigner() *signer {
+	return &signer{}
+}
+
+// GeneratePrivateKey generates a new BLS private key
+func (s *signer) GeneratePrivateKey() ([]byte, error) {
+	privateKey, _ := keyGenerator.GeneratePair()
+
+	return privateKey.ToByteArray()
+}
+
+// GeneratePublicKey returns the BLS public key of the provided private key
+func (s *signer) GeneratePublicKey(privateKey []byte) ([]byte, error) {
+	sk, err := keyGenerator.PrivateKeyFromByteArray(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return sk.GeneratePublic().ToByteArray()
+}
+
+// Sign returns the BLS signature of the message
+func (s *signer) Sign(message []byte, privateKey []byte) ([]byte, error) {
+	sk, err := keyGenerator.PrivateKeyFromByteArray(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleSigner.Sign(sk, message)
+}
+
+// Verify checks the BLS signature of the message
+func (s *signer) Verify(publicKey []byte, message []byte, signature []byte) error {
+	pk, err := keyGenerator.PublicKeyFromByteArray(publicKey)
+	if err != nil {
+		return err
+	}
+
+	return singleSigner.Verify(pk, message, signature)
+}
+
+// NewPemKey returns a BLS PEM key for the provided private key, ready to be saved in a validatorKey.pem file
+func (s *signer) NewPemKey(privateKey []byte) (*interactors.PemKey, error) {
+	publicKey, err := s.GeneratePublicKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interactors.PemKey{
+		KeyType:    interactors.KeyTypeBLS,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+	}, nil
+}
+
+// CheckPemKey checks that the provided PEM key is a BLS key whose public key, taken from the PEM label, matches
+// the private key
+func (s *signer) CheckPemKey(key *interactors.PemKey) error {
+	if key == nil {
+		return ErrNilPemKey
+	}
+	if key.KeyType != interactors.KeyTypeBLS {
+		return fmt.Errorf("%w: %s", ErrNotBLSKey, key.KeyType)
+	}
+
+	publicKey, err := s.GeneratePublicKey(key.PrivateKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(publicKey, key.PublicKey) {
+		return ErrPublicKeyMismatch
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *signer) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,95 @@
+package bls
+
+import (
+	"encoding/hex"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPrivateKey = "7cff99bd671502db7d15bc8abc0c9a804fb925406fbdd50f1e4c17a4cd774247"
+	testPublicKey  = "e7beaa95b3877f47348df4dd1cb578a4f7cabf7a20bfeefe5cdd263878ff132b765e04fef6f40c93512b666c47ed7719b8902f6c922c04247989b7137e837cc81a62e54712471c97a2ddab75aa9c2f58f813ed4c0fa722bde0ab718bff382208"
+	testSignature  = "84fd0a3a9d4f1ea2d4b40c6da67f9b786284a1c3895b7253fec7311597cda3f757862bb0690a92a13ce612c33889fd86"
+)
+
+func decodeHex(tb testing.TB, hexString string) []byte {
+	buff, err := hex.DecodeString(hexString)
+	require.Nil(tb, err)
+
+	return buff
+}
+
+func TestSigner_SignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	s := NewSigner()
+	assert.False(t, s.IsInterfaceNil())
+
+	publicKey, err := s.GeneratePublicKey(decodeHex(t, testPrivateKey))
+	assert.Nil(t, err)
+	assert.Equal(t, testPublicKey, hex.EncodeToString(publicKey))
+
+	signature, err := s.Sign([]byte("hello"), decodeHex(t, testPrivateKey))
+	assert.Nil(t, err)
+	assert.Equal(t, testSignature, hex.EncodeToString(signature))
+
+	assert.Nil(t, s.Verify(publicKey, []byte("hello"), signature))
+	assert.NotNil(t, s.Verify(publicKey, []byte("hello world"), signature))
+
+	_, err = s.Sign([]byte("hello"), []byte("invalid"))
+	assert.NotNil(t, err)
+
+	privateKey, err := s.GeneratePrivateKey()
+	assert.Nil(t, err)
+	assert.Equal(t, 32, len(privateKey))
+}
+
+func TestSigner_PemKeys(t *testing.T) {
+	t.Parallel()
+
+	s := NewSigner()
+	t.Run("check invalid keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ErrNilPemKey, s.CheckPemKey(nil))
+		assert.True(t, errors.Is(s.CheckPemKey(&interactors.PemKey{KeyType: interactors.KeyTypeEd25519}), ErrNotBLSKey))
+
+		key, err := s.NewPemKey(decodeHex(t, testPrivateKey))
+		require.Nil(t, err)
+		key.PublicKey[0] ^= 0xFF
+		assert.Equal(t, ErrPublicKeyMismatch, s.CheckPemKey(key))
+	})
+	t.Run("save, load and sign should work", func(t *testing.T) {
+		t.Parallel()
+
+		generatedPrivateKey, err := s.GeneratePrivateKey()
+		require.Nil(t, err)
+
+		firstKey, err := s.NewPemKey(decodeHex(t, testPrivateKey))
+		require.Nil(t, err)
+		secondKey, err := s.NewPemKey(generatedPrivateKey)
+		require.Nil(t, err)
+
+		w := interactors.NewWallet()
+		filename := path.Join(t.TempDir(), "validatorKey.pem")
+		err = w.SavePrivateKeysToPemFile([]*interactors.PemKey{firstKey, secondKey}, filename)
+		require.Nil(t, err)
+
+		loaded, err := w.LoadAllPrivateKeysFromPemFile(filename)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(loaded))
+		for _, key := range loaded {
+			assert.Nil(t, s.CheckPemKey(key))
+		}
+		assert.Equal(t, "PRIVATE KEY for "+testPublicKey, loaded[0].Label)
+
+		signature, err := s.Sign([]byte("hello"), loaded[0].PrivateKey)
+		assert.Nil(t, err)
+		assert.Equal(t, testSignature, hex.EncodeToString(signature))
+	})
+}
@@ -24,6 +24,8 @@ const (
 	nft                        = "address/%s/nft/%s/nonce/%d"
 	nodeGetGuardianData        = "address/%s/guardian-data"
 	isDataTrieMigrated         = "address/%s/is-data-trie-migrated"
+	proof                      = "proof/root-hash/%s/address/%s"
+	proofDataTrie              = "proof/root-hash/%s/address/%s/key/%s"
 )
 
 type baseEndpointProvider struct{}
@@ -132,3 +134,13 @@ func (base *baseEndpointProvider) GetValidatorsInfo(epoch uint32) string {
 func (base *baseEndpointProvider) IsDataTrieMigrated(addressAsBech32 string) string {
 	return fmt.Sprintf(isDataTrieMigrated, addressAsBech32)
 }
+
+// GetProof returns the Merkle proof endpoint of an account in the state trie with the provided root hash
+func (base *baseEndpointProvider) GetProof(hexRootHash string, addressAsBech32 string) string {
+	return fmt.Sprintf(proof, hexRootHash, addressAsBech32)
+}
+
+// GetProofDataTrie returns the Merkle proofs endpoint of a key from the data trie of an account
+func (base *baseEndpointProvider) GetProofDataTrie(hexRootHash string, addressAsBech32 string, hexKey string) string {
+	return fmt.Sprintf(proofDataTrie, hexRootHash, addressAsBech32, hexKey)
+}
@@ -28,4 +28,6 @@ func TestBaseEndpointProvider(t *testing.T) {
 	assert.Equal(t, "address/erd1address/esdt/TKN-001122", base.GetESDTTokenData("erd1address", "TKN-001122"))
 	assert.Equal(t, "address/erd1address/nft/TKN-001122/nonce/37", base.GetNFTTokenData("erd1address", "TKN-001122", 37))
 	assert.Equal(t, "address/dummyAddress/guardian-data", base.GetGuardianData("dummyAddress"))
+	assert.Equal(t, "proof/root-hash/hex/address/erd1address", base.GetProof("hex", "erd1address"))
+	assert.Equal(t, "proof/root-hash/hex/address/erd1address/key/6b6579", base.GetProofDataTrie("hex", "erd1address", "6b6579"))
 }
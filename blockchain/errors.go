@@ -63,6 +63,9 @@ var ErrInvalidBlockRange = errors.New("invalid block range: too many blocks to p
 // ErrNoBlockRangeProvided signals that no block range was provided
 var ErrNoBlockRangeProvided = errors.New("no block range specified")
 
+// ErrNilBlock signals that a nil block was received
+var ErrNilBlock = errors.New("nil block")
+
 func createHTTPStatusError(httpStatusCode int, err error) error {
 	if err == nil {
 		err = ErrHTTPStatusCodeIsNotOK
@@ -71,3 +74,18 @@ func createHTTPStatusError(httpStatusCode int, err error) error {
 	return fmt.Errorf("%w, returned http status: %d, %s",
 		err, httpStatusCode, http.StatusText(httpStatusCode))
 }
+
+// ErrNilLogCursorHandler signals that a nil log cursor handler was provided
+var ErrNilLogCursorHandler = errors.New("nil log cursor handler")
+
+// ErrNilFilterQuery signals that a nil filter query was provided
+var ErrNilFilterQuery = errors.New("nil filter query")
+
+// ErrUnsupportedSubscriptionFilter signals that the provided filter can not be used in a subscription
+var ErrUnsupportedSubscriptionFilter = errors.New("unsupported subscription filter")
+
+// ErrLogSubscriptionClosed signals that the log subscription was closed
+var ErrLogSubscriptionClosed = errors.New("log subscription closed")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
@@ -42,6 +42,8 @@ type EndpointProvider interface {
 	GetESDTTokenData(addressAsBech32 string, tokenIdentifier string) string
 	GetNFTTokenData(addressAsBech32 string, tokenIdentifier string, nonce uint64) string
 	IsDataTrieMigrated(addressAsBech32 string) string
+	GetProof(hexRootHash string, addressAsBech32 string) string
+	GetProofDataTrie(hexRootHash string, addressAsBech32 string, hexKey string) string
 	GetBlockByNonce(shardID uint32, nonce uint64) string
 	GetBlockByHash(shardID uint32, hash string) string
 	IsInterfaceNil() bool
@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"context"
+
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/core"
@@ -55,6 +56,8 @@ type EndpointProvider interface {
 	GetESDTTokenData(addressAsBech32 string, tokenIdentifier string) string
 	GetNFTTokenData(addressAsBech32 string, tokenIdentifier string, nonce uint64) string
 	IsDataTrieMigrated(addressAsBech32 string) string
+	GetProof(hexRootHash string, addressAsBech32 string) string
+	GetProofDataTrie(hexRootHash string, addressAsBech32 string, hexKey string) string
 	GetBlockByNonce(shardID uint32, nonce uint64) string
 	GetBlockByHash(shardID uint32, hash string) string
 	IsInterfaceNil() bool
@@ -66,6 +69,26 @@ type FinalityProvider interface {
 	IsInterfaceNil() bool
 }
 
+// LogsProxy defines the proxy operations required by the log subscription
+type LogsProxy interface {
+	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+	GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error)
+	GetBlockWithTxsAndLogsByNonce(ctx context.Context, shardID uint32, nonce uint64) (*api.Block, error)
+	IsInterfaceNil() bool
+}
+
+// LogCursorHandler will keep track of the last processed block nonce, for each shard, as to be able to resume
+// the log subscriptions after an application restart
+type LogCursorHandler interface {
+	ProcessedNonce(shardID uint32, nonce uint64)
+	GetLastProcessedNonce(shardID uint32) uint64
+	IsInterfaceNil() bool
+}
+
+type shardOfAddressProvider interface {
+	GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error)
+}
+
 // BlockDataCache defines the methods required for a basic cache.
 type BlockDataCache interface {
 	Get(key []byte) (value interface{}, ok bool)
@@ -0,0 +1,313 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const minimumLogsPollingInterval = time.Millisecond
+
+// ArgsLogSubscription is the DTO used in the NewLogSubscription constructor function
+type ArgsLogSubscription struct {
+	Proxy           LogsProxy
+	CursorHandler   LogCursorHandler
+	PollingInterval time.Duration
+	ChannelSize     int
+}
+
+type logSubscriber struct {
+	ctx      context.Context
+	filter   *sdkCore.FilterQuery
+	chEvents chan *data.LogEvent
+}
+
+type shardLogFollower struct {
+	shardID     uint32
+	lastNonce   uint64
+	subscribers []*logSubscriber
+}
+
+// logSubscription is able to continuously follow the final blocks of the shards and deliver the log events
+// matching the registered filters. Each block is fetched once for all the filters registered on the same shard.
+// The last processed block nonce of each shard is persisted through the LogCursorHandler so the subscriptions
+// can resume after an application restart
+type logSubscription struct {
+	proxy           LogsProxy
+	cursorHandler   LogCursorHandler
+	pollingInterval time.Duration
+	channelSize     int
+
+	mut       sync.Mutex
+	followers map[uint32]*shardLogFollower
+	ctx       context.Context
+	cancel    func()
+}
+
+// NewLogSubscription creates a new instance of the logSubscription type
+func NewLogSubscription(args ArgsLogSubscription) (*logSubscription, error) {
+	err := checkArgsLogSubscription(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ls := &logSubscription{
+		proxy:           args.Proxy,
+		cursorHandler:   args.CursorHandler,
+		pollingInterval: args.PollingInterval,
+		channelSize:     args.ChannelSize,
+		followers:       make(map[uint32]*shardLogFollower),
+	}
+	ls.ctx, ls.cancel = context.WithCancel(context.Background())
+
+	return ls, nil
+}
+
+func checkArgsLogSubscription(args ArgsLogSubscription) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilProxy
+	}
+	if check.IfNil(args.CursorHandler) {
+		return ErrNilLogCursorHandler
+	}
+	if args.PollingInterval < minimumLogsPollingInterval {
+		return fmt.Errorf("%w for PollingInterval", ErrInvalidValue)
+	}
+	if args.ChannelSize < 0 {
+		return fmt.Errorf("%w for ChannelSize, provided: %d", ErrInvalidValue, args.ChannelSize)
+	}
+
+	return nil
+}
+
+// Subscribe registers the provided filter and returns the channel on which the matching log events will be delivered,
+// in order, as soon as their blocks are final. The subscription ends, and the channel is closed, when the provided
+// context is done or when the log subscription is closed. The filter should not contain a block hash or a ToBlock value.
+// Slow readers will delay the delivery for all the subscribers on the same shard
+func (ls *logSubscription) Subscribe(ctx context.Context, filter *sdkCore.FilterQuery) (<-chan *data.LogEvent, error) {
+	if filter == nil {
+		return nil, ErrNilFilterQuery
+	}
+	if filter.BlockHash != nil || filter.ToBlock.HasValue {
+		return nil, fmt.Errorf("%w, block hash and ToBlock are not allowed", ErrUnsupportedSubscriptionFilter)
+	}
+	if ls.ctx.Err() != nil {
+		return nil, ErrLogSubscriptionClosed
+	}
+
+	shardID, err := computeShardIdForFilter(ctx, ls.proxy, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber := &logSubscriber{
+		ctx:      ctx,
+		filter:   filter,
+		chEvents: make(chan *data.LogEvent, ls.channelSize),
+	}
+
+	ls.mut.Lock()
+	defer ls.mut.Unlock()
+
+	follower, found := ls.followers[shardID]
+	if !found {
+		lastNonce, errNonce := ls.computeStartingNonce(ctx, shardID, filter)
+		if errNonce != nil {
+			return nil, errNonce
+		}
+
+		follower = &shardLogFollower{
+			shardID:   shardID,
+			lastNonce: lastNonce,
+		}
+		ls.followers[shardID] = follower
+		go ls.processLoop(follower)
+
+		log.Debug("logSubscription: started following shard", "shard", shardID, "last processed nonce", lastNonce)
+	}
+	follower.subscribers = append(follower.subscribers, subscriber)
+
+	return subscriber.chEvents, nil
+}
+
+func (ls *logSubscription) computeStartingNonce(ctx context.Context, shardID uint32, filter *sdkCore.FilterQuery) (uint64, error) {
+	lastNonce := ls.cursorHandler.GetLastProcessedNonce(shardID)
+	if lastNonce > 0 {
+		return lastNonce, nil
+	}
+	if filter.FromBlock.HasValue && filter.FromBlock.Value > 0 {
+		return filter.FromBlock.Value - 1, nil
+	}
+
+	status, err := ls.proxy.GetNetworkStatus(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+	if status == nil {
+		return 0, ErrNilNetworkStatus
+	}
+
+	return status.HighestNonce, nil
+}
+
+func (ls *logSubscription) processLoop(follower *shardLogFollower) {
+	timer := time.NewTimer(ls.pollingInterval)
+	defer timer.Stop()
+
+	for {
+		subscribers := ls.getActiveSubscribers(follower)
+		if len(subscribers) == 0 {
+			log.Debug("logSubscription: no more subscribers, stopping shard follower", "shard", follower.shardID)
+			return
+		}
+
+		err := ls.processNewBlocks(follower, subscribers)
+		if err != nil {
+			log.Debug("logSubscription: error processing new blocks",
+				"shard", follower.shardID, "last processed nonce", follower.lastNonce, "error", err)
+		}
+
+		timer.Reset(ls.pollingInterval)
+		select {
+		case <-timer.C:
+		case <-ls.ctx.Done():
+			ls.closeSubscribers(follower)
+			return
+		}
+	}
+}
+
+// getActiveSubscribers will close the channels of the ended subscriptions and return the remaining ones. If no
+// subscribers remain, the follower is removed
+func (ls *logSubscription) getActiveSubscribers(follower *shardLogFollower) []*logSubscriber {
+	ls.mut.Lock()
+	defer ls.mut.Unlock()
+
+	activeSubscribers := make([]*logSubscriber, 0, len(follower.subscribers))
+	for _, subscriber := range follower.subscribers {
+		if subscriber.ctx.Err() != nil || ls.ctx.Err() != nil {
+			close(subscriber.chEvents)
+			continue
+		}
+
+		activeSubscribers = append(activeSubscribers, subscriber)
+	}
+	follower.subscribers = activeSubscribers
+	if len(activeSubscribers) == 0 {
+		delete(ls.followers, follower.shardID)
+	}
+
+	return activeSubscribers
+}
+
+func (ls *logSubscription) closeSubscribers(follower *shardLogFollower) {
+	ls.mut.Lock()
+	defer ls.mut.Unlock()
+
+	for _, subscriber := range follower.subscribers {
+		close(subscriber.chEvents)
+	}
+	follower.subscribers = nil
+	delete(ls.followers, follower.shardID)
+}
+
+func (ls *logSubscription) processNewBlocks(follower *shardLogFollower, subscribers []*logSubscriber) error {
+	status, err := ls.proxy.GetNetworkStatus(ls.ctx, follower.shardID)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		return ErrNilNetworkStatus
+	}
+
+	for nonce := follower.lastNonce + 1; nonce <= status.HighestNonce; nonce++ {
+		block, errGet := ls.proxy.GetBlockWithTxsAndLogsByNonce(ls.ctx, follower.shardID, nonce)
+		if errGet != nil {
+			return errGet
+		}
+
+		events := extractLogEvents(follower.shardID, block)
+		for _, subscriber := range subscribers {
+			ls.deliver(subscriber, nonce, events)
+		}
+		if ls.ctx.Err() != nil {
+			return ErrLogSubscriptionClosed
+		}
+
+		follower.lastNonce = nonce
+		ls.cursorHandler.ProcessedNonce(follower.shardID, nonce)
+	}
+
+	return nil
+}
+
+func (ls *logSubscription) deliver(subscriber *logSubscriber, nonce uint64, events []*data.LogEvent) {
+	if subscriber.filter.FromBlock.HasValue && nonce < subscriber.filter.FromBlock.Value {
+		return
+	}
+
+	for _, event := range events {
+		if !matchesFilter(subscriber.filter, event.Event) {
+			continue
+		}
+
+		select {
+		case subscriber.chEvents <- event:
+		case <-subscriber.ctx.Done():
+			return
+		case <-ls.ctx.Done():
+			return
+		}
+	}
+}
+
+// extractLogEvents returns all the log events of a block, in order. A transaction that is included in
+// more than one miniblock of the same block will have its events returned only once
+func extractLogEvents(shardID uint32, block *api.Block) []*data.LogEvent {
+	events := make([]*data.LogEvent, 0)
+	processedTxs := make(map[string]struct{})
+	for _, miniblock := range block.MiniBlocks {
+		for _, tx := range miniblock.Transactions {
+			if tx.Logs == nil {
+				continue
+			}
+
+			_, found := processedTxs[tx.Hash]
+			if found {
+				continue
+			}
+			processedTxs[tx.Hash] = struct{}{}
+
+			for index, event := range tx.Logs.Events {
+				events = append(events, &data.LogEvent{
+					ShardID:    shardID,
+					BlockNonce: block.Nonce,
+					BlockHash:  block.Hash,
+					TxHash:     tx.Hash,
+					EventIndex: index,
+					Event:      event,
+				})
+			}
+		}
+	}
+
+	return events
+}
+
+// Close stops following the shards and closes all the subscription channels
+func (ls *logSubscription) Close() error {
+	ls.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ls *logSubscription) IsInterfaceNil() bool {
+	return ls == nil
+}
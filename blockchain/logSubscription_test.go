@@ -0,0 +1,339 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSubscriptionTimeout = time.Second
+
+func createMockArgsLogSubscription() ArgsLogSubscription {
+	return ArgsLogSubscription{
+		Proxy:           &testsCommon.ProxyStub{},
+		CursorHandler:   &testsCommon.LogCursorHandlerStub{},
+		PollingInterval: time.Millisecond,
+		ChannelSize:     10,
+	}
+}
+
+func createBlockWithEvents(nonce uint64) *api.Block {
+	txHash := fmt.Sprintf("tx%d", nonce)
+	tx := &transaction.ApiTransactionResult{
+		Hash: txHash,
+		Logs: &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				{
+					Address:    "erd1contract",
+					Identifier: "first",
+					Topics:     [][]byte{[]byte("topic")},
+				},
+				{
+					Address:    "erd1other",
+					Identifier: "second",
+				},
+			},
+		},
+	}
+
+	return &api.Block{
+		Nonce: nonce,
+		Hash:  fmt.Sprintf("hash%d", nonce),
+		MiniBlocks: []*api.MiniBlock{
+			{Transactions: []*transaction.ApiTransactionResult{tx}},
+			// same transaction included again should not duplicate the events
+			{Transactions: []*transaction.ApiTransactionResult{tx}},
+		},
+	}
+}
+
+func readLogEvent(t *testing.T, chEvents <-chan *data.LogEvent) *data.LogEvent {
+	select {
+	case event := <-chEvents:
+		return event
+	case <-time.After(testSubscriptionTimeout):
+		require.Fail(t, "timeout reading log event")
+	}
+
+	return nil
+}
+
+func TestNewLogSubscription(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLogSubscription()
+		args.Proxy = nil
+		ls, err := NewLogSubscription(args)
+		assert.True(t, check.IfNil(ls))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil cursor handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLogSubscription()
+		args.CursorHandler = nil
+		ls, err := NewLogSubscription(args)
+		assert.True(t, check.IfNil(ls))
+		assert.Equal(t, ErrNilLogCursorHandler, err)
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLogSubscription()
+		args.PollingInterval = 0
+		ls, err := NewLogSubscription(args)
+		assert.True(t, check.IfNil(ls))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid channel size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLogSubscription()
+		args.ChannelSize = -1
+		ls, err := NewLogSubscription(args)
+		assert.True(t, check.IfNil(ls))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ls, err := NewLogSubscription(createMockArgsLogSubscription())
+		assert.False(t, check.IfNil(ls))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLogSubscription_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filters should error", func(t *testing.T) {
+		t.Parallel()
+
+		ls, _ := NewLogSubscription(createMockArgsLogSubscription())
+		defer func() {
+			_ = ls.Close()
+		}()
+
+		chEvents, err := ls.Subscribe(context.Background(), nil)
+		assert.Nil(t, chEvents)
+		assert.Equal(t, ErrNilFilterQuery, err)
+
+		chEvents, err = ls.Subscribe(context.Background(), &sdkCore.FilterQuery{BlockHash: []byte("hash")})
+		assert.Nil(t, chEvents)
+		assert.True(t, errors.Is(err, ErrUnsupportedSubscriptionFilter))
+
+		chEvents, err = ls.Subscribe(context.Background(), &sdkCore.FilterQuery{ToBlock: core.OptionalUint64{Value: 1, HasValue: true}})
+		assert.Nil(t, chEvents)
+		assert.True(t, errors.Is(err, ErrUnsupportedSubscriptionFilter))
+
+		chEvents, err = ls.Subscribe(context.Background(), &sdkCore.FilterQuery{})
+		assert.Nil(t, chEvents)
+		assert.Equal(t, ErrNoShardOrAddressesProvided, err)
+	})
+	t.Run("closed subscription should error", func(t *testing.T) {
+		t.Parallel()
+
+		ls, _ := NewLogSubscription(createMockArgsLogSubscription())
+		_ = ls.Close()
+
+		chEvents, err := ls.Subscribe(context.Background(), &sdkCore.FilterQuery{ShardID: core.OptionalUint32{Value: 0, HasValue: true}})
+		assert.Nil(t, chEvents)
+		assert.Equal(t, ErrLogSubscriptionClosed, err)
+	})
+	t.Run("should deliver final events to all subscribers and fetch each block once", func(t *testing.T) {
+		t.Parallel()
+
+		finalNonce := uint64(10)
+		mutFetched := sync.Mutex{}
+		fetchedBlocks := make(map[uint64]int)
+		processedNonce := uint64(0)
+
+		args := createMockArgsLogSubscription()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 1, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				assert.Equal(t, uint32(1), shardID)
+				return &data.NetworkStatus{
+					Nonce:        atomic.LoadUint64(&finalNonce) + 2,
+					HighestNonce: atomic.LoadUint64(&finalNonce),
+				}, nil
+			},
+			GetBlockWithTxsAndLogsByNonceCalled: func(ctx context.Context, shardID uint32, nonce uint64) (*api.Block, error) {
+				mutFetched.Lock()
+				fetchedBlocks[nonce]++
+				mutFetched.Unlock()
+
+				return createBlockWithEvents(nonce), nil
+			},
+		}
+		args.CursorHandler = &testsCommon.LogCursorHandlerStub{
+			ProcessedNonceCalled: func(shardID uint32, nonce uint64) {
+				atomic.StoreUint64(&processedNonce, nonce)
+			},
+		}
+		ls, _ := NewLogSubscription(args)
+		defer func() {
+			_ = ls.Close()
+		}()
+
+		chContractEvents, err := ls.Subscribe(context.Background(), &sdkCore.FilterQuery{
+			Addresses: []string{"erd1contract"},
+			Topics:    [][]byte{[]byte("topic")},
+		})
+		require.Nil(t, err)
+		chAllEvents, err := ls.Subscribe(context.Background(), &sdkCore.FilterQuery{
+			ShardID: core.OptionalUint32{Value: 1, HasValue: true},
+		})
+		require.Nil(t, err)
+
+		// subscriptions start from the latest final block
+		atomic.StoreUint64(&finalNonce, 12)
+
+		for nonce := uint64(11); nonce <= 12; nonce++ {
+			event := readLogEvent(t, chContractEvents)
+			assert.Equal(t, nonce, event.BlockNonce)
+			assert.Equal(t, fmt.Sprintf("tx%d", nonce), event.TxHash)
+			assert.Equal(t, 0, event.EventIndex)
+			assert.Equal(t, "first", event.Event.Identifier)
+
+			event = readLogEvent(t, chAllEvents)
+			assert.Equal(t, nonce, event.BlockNonce)
+			assert.Equal(t, "first", event.Event.Identifier)
+			event = readLogEvent(t, chAllEvents)
+			assert.Equal(t, nonce, event.BlockNonce)
+			assert.Equal(t, 1, event.EventIndex)
+			assert.Equal(t, "second", event.Event.Identifier)
+		}
+
+		time.Sleep(time.Millisecond * 50)
+		assert.Equal(t, 0, len(chContractEvents))
+		assert.Equal(t, 0, len(chAllEvents))
+		assert.Equal(t, uint64(12), atomic.LoadUint64(&processedNonce))
+
+		mutFetched.Lock()
+		assert.Equal(t, map[uint64]int{11: 1, 12: 1}, fetchedBlocks)
+		mutFetched.Unlock()
+	})
+	t.Run("should resume from the stored cursor", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLogSubscription()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				return &data.NetworkStatus{
+					HighestNonce: 100,
+				}, nil
+			},
+			GetBlockWithTxsAndLogsByNonceCalled: func(ctx context.Context, shardID uint32, nonce uint64) (*api.Block, error) {
+				return createBlockWithEvents(nonce), nil
+			},
+		}
+		args.CursorHandler = &testsCommon.LogCursorHandlerStub{
+			GetLastProcessedNonceCalled: func(shardID uint32) uint64 {
+				return 97
+			},
+		}
+		ls, _ := NewLogSubscription(args)
+		defer func() {
+			_ = ls.Close()
+		}()
+
+		chEvents, err := ls.Subscribe(context.Background(), &sdkCore.FilterQuery{
+			ShardID: core.OptionalUint32{Value: 0, HasValue: true},
+			Topics:  [][]byte{[]byte("topic")},
+		})
+		require.Nil(t, err)
+
+		for nonce := uint64(98); nonce <= 100; nonce++ {
+			event := readLogEvent(t, chEvents)
+			assert.Equal(t, nonce, event.BlockNonce)
+		}
+	})
+	t.Run("temporary errors should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		args := createMockArgsLogSubscription()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				return &data.NetworkStatus{
+					HighestNonce: 6,
+				}, nil
+			},
+			GetBlockWithTxsAndLogsByNonceCalled: func(ctx context.Context, shardID uint32, nonce uint64) (*api.Block, error) {
+				if atomic.AddUint32(&numCalls, 1) == 1 {
+					return nil, errors.New("temporary error")
+				}
+
+				return createBlockWithEvents(nonce), nil
+			},
+		}
+		ls, _ := NewLogSubscription(args)
+		defer func() {
+			_ = ls.Close()
+		}()
+
+		chEvents, err := ls.Subscribe(context.Background(), &sdkCore.FilterQuery{
+			ShardID:   core.OptionalUint32{Value: 0, HasValue: true},
+			FromBlock: core.OptionalUint64{Value: 5, HasValue: true},
+			Topics:    [][]byte{[]byte("topic")},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(5), readLogEvent(t, chEvents).BlockNonce)
+		assert.Equal(t, uint64(6), readLogEvent(t, chEvents).BlockNonce)
+	})
+	t.Run("ended subscriptions should have their channels closed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLogSubscription()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				return &data.NetworkStatus{}, nil
+			},
+		}
+		ls, _ := NewLogSubscription(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		filter := &sdkCore.FilterQuery{ShardID: core.OptionalUint32{Value: 0, HasValue: true}}
+		chEvents, err := ls.Subscribe(ctx, filter)
+		require.Nil(t, err)
+		chOtherEvents, err := ls.Subscribe(context.Background(), filter)
+		require.Nil(t, err)
+
+		cancel()
+		select {
+		case _, ok := <-chEvents:
+			assert.False(t, ok)
+		case <-time.After(testSubscriptionTimeout):
+			assert.Fail(t, "timeout waiting for the channel to be closed")
+		}
+
+		_ = ls.Close()
+		select {
+		case _, ok := <-chOtherEvents:
+			assert.False(t, ok)
+		case <-time.After(testSubscriptionTimeout):
+			assert.Fail(t, "timeout waiting for the channel to be closed")
+		}
+	})
+}
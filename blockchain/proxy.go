@@ -26,6 +26,8 @@ import (
 const (
 	withResultsQueryParam = "?withResults=true"
 	withTxsAndLogs        = "?withTxs=true&withLogs=true"
+
+	defaultFilterQueryMaxConcurrentRequests = 10
 )
 
 var (
@@ -44,6 +46,9 @@ type ArgsProxy struct {
 	CacheExpirationTime    time.Duration
 	EntityType             sdkCore.RestAPIEntityType
 	FilterQueryBlockCacher BlockDataCache
+	// FilterQueryMaxConcurrentRequests is the maximum number of blocks fetched concurrently by FilterLogs.
+	// If not set, defaultFilterQueryMaxConcurrentRequests will be used
+	FilterQueryMaxConcurrentRequests int
 }
 
 // proxy implements basic functions for interacting with a multiversx Proxy
@@ -55,6 +60,8 @@ type proxy struct {
 	allowedDeltaToFinal    int
 	finalityProvider       FinalityProvider
 	filterQueryBlockCacher BlockDataCache
+
+	filterQueryMaxConcurrentRequests int
 }
 
 // NewProxy initializes and returns a proxy object
@@ -90,14 +97,20 @@ func NewProxy(args ArgsProxy) (*proxy, error) {
 		cacher = &DisabledBlockDataCache{}
 	}
 
+	maxConcurrentRequests := args.FilterQueryMaxConcurrentRequests
+	if maxConcurrentRequests == 0 {
+		maxConcurrentRequests = defaultFilterQueryMaxConcurrentRequests
+	}
+
 	ep := &proxy{
-		baseProxy:              baseProxyInstance,
-		sameScState:            args.SameScState,
-		shouldBeSynced:         args.ShouldBeSynced,
-		finalityCheck:          args.FinalityCheck,
-		allowedDeltaToFinal:    args.AllowedDeltaToFinal,
-		finalityProvider:       finalityProvider,
-		filterQueryBlockCacher: cacher,
+		baseProxy:                        baseProxyInstance,
+		sameScState:                      args.SameScState,
+		shouldBeSynced:                   args.ShouldBeSynced,
+		finalityCheck:                    args.FinalityCheck,
+		allowedDeltaToFinal:              args.AllowedDeltaToFinal,
+		finalityProvider:                 finalityProvider,
+		filterQueryBlockCacher:           cacher,
+		filterQueryMaxConcurrentRequests: maxConcurrentRequests,
 	}
 
 	return ep, nil
@@ -111,6 +124,11 @@ func checkArgsProxy(args ArgsProxy) error {
 		}
 	}
 
+	if args.FilterQueryMaxConcurrentRequests < 0 {
+		return fmt.Errorf("%w for FilterQueryMaxConcurrentRequests, provided: %d",
+			ErrInvalidValue, args.FilterQueryMaxConcurrentRequests)
+	}
+
 	return nil
 }
 
@@ -734,6 +752,68 @@ func (ep *proxy) IsDataTrieMigrated(ctx context.Context, address sdkCore.Address
 	return isMigrated, nil
 }
 
+// GetProof retrieves the Merkle proof of the provided account from the state trie with the provided root hash
+func (ep *proxy) GetProof(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler) (*data.AccountProof, error) {
+	bech32Address, err := addressToBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetProof(hex.EncodeToString(rootHash), bech32Address)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.ProofResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return &response.Data, nil
+}
+
+// GetProofDataTrie retrieves the Merkle proof of the provided account from the state trie with the provided root hash
+// and the Merkle proof of the provided key from the account's data trie
+func (ep *proxy) GetProofDataTrie(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler, key []byte) (*data.DataTrieProof, error) {
+	bech32Address, err := addressToBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetProofDataTrie(hex.EncodeToString(rootHash), bech32Address, hex.EncodeToString(key))
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.DataTrieProofResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return &response.Data, nil
+}
+
+func addressToBech32(address sdkCore.AddressHandler) (string, error) {
+	if check.IfNil(address) {
+		return "", ErrNilAddress
+	}
+	if !address.IsValid() {
+		return "", ErrInvalidAddress
+	}
+
+	return address.AddressAsBech32String()
+}
+
 // GetBlockBytesByNonce retrieves bytes of a block with its transactions and logs by nonce
 func (ep *proxy) getBlockBytesByNonceWithTxsAndLogs(ctx context.Context, shardID uint32, nonce uint64) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetBlockByNonce(shardID, nonce)
@@ -756,39 +836,408 @@ func (ep *proxy) getBlockBytesByHashWithTxsAndLogs(ctx context.Context, shardID
 	return buff, nil
 }
 
-// FilterLogs retrieves logs from the network and filters them based on the provided filter
+// FilterLogs retrieves logs from the network and filters them based on the provided filter. All the shards of the
+// provided addresses are queried and the blocks are fetched concurrently. When several shards are queried, the block
+// range of the filter refers to the shard with the lowest ID and the other shards are queried for the same rounds. The
+// results of all the shards are ordered by (block timestamp, round, transaction index, event index). If the filter
+// specifies a limit, only the first results are returned
 func (ep *proxy) FilterLogs(ctx context.Context, filter *sdkCore.FilterQuery) ([]*transaction.Events, error) {
-	shardID, err := ep.computeShardId(ctx, filter)
+	shardIDs, err := ep.computeShardIds(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*data.NetworkStatus, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		status, errStatus := ep.GetNetworkStatus(ctx, shardID)
+		if errStatus != nil {
+			return nil, errStatus
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	blockRanges, err := ep.computeBlockRangesForFilter(ctx, filter, shardIDs, statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	return ep.getLogsFromBlockRanges(ctx, blockRanges, filter)
+}
+
+// computeBlockRangesForFilter returns the block range of each shard. The block nonces of the filter refer to the first
+// shard, as the nonces of different shards are unrelated. The ranges of the other shards are computed so that they
+// hold the blocks proposed in the same rounds, the shards being skipped if they have no blocks in those rounds
+func (ep *proxy) computeBlockRangesForFilter(
+	ctx context.Context,
+	filter *sdkCore.FilterQuery,
+	shardIDs []uint32,
+	statuses []*data.NetworkStatus,
+) ([]shardBlockRange, error) {
+	fromBlock, toBlock, err := ep.computeFromToBlocksForFilter(ctx, filter, shardIDs[0], statuses[0].Nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	status, err := ep.GetNetworkStatus(ctx, shardID)
+	blockRanges := make([]shardBlockRange, 0, len(shardIDs))
+	blockRanges = append(blockRanges, shardBlockRange{
+		shardID:   shardIDs[0],
+		fromBlock: fromBlock,
+		toBlock:   toBlock,
+	})
+	if len(shardIDs) == 1 {
+		return blockRanges, nil
+	}
+
+	fromRound, err := ep.getBlockRound(ctx, shardIDs[0], fromBlock)
 	if err != nil {
 		return nil, err
 	}
+	toRound, err := ep.getBlockRound(ctx, shardIDs[0], toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := 1; idx < len(shardIDs); idx++ {
+		shardFromBlock, errSearch := ep.findFirstBlockFromRound(ctx, shardIDs[idx], statuses[idx], fromRound)
+		if errSearch != nil {
+			return nil, errSearch
+		}
+		shardAfterToBlock, errSearch := ep.findFirstBlockFromRound(ctx, shardIDs[idx], statuses[idx], toRound+1)
+		if errSearch != nil {
+			return nil, errSearch
+		}
+		if shardAfterToBlock <= shardFromBlock {
+			continue
+		}
+		if shardAfterToBlock-1-shardFromBlock > MaximumBlocksDelta {
+			return nil, errors.New("invalid block range: too many blocks to process")
+		}
+
+		blockRanges = append(blockRanges, shardBlockRange{
+			shardID:   shardIDs[idx],
+			fromBlock: shardFromBlock,
+			toBlock:   shardAfterToBlock - 1,
+		})
+	}
+
+	return blockRanges, nil
+}
+
+// findFirstBlockFromRound returns the nonce of the first block of the shard proposed in the provided round or later,
+// or the nonce following the latest block if there is no such block. As the nonces increase by at most one per round,
+// the search starts from the lowest nonce the block can have, which is usually the searched one
+func (ep *proxy) findFirstBlockFromRound(ctx context.Context, shardID uint32, status *data.NetworkStatus, round uint64) (uint64, error) {
+	low := status.Nonce
+	if status.CurrentRound > round {
+		roundsDelta := status.CurrentRound - round
+		low = 0
+		if roundsDelta < status.Nonce {
+			low = status.Nonce - roundsDelta
+		}
+	}
+
+	// all the blocks before low are older than the round, search exponentially for a block that is not
+	high := low
+	step := uint64(1)
+	for high <= status.Nonce {
+		blockRound, err := ep.getBlockRound(ctx, shardID, high)
+		if err != nil {
+			return 0, err
+		}
+		if blockRound >= round {
+			break
+		}
+
+		low = high + 1
+		high += step
+		step *= 2
+	}
+	if high > status.Nonce {
+		high = status.Nonce + 1
+	}
+
+	for low < high {
+		mid := low + (high-low)/2
+		blockRound, err := ep.getBlockRound(ctx, shardID, mid)
+		if err != nil {
+			return 0, err
+		}
+		if blockRound >= round {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low, nil
+}
+
+func (ep *proxy) getBlockRound(ctx context.Context, shardID uint32, nonce uint64) (uint64, error) {
+	block, err := ep.GetBlockWithTxsAndLogsByNonce(ctx, shardID, nonce)
+	if err != nil {
+		return 0, err
+	}
+
+	return block.Round, nil
+}
+
+type shardBlockRange struct {
+	shardID   uint32
+	fromBlock uint64
+	toBlock   uint64
+}
+
+type blockLogsTask struct {
+	done chan struct{}
+	logs *blockLogs
+	err  error
+}
+
+// blockLogs holds the matching events of a block, along with the block fields used to order the events of
+// different shards
+type blockLogs struct {
+	timestamp time.Duration
+	round     uint64
+	events    []*blockEvent
+}
+
+type blockEvent struct {
+	txIndex    int
+	eventIndex int
+	event      *transaction.Events
+}
+
+// shardLogsStream holds the blocks of a shard, in the order of their nonces
+type shardLogsStream struct {
+	chTasks <-chan *blockLogsTask
+	head    *blockLogs
+	ended   bool
+}
+
+// getLogsFromBlockRanges fetches the blocks using at most filterQueryMaxConcurrentRequests concurrent requests, shared
+// by all the shards. The blocks of each shard are fetched in order and the shards are merged by block timestamp and
+// round, then by transaction index and event index. The limit is applied on the merged results, so the fetching
+// stops as soon as the limit is reached, as the next blocks of each shard can not be older than the merged ones
+func (ep *proxy) getLogsFromBlockRanges(ctx context.Context, blockRanges []shardBlockRange, filter *sdkCore.FilterQuery) ([]*transaction.Events, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	semaphore := make(chan struct{}, ep.filterQueryMaxConcurrentRequests)
+	streams := make([]*shardLogsStream, 0, len(blockRanges))
+	for _, blockRange := range blockRanges {
+		chTasks := make(chan *blockLogsTask, ep.filterQueryMaxConcurrentRequests)
+		go ep.startBlockLogsTasks(ctx, blockRange, filter, semaphore, chTasks)
+
+		streams = append(streams, &shardLogsStream{
+			chTasks: chTasks,
+		})
+	}
+
+	matchingEvents := make([]*transaction.Events, 0)
+	for {
+		nextBlocks, err := popOldestBlocks(ctx, streams)
+		if err != nil {
+			return nil, err
+		}
+		if len(nextBlocks) == 0 {
+			return matchingEvents, nil
+		}
+
+		matchingEvents = append(matchingEvents, mergeBlocksEvents(nextBlocks)...)
+		if filter.Limit > 0 && uint64(len(matchingEvents)) >= filter.Limit {
+			return matchingEvents[:filter.Limit], nil
+		}
+	}
+}
+
+func (ep *proxy) startBlockLogsTasks(
+	ctx context.Context,
+	blockRange shardBlockRange,
+	filter *sdkCore.FilterQuery,
+	semaphore chan struct{},
+	chTasks chan<- *blockLogsTask,
+) {
+	defer close(chTasks)
+
+	for blockNum := blockRange.fromBlock; blockNum <= blockRange.toBlock; blockNum++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		task := &blockLogsTask{
+			done: make(chan struct{}),
+		}
+		go func(shardID uint32, nonce uint64) {
+			task.logs, task.err = ep.getLogsFromBlock(ctx, shardID, nonce, filter)
+			close(task.done)
+			<-semaphore
+		}(blockRange.shardID, blockNum)
+
+		select {
+		case chTasks <- task:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// peek returns the next block of the stream, waiting for it to be fetched, or nil if the stream ended
+func (stream *shardLogsStream) peek(ctx context.Context) (*blockLogs, error) {
+	if stream.head != nil || stream.ended {
+		return stream.head, nil
+	}
+
+	var task *blockLogsTask
+	var ok bool
+	select {
+	case task, ok = <-stream.chTasks:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if !ok {
+		// the tasks channel is also closed when the context is done
+		stream.ended = true
+		return nil, ctx.Err()
+	}
+
+	select {
+	case <-task.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if task.err != nil {
+		return nil, task.err
+	}
+
+	stream.head = task.logs
 
-	fromBlock, toBlock, err := ep.computeFromToBlocksForFilter(ctx, filter, shardID, status.Nonce)
+	return stream.head, nil
+}
+
+// popOldestBlocks removes and returns the oldest next block of the streams, along with the next blocks of the other
+// streams having the same timestamp and round. It returns an empty slice when all the streams ended
+func popOldestBlocks(ctx context.Context, streams []*shardLogsStream) ([]*blockLogs, error) {
+	var oldest *blockLogs
+	for _, stream := range streams {
+		head, err := stream.peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if head == nil {
+			continue
+		}
+		if oldest == nil || isBlockOlder(head, oldest) {
+			oldest = head
+		}
+	}
+	if oldest == nil {
+		return nil, nil
+	}
+
+	oldestBlocks := make([]*blockLogs, 0, len(streams))
+	for _, stream := range streams {
+		if stream.head == nil || isBlockOlder(oldest, stream.head) {
+			continue
+		}
+
+		oldestBlocks = append(oldestBlocks, stream.head)
+		stream.head = nil
+	}
+
+	return oldestBlocks, nil
+}
+
+func isBlockOlder(block *blockLogs, other *blockLogs) bool {
+	if block.timestamp != other.timestamp {
+		return block.timestamp < other.timestamp
+	}
+
+	return block.round < other.round
+}
+
+// mergeBlocksEvents merges the events of the blocks with the same timestamp and round by transaction index and
+// event index. The events with the same indexes keep the order of the shards
+func mergeBlocksEvents(blocks []*blockLogs) []*transaction.Events {
+	events := make([]*blockEvent, 0)
+	for _, block := range blocks {
+		events = append(events, block.events...)
+	}
+	if len(blocks) > 1 {
+		sort.SliceStable(events, func(i, j int) bool {
+			if events[i].txIndex != events[j].txIndex {
+				return events[i].txIndex < events[j].txIndex
+			}
+
+			return events[i].eventIndex < events[j].eventIndex
+		})
+	}
+
+	matchingEvents := make([]*transaction.Events, 0, len(events))
+	for _, event := range events {
+		matchingEvents = append(matchingEvents, event.event)
+	}
+
+	return matchingEvents
+}
+
+func (ep *proxy) computeShardIds(ctx context.Context, filter *sdkCore.FilterQuery) ([]uint32, error) {
+	if len(filter.Addresses) == 0 {
+		if filter.ShardID.HasValue {
+			return []uint32{filter.ShardID.Value}, nil
+		}
+
+		return nil, ErrNoShardOrAddressesProvided
+	}
+
+	shardIDs, err := ep.computeShardIdsFromAddresses(ctx, filter.Addresses)
 	if err != nil {
 		return nil, err
 	}
 
-	matchingEvents := make([]*transaction.Events, 0, toBlock-fromBlock+1)
-	for blockNum := fromBlock; blockNum <= toBlock; blockNum++ {
-		blockLogs, err := ep.getLogsFromBlock(ctx, shardID, blockNum, filter)
+	if filter.ShardID.HasValue {
+		if len(shardIDs) > 1 {
+			return nil, fmt.Errorf("%w, computed %v, provided %d", ErrShardIDMismatch, shardIDs, filter.ShardID.Value)
+		}
+		if shardIDs[0] != filter.ShardID.Value {
+			return nil, fmt.Errorf("%w, computed %d, provided %d", ErrShardIDMismatch, shardIDs[0], filter.ShardID.Value)
+		}
+	}
+	if filter.BlockHash != nil && len(shardIDs) > 1 {
+		return nil, fmt.Errorf("%w, a block hash can be used only for a single shard", ErrAddressesFromDifferentShards)
+	}
+
+	return shardIDs, nil
+}
+
+// computeShardIdsFromAddresses returns the sorted, unique, shard IDs of the provided addresses
+func (ep *proxy) computeShardIdsFromAddresses(ctx context.Context, addresses []string) ([]uint32, error) {
+	shardIDsMap := make(map[uint32]struct{})
+	for _, address := range addresses {
+		shardID, err := ep.GetShardOfAddress(ctx, address)
 		if err != nil {
 			return nil, err
 		}
 
-		matchingEvents = append(matchingEvents, blockLogs...)
+		shardIDsMap[shardID] = struct{}{}
 	}
 
-	return matchingEvents, nil
+	shardIDs := make([]uint32, 0, len(shardIDsMap))
+	for shardID := range shardIDsMap {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs, nil
 }
 
-func (ep *proxy) computeShardId(ctx context.Context, filter *sdkCore.FilterQuery) (uint32, error) {
+func computeShardIdForFilter(ctx context.Context, provider shardOfAddressProvider, filter *sdkCore.FilterQuery) (uint32, error) {
 	if len(filter.Addresses) != 0 {
-		shardIdFromAddresses, err := ep.computeShardIdFromAddresses(ctx, filter.Addresses)
+		shardIdFromAddresses, err := computeShardIdFromAddresses(ctx, provider, filter.Addresses)
 		if err != nil {
 			return 0, err
 		}
@@ -811,14 +1260,14 @@ func (ep *proxy) computeShardId(ctx context.Context, filter *sdkCore.FilterQuery
 	return 0, ErrNoShardOrAddressesProvided
 }
 
-func (ep *proxy) computeShardIdFromAddresses(ctx context.Context, addresses []string) (uint32, error) {
-	shardId, err := ep.GetShardOfAddress(ctx, addresses[0])
+func computeShardIdFromAddresses(ctx context.Context, provider shardOfAddressProvider, addresses []string) (uint32, error) {
+	shardId, err := provider.GetShardOfAddress(ctx, addresses[0])
 	if err != nil {
 		return 0, err
 	}
 
 	for _, address := range addresses {
-		addressShardId, err := ep.GetShardOfAddress(ctx, address)
+		addressShardId, err := provider.GetShardOfAddress(ctx, address)
 		if err != nil {
 			return 0, err
 		}
@@ -894,17 +1343,29 @@ func (ep *proxy) getBlockNumberByHash(ctx context.Context, shardID uint32, block
 
 	// Cache the raw response bytes
 	if len(buff) > 0 {
-		cacheKey := make([]byte, 8)
-		binary.BigEndian.PutUint64(cacheKey, blockNonce)
-		ep.filterQueryBlockCacher.Put(cacheKey, buff, len(buff))
+		ep.filterQueryBlockCacher.Put(createBlockCacheKey(shardID, blockNonce), buff, len(buff))
 	}
 
 	return blockNonce, nil
 }
 
 // getLogsFromBlock retrieves logs from a specific block and filters them
-func (ep *proxy) getLogsFromBlock(ctx context.Context, shardID uint32, blockNum uint64, filter *sdkCore.FilterQuery) ([]*transaction.Events, error) {
-	buff, err := getBlockBytesByNonce(ctx, ep, shardID, blockNum)
+func (ep *proxy) getLogsFromBlock(ctx context.Context, shardID uint32, blockNum uint64, filter *sdkCore.FilterQuery) (*blockLogs, error) {
+	block, err := ep.GetBlockWithTxsAndLogsByNonce(ctx, shardID, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockLogs{
+		timestamp: block.Timestamp,
+		round:     block.Round,
+		events:    extractMatchingEvents(block, filter),
+	}, nil
+}
+
+// GetBlockWithTxsAndLogsByNonce retrieves a block, along with its transactions and logs, by nonce
+func (ep *proxy) GetBlockWithTxsAndLogsByNonce(ctx context.Context, shardID uint32, nonce uint64) (*api.Block, error) {
+	buff, err := getBlockBytesByNonce(ctx, ep, shardID, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -917,13 +1378,15 @@ func (ep *proxy) getLogsFromBlock(ctx context.Context, shardID uint32, blockNum
 	if response.Error != "" {
 		return nil, errors.New(response.Error)
 	}
+	if response.Data.Block == nil {
+		return nil, fmt.Errorf("%w for shard %d, nonce %d", ErrNilBlock, shardID, nonce)
+	}
 
-	return extractMatchingEvents(response, filter), nil
+	return response.Data.Block, nil
 }
 
 func getBlockBytesByNonce(ctx context.Context, ep *proxy, shardID uint32, nonce uint64) ([]byte, error) {
-	cacheKey := make([]byte, 8)
-	binary.BigEndian.PutUint64(cacheKey, nonce)
+	cacheKey := createBlockCacheKey(shardID, nonce)
 
 	cachedResponse, found := ep.filterQueryBlockCacher.Get(cacheKey)
 	if found {
@@ -944,16 +1407,30 @@ func getBlockBytesByNonce(ctx context.Context, ep *proxy, shardID uint32, nonce
 	return buff, nil
 }
 
-func extractMatchingEvents(response data.BlockResponse, filter *sdkCore.FilterQuery) []*transaction.Events {
-	var matchingEvents []*transaction.Events
-	for _, miniblock := range response.Data.Block.MiniBlocks {
+func createBlockCacheKey(shardID uint32, nonce uint64) []byte {
+	cacheKey := make([]byte, 12)
+	binary.BigEndian.PutUint32(cacheKey, shardID)
+	binary.BigEndian.PutUint64(cacheKey[4:], nonce)
+
+	return cacheKey
+}
+
+func extractMatchingEvents(block *api.Block, filter *sdkCore.FilterQuery) []*blockEvent {
+	var matchingEvents []*blockEvent
+	txIndex := 0
+	for _, miniblock := range block.MiniBlocks {
 		for _, tx := range miniblock.Transactions {
+			txIndex++
 			if tx.Logs == nil {
 				continue
 			}
-			for _, event := range tx.Logs.Events {
+			for eventIndex, event := range tx.Logs.Events {
 				if matchesFilter(filter, event) {
-					matchingEvents = append(matchingEvents, event)
+					matchingEvents = append(matchingEvents, &blockEvent{
+						txIndex:    txIndex,
+						eventIndex: eventIndex,
+						event:      event,
+					})
 				}
 			}
 		}
@@ -34,6 +34,8 @@ const testHttpURL = "https://test.org"
 const networkConfigEndpoint = "network/config"
 const getNetworkStatusEndpoint = "network/status/%d"
 const getNodeStatusEndpoint = "node/status"
+const testGenesisTimestamp = 1596117600
+const testRoundDurationInSeconds = 6
 
 // not a real-world valid test query option but rather a test one to check all fields are properly set
 var testQueryOptions = api.AccountQueryOptions{
@@ -1339,7 +1341,7 @@ func TestProxy_FilterLogs(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
-	t.Run("should fail for addresses from different shards", func(t *testing.T) {
+	t.Run("observer should fail for addresses from different shards", func(t *testing.T) {
 
 		invalidFilter := &sdkCore.FilterQuery{
 			FromBlock: core.OptionalUint64{Value: 21000005, HasValue: true},
@@ -1364,7 +1366,7 @@ func TestProxy_FilterLogs(t *testing.T) {
 		ep, _ := NewProxy(args)
 
 		res, err := ep.FilterLogs(context.Background(), invalidFilter)
-		assert.Equal(t, ErrAddressesFromDifferentShards, err)
+		assert.True(t, errors.Is(err, ErrShardIDMismatch))
 		assert.Nil(t, res)
 	})
 
@@ -1598,4 +1600,499 @@ func TestProxy_FilterLogs(t *testing.T) {
 		assert.Equal(t, res2[6].Address, "erd1qqqqqqqqqqqqqpgqstmgzmwfm5q3y3r0gkv0fp3j07chyv69h4vq7md7fd")
 		assert.Equal(t, len(res2[6].Topics), 1)
 	})
+
+	t.Run("should query all the shards of the provided addresses", func(t *testing.T) {
+		validFilter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 21000005, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 21000005, HasValue: true},
+			Addresses: []string{
+				"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu", // address from shard 1
+				"erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y", // address from shard 0
+			},
+		}
+
+		// the block 21000005 of shard 0 was proposed in round 21008280, when shard 1 proposed its block 490
+		responseMap := map[string][]byte{
+			"https://test.org/network/status/0":                                     createNetworkStatusResponseBytes(0, 21980327, 21988602),
+			"https://test.org/network/status/1":                                     createNetworkStatusResponseBytes(1, 500, 21008290),
+			"https://test.org/block/0/by-nonce/21000005?withTxs=true&withLogs=true": httpDataBlock21000005,
+			"https://test.org/block/1/by-nonce/490?withTxs=true&withLogs=true":      createBlockWithLogsResponseBytes(t, 1, 490, 21008280, []string{"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu"}),
+			"https://test.org/block/1/by-nonce/491?withTxs=true&withLogs=true":      createBlockWithLogsResponseBytes(t, 1, 491, 21008281, []string{"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu"}),
+			"https://test.org/network/config":                                       httpNetworkConfig,
+		}
+
+		httpClient := createMockClientMultiResponse(responseMap)
+		args := createMockArgsProxy(httpClient)
+		args.EntityType = sdkCore.Proxy
+		ep, _ := NewProxy(args)
+
+		res, err := ep.FilterLogs(context.Background(), validFilter)
+		require.Nil(t, err)
+		require.Equal(t, 4, len(res))
+
+		identifiers := make([]string, 0, len(res))
+		for _, event := range res {
+			identifiers = append(identifiers, event.Identifier)
+		}
+		assert.Contains(t, identifiers, "shard 1 block 490 tx 1")
+		assert.NotContains(t, identifiers, "shard 1 block 491 tx 1")
+	})
+
+	t.Run("should query the other shards for the rounds of the block range", func(t *testing.T) {
+		validFilter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 100, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 102, HasValue: true},
+			Addresses: []string{
+				"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu", // address from shard 1
+				"erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y", // address from shard 0
+			},
+		}
+
+		// shard 0 proposed the blocks 100, 101 and 102 in the rounds 1000, 1001 and 1002, while shard 1, at a different
+		// height, missed the round 1000 and proposed the blocks 4901 and 4902 in the rounds 1001 and 1002
+		chains := map[uint32]*mockShardChain{
+			0: {
+				latestNonce:     200,
+				currentRound:    1100,
+				roundOfBlock:    func(nonce uint64) uint64 { return nonce + 900 },
+				eventsAddresses: []string{"erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y"},
+			},
+			1: {
+				latestNonce:  5000,
+				currentRound: 1100,
+				roundOfBlock: func(nonce uint64) uint64 {
+					if nonce < 4901 {
+						return nonce - 3902
+					}
+					return nonce - 3900
+				},
+				eventsAddresses: []string{"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu"},
+			},
+		}
+		args := createMockArgsProxy(createMockClientForShardChains(t, httpNetworkConfig, chains))
+		args.EntityType = sdkCore.Proxy
+		ep, _ := NewProxy(args)
+
+		res, err := ep.FilterLogs(context.Background(), validFilter)
+		require.Nil(t, err)
+		require.Equal(t, 5, len(res))
+		assert.Equal(t, "shard 0 block 100 tx 1", res[0].Identifier)
+		assert.Equal(t, "shard 0 block 101 tx 1", res[1].Identifier)
+		assert.Equal(t, "shard 1 block 4901 tx 1", res[2].Identifier)
+		assert.Equal(t, "shard 0 block 102 tx 1", res[3].Identifier)
+		assert.Equal(t, "shard 1 block 4902 tx 1", res[4].Identifier)
+	})
+
+	t.Run("should merge the shards by block timestamp before applying the limit", func(t *testing.T) {
+		validFilter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 100, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 101, HasValue: true},
+			Addresses: []string{
+				"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu", // address from shard 1
+				"erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y", // address from shard 0
+			},
+			Limit: 1,
+		}
+
+		// the first matching event of shard 0 is in its second block, while shard 1 has one in the first round
+		chains := map[uint32]*mockShardChain{
+			0: {
+				latestNonce:     200,
+				currentRound:    1100,
+				roundOfBlock:    func(nonce uint64) uint64 { return nonce + 900 },
+				eventsAddresses: []string{"erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y"},
+				eventsFromNonce: 101,
+			},
+			1: {
+				latestNonce:     5000,
+				currentRound:    1100,
+				roundOfBlock:    func(nonce uint64) uint64 { return nonce - 3900 },
+				eventsAddresses: []string{"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu"},
+			},
+		}
+		args := createMockArgsProxy(createMockClientForShardChains(t, httpNetworkConfig, chains))
+		args.EntityType = sdkCore.Proxy
+		ep, _ := NewProxy(args)
+
+		res, err := ep.FilterLogs(context.Background(), validFilter)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(res))
+		assert.Equal(t, "shard 1 block 4900 tx 1", res[0].Identifier)
+	})
+
+	t.Run("blocks with the same timestamp should be merged by transaction and event index", func(t *testing.T) {
+		validFilter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 21000000, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 21000000, HasValue: true},
+			Addresses: []string{
+				"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu", // address from shard 1
+				"erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y", // address from shard 0
+			},
+		}
+
+		responseMap := map[string][]byte{
+			"https://test.org/network/status/0":                                     createNetworkStatusResponseBytes(0, 21980327, 21980427),
+			"https://test.org/network/status/1":                                     createNetworkStatusResponseBytes(1, 300, 200),
+			"https://test.org/block/0/by-nonce/21000000?withTxs=true&withLogs=true": createBlockWithLogsResponseBytes(t, 0, 21000000, 100, []string{"", "erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y"}),
+			"https://test.org/block/1/by-nonce/200?withTxs=true&withLogs=true":      createBlockWithLogsResponseBytes(t, 1, 200, 100, []string{"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu", "erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu"}),
+			"https://test.org/block/1/by-nonce/201?withTxs=true&withLogs=true":      createBlockWithLogsResponseBytes(t, 1, 201, 101, nil),
+			"https://test.org/network/config":                                       httpNetworkConfig,
+		}
+
+		httpClient := createMockClientMultiResponse(responseMap)
+		args := createMockArgsProxy(httpClient)
+		args.EntityType = sdkCore.Proxy
+		ep, _ := NewProxy(args)
+
+		res, err := ep.FilterLogs(context.Background(), validFilter)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(res))
+		assert.Equal(t, "shard 1 block 200 tx 1", res[0].Identifier)
+		assert.Equal(t, "shard 0 block 21000000 tx 2", res[1].Identifier)
+		assert.Equal(t, "shard 1 block 200 tx 2", res[2].Identifier)
+	})
+
+	t.Run("addresses from different shards and provided shard ID should error", func(t *testing.T) {
+		invalidFilter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 21000005, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 21000005, HasValue: true},
+			Addresses: []string{
+				"erd1qqqqqqqqqqqqqpgqta0tv8d5pjzmwzshrtw62n4nww9kxtl278ssspxpxu",
+				"erd1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqd0fj5y",
+			},
+			ShardID: core.OptionalUint32{Value: 0, HasValue: true},
+		}
+
+		responseMap := map[string][]byte{
+			"https://test.org/network/config": httpNetworkConfig,
+		}
+
+		httpClient := createMockClientMultiResponse(responseMap)
+		args := createMockArgsProxy(httpClient)
+		args.EntityType = sdkCore.Proxy
+		ep, _ := NewProxy(args)
+
+		res, err := ep.FilterLogs(context.Background(), invalidFilter)
+		assert.True(t, errors.Is(err, ErrShardIDMismatch))
+		assert.Nil(t, res)
+	})
+
+	t.Run("should fetch blocks concurrently and keep the blocks order", func(t *testing.T) {
+		validFilter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 21000000, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 21000005, HasValue: true},
+			ShardID:   core.OptionalUint32{Value: 0, HasValue: true},
+		}
+
+		blocks := map[string][]byte{
+			"/block/0/by-nonce/21000000": httpDataBlock21000000,
+			"/block/0/by-nonce/21000001": httpDataBlock21000001,
+			"/block/0/by-nonce/21000002": httpDataBlock21000005,
+			"/block/0/by-nonce/21000003": httpDataBlock21000000,
+			"/block/0/by-nonce/21000004": httpDataBlock21000001,
+			"/block/0/by-nonce/21000005": httpDataBlock21000005,
+		}
+		numInFlight := int32(0)
+		maxInFlight := int32(0)
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path == "/network/status/0" {
+					return &http.Response{
+						Body:       io.NopCloser(bytes.NewReader(createNetworkStatusResponseBytes(0, 21980327, 21988602))),
+						StatusCode: http.StatusOK,
+					}, nil
+				}
+
+				current := atomic.AddInt32(&numInFlight, 1)
+				defer atomic.AddInt32(&numInFlight, -1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+
+				// later blocks are answered faster
+				nonce := req.URL.Path[len(req.URL.Path)-1] - '0'
+				time.Sleep(time.Millisecond * time.Duration(10*(6-nonce)))
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(blocks[req.URL.Path])),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.EntityType = sdkCore.Proxy
+		args.FilterQueryMaxConcurrentRequests = 3
+		ep, _ := NewProxy(args)
+
+		res, err := ep.FilterLogs(context.Background(), validFilter)
+		require.Nil(t, err)
+
+		argsSequential := createMockArgsProxy(createMockClientMultiResponse(map[string][]byte{
+			"https://test.org/network/status/0":                                     createNetworkStatusResponseBytes(0, 21980327, 21988602),
+			"https://test.org/block/0/by-nonce/21000000?withTxs=true&withLogs=true": httpDataBlock21000000,
+			"https://test.org/block/0/by-nonce/21000001?withTxs=true&withLogs=true": httpDataBlock21000001,
+			"https://test.org/block/0/by-nonce/21000002?withTxs=true&withLogs=true": httpDataBlock21000005,
+			"https://test.org/block/0/by-nonce/21000003?withTxs=true&withLogs=true": httpDataBlock21000000,
+			"https://test.org/block/0/by-nonce/21000004?withTxs=true&withLogs=true": httpDataBlock21000001,
+			"https://test.org/block/0/by-nonce/21000005?withTxs=true&withLogs=true": httpDataBlock21000005,
+		}))
+		argsSequential.EntityType = sdkCore.Proxy
+		argsSequential.FilterQueryMaxConcurrentRequests = 1
+		epSequential, _ := NewProxy(argsSequential)
+
+		expectedRes, err := epSequential.FilterLogs(context.Background(), validFilter)
+		require.Nil(t, err)
+		assert.Equal(t, 34, len(expectedRes))
+		assert.Equal(t, expectedRes, res)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&maxInFlight))
+	})
+
+	t.Run("should honor the limit", func(t *testing.T) {
+		validFilter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 21000000, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 21000005, HasValue: true},
+			ShardID:   core.OptionalUint32{Value: 0, HasValue: true},
+			Limit:     2,
+		}
+
+		// the blocks after 21000000 are not available, the fetching should stop before processing them
+		responseMap := map[string][]byte{
+			"https://test.org/node/status":                                        httpNodeStatus,
+			"https://test.org/block/by-nonce/21000000?withTxs=true&withLogs=true": httpDataBlock21000000,
+		}
+
+		httpClient := createMockClientMultiResponse(responseMap)
+		args := createMockArgsProxy(httpClient)
+		ep, _ := NewProxy(args)
+
+		res, err := ep.FilterLogs(context.Background(), validFilter)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(res))
+		assert.Equal(t, "ESDTTransfer", res[0].Identifier)
+		assert.Equal(t, "writeLog", res[1].Identifier)
+	})
+
+	t.Run("invalid max concurrent requests should error", func(t *testing.T) {
+		args := createMockArgsProxy(nil)
+		args.FilterQueryMaxConcurrentRequests = -1
+		ep, err := NewProxy(args)
+		assert.True(t, check.IfNil(ep))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+}
+
+// createBlockWithLogsResponseBytes returns a block holding a transaction with one event for each provided address. An
+// empty address means a transaction without logs
+func createBlockWithLogsResponseBytes(tb testing.TB, shardID uint32, nonce uint64, round uint64, eventsAddresses []string) []byte {
+	transactions := make([]*transaction.ApiTransactionResult, 0, len(eventsAddresses))
+	for idx, address := range eventsAddresses {
+		tx := &transaction.ApiTransactionResult{}
+		if len(address) > 0 {
+			tx.Logs = &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{
+						Address:    address,
+						Identifier: fmt.Sprintf("shard %d block %d tx %d", shardID, nonce, idx+1),
+					},
+				},
+			}
+		}
+		transactions = append(transactions, tx)
+	}
+
+	response := data.BlockResponse{}
+	response.Data.Block = &api.Block{
+		Nonce:     nonce,
+		Shard:     shardID,
+		Round:     round,
+		Timestamp: time.Duration(testGenesisTimestamp + round*testRoundDurationInSeconds),
+		MiniBlocks: []*api.MiniBlock{
+			{
+				Transactions: transactions,
+			},
+		},
+	}
+	buff, err := json.Marshal(response)
+	require.Nil(tb, err)
+
+	return buff
+}
+
+func createNetworkStatusResponseBytes(shardID uint32, nonce uint64, currentRound uint64) []byte {
+	response := data.NetworkStatusResponse{}
+	response.Data.Status = &data.NetworkStatus{
+		CurrentRound: currentRound,
+		Nonce:        nonce,
+		HighestNonce: nonce,
+		ShardID:      shardID,
+	}
+	buff, _ := json.Marshal(response)
+
+	return buff
+}
+
+// mockShardChain describes the blocks of a shard, each block holding an event for each of the events addresses,
+// starting with the eventsFromNonce block
+type mockShardChain struct {
+	latestNonce     uint64
+	currentRound    uint64
+	roundOfBlock    func(nonce uint64) uint64
+	eventsAddresses []string
+	eventsFromNonce uint64
+}
+
+func createMockClientForShardChains(tb testing.TB, httpNetworkConfig []byte, chains map[uint32]*mockShardChain) *mockHTTPClient {
+	return &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			var responseBytes []byte
+			var shardID uint32
+			var nonce uint64
+			switch {
+			case req.URL.Path == "/network/config":
+				responseBytes = httpNetworkConfig
+			case strings.HasPrefix(req.URL.Path, "/network/status/"):
+				_, err := fmt.Sscanf(req.URL.Path, "/network/status/%d", &shardID)
+				require.Nil(tb, err)
+				responseBytes = createNetworkStatusResponseBytes(shardID, chains[shardID].latestNonce, chains[shardID].currentRound)
+			default:
+				_, err := fmt.Sscanf(req.URL.Path, "/block/%d/by-nonce/%d", &shardID, &nonce)
+				require.Nil(tb, err)
+				chain := chains[shardID]
+				if nonce > chain.latestNonce {
+					return nil, fmt.Errorf("no response for URL: %s", req.URL.String())
+				}
+
+				var eventsAddresses []string
+				if nonce >= chain.eventsFromNonce {
+					eventsAddresses = chain.eventsAddresses
+				}
+				responseBytes = createBlockWithLogsResponseBytes(tb, shardID, nonce, chain.roundOfBlock(nonce), eventsAddresses)
+			}
+
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewReader(responseBytes)),
+				StatusCode: http.StatusOK,
+			}, nil
+		},
+	}
+}
+
+func TestProxy_GetProof(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	rootHash := []byte("root hash")
+	address, _ := data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqfzydqmdw7m2vazsp6u5p95yxz76t2p9rd8ss0zp9ts")
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		response, err := ep.GetProof(context.Background(), rootHash, nil)
+		assert.Nil(t, response)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		response, err := ep.GetProof(context.Background(), rootHash, data.NewAddressFromBytes([]byte("invalid")))
+		assert.Nil(t, response)
+		assert.Equal(t, ErrInvalidAddress, err)
+	})
+	t.Run("invalid status should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytesWithStatus(make([]byte, 0), http.StatusNotFound)))
+
+		response, err := ep.GetProof(context.Background(), rootHash, address)
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, ErrHTTPStatusCodeIsNotOK)
+	})
+	t.Run("response returned error should error", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes, _ := json.Marshal(&data.ProofResponse{Error: expectedErr.Error()})
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(responseBytes)))
+
+		response, err := ep.GetProof(context.Background(), rootHash, address)
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr.Error(), err.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProof := data.AccountProof{
+			Proof: []string{"aa", "bb"},
+			Value: "cc",
+		}
+		responseBytes, _ := json.Marshal(&data.ProofResponse{Data: expectedProof})
+		bech32Address, _ := address.AddressAsBech32String()
+		expectedURL := fmt.Sprintf("%s/proof/root-hash/%s/address/%s", testHttpURL, hex.EncodeToString(rootHash), bech32Address)
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientMultiResponse(map[string][]byte{expectedURL: responseBytes})))
+
+		response, err := ep.GetProof(context.Background(), rootHash, address)
+		assert.Nil(t, err)
+		assert.Equal(t, &expectedProof, response)
+	})
+}
+
+func TestProxy_GetProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	rootHash := []byte("root hash")
+	key := []byte("key")
+	address, _ := data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqfzydqmdw7m2vazsp6u5p95yxz76t2p9rd8ss0zp9ts")
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		response, err := ep.GetProofDataTrie(context.Background(), rootHash, nil, key)
+		assert.Nil(t, response)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("http client errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingError(expectedErr)))
+
+		response, err := ep.GetProofDataTrie(context.Background(), rootHash, address, key)
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("response returned error should error", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes, _ := json.Marshal(&data.DataTrieProofResponse{Error: expectedErr.Error()})
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(responseBytes)))
+
+		response, err := ep.GetProofDataTrie(context.Background(), rootHash, address, key)
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr.Error(), err.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProof := data.DataTrieProof{
+			Value:            "dd",
+			DataTrieRootHash: "ee",
+		}
+		expectedProof.Proofs.MainProof = []string{"aa"}
+		expectedProof.Proofs.DataTrieProof = []string{"bb", "cc"}
+		responseBytes, _ := json.Marshal(&data.DataTrieProofResponse{Data: expectedProof})
+		bech32Address, _ := address.AddressAsBech32String()
+		expectedURL := fmt.Sprintf("%s/proof/root-hash/%s/address/%s/key/%s", testHttpURL,
+			hex.EncodeToString(rootHash), bech32Address, hex.EncodeToString(key))
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientMultiResponse(map[string][]byte{expectedURL: responseBytes})))
+
+		response, err := ep.GetProofDataTrie(context.Background(), rootHash, address, key)
+		assert.Nil(t, err)
+		assert.Equal(t, &expectedProof, response)
+	})
 }
@@ -46,3 +46,6 @@ var ErrMissingGuardianOption = errors.New("guardian flag is missing in the optio
 
 // ErrGuardianDoesNotMatch signals a mismatch between the configured guardian in tx and the signing guardian address
 var ErrGuardianDoesNotMatch = errors.New("configured guardian does not match signing guardian")
+
+// ErrNilTransactionSigner signals that a nil transaction signer was provided
+var ErrNilTransactionSigner = errors.New("nil transaction signer")
@@ -2,6 +2,7 @@ package builders
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"math/big"
@@ -56,19 +57,90 @@ func (builder *txBuilder) ApplyUserSignature(
 }
 
 func (builder *txBuilder) signTx(unsignedTx *transaction.FrontendTransaction, userCryptoHolder core.CryptoComponentsHolder) ([]byte, error) {
+	unsignedMessage, err := ComputeSigningMessage(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.signer.SignByteSlice(unsignedMessage, userCryptoHolder.GetPrivateKey())
+}
+
+// ApplyUserSignatureWithSigner will apply the sender provided by the transaction signer and set the user signature
+// field. The private key is kept by the transaction signer
+func (builder *txBuilder) ApplyUserSignatureWithSigner(
+	ctx context.Context,
+	txSigner core.TransactionSigner,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(txSigner) {
+		return ErrNilTransactionSigner
+	}
+
+	tx.Sender = txSigner.GetBech32()
+	signature, err := txSigner.SignTransaction(ctx, TransactionToUnsignedTx(tx))
+	if err != nil {
+		return err
+	}
+
+	tx.Signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+// ApplyGuardianSignatureWithSigner applies the guardian signature over the transaction using the transaction signer.
+// Does a basic check for the transaction options and guardian address.
+func (builder *txBuilder) ApplyGuardianSignatureWithSigner(
+	ctx context.Context,
+	guardianSigner core.TransactionSigner,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(guardianSigner) {
+		return ErrNilTransactionSigner
+	}
+
+	nodeTx, err := transactionToNodeTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	if !nodeTx.HasOptionGuardianSet() {
+		return ErrMissingGuardianOption
+	}
+
+	if tx.GuardianAddr != guardianSigner.GetBech32() {
+		return ErrGuardianDoesNotMatch
+	}
+
+	guardianSignature, err := guardianSigner.SignTransaction(ctx, TransactionToUnsignedTx(tx))
+	if err != nil {
+		return err
+	}
+
+	tx.GuardianSignature = hex.EncodeToString(guardianSignature)
+
+	return nil
+}
+
+// ComputeSigningMessage returns the message that has to be signed for the provided transaction. The signature
+// fields of the transaction are ignored
+func ComputeSigningMessage(tx *transaction.FrontendTransaction) ([]byte, error) {
 	// TODO: refactor to use Transaction from core so that GetDataForSigning can be used (this logic is duplicated in core)
-	unsignedMessage, err := json.Marshal(unsignedTx)
+	unsignedMessage, err := json.Marshal(TransactionToUnsignedTx(tx))
 	if err != nil {
 		return nil, err
 	}
 
-	shouldSignOnTxHash := unsignedTx.Version >= 2 && unsignedTx.Options&1 > 0
-	if shouldSignOnTxHash {
+	if IsHashSigningTransaction(tx) {
 		log.Debug("signing the transaction using the hash of the message")
 		unsignedMessage = hashSigningTxHasher.Compute(string(unsignedMessage))
 	}
 
-	return builder.signer.SignByteSlice(unsignedMessage, userCryptoHolder.GetPrivateKey())
+	return unsignedMessage, nil
+}
+
+// IsHashSigningTransaction returns true if the transaction has to be signed over the hash of its message
+func IsHashSigningTransaction(tx *transaction.FrontendTransaction) bool {
+	return tx.Version >= 2 && tx.Options&1 > 0
 }
 
 // ApplyGuardianSignature applies the guardian signature over the transaction.
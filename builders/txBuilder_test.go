@@ -1,6 +1,7 @@
 package builders
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"math/big"
@@ -12,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/testsCommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -222,3 +224,101 @@ func TestTxBuilder_ApplyUserSignatureAndGenerateWithTxGuardian(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestTxBuilder_ApplySignaturesWithSigner(t *testing.T) {
+	t.Parallel()
+
+	guardianAddress := "erd1p5jgz605m47fq5mlqklpcjth9hdl3au53dg8a5tlkgegfnep3d7stdk09x"
+	skGuardian, err := hex.DecodeString("6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c40d248169f4dd7c90537f05be1c49772ddbf8f7948b507ed17fb23284cf218b7d")
+	require.Nil(t, err)
+	cryptoHolderGuardian, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, skGuardian)
+	require.Nil(t, err)
+
+	sk, err := hex.DecodeString("28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68")
+	require.Nil(t, err)
+	cryptoHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(t, err)
+
+	createTxSigner := func(holder core.CryptoComponentsHolder) *testsCommon.TransactionSignerStub {
+		return &testsCommon.TransactionSignerStub{
+			SignTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error) {
+				assert.Empty(t, tx.Signature)
+				assert.Empty(t, tx.GuardianSignature)
+
+				message, errCompute := ComputeSigningMessage(tx)
+				require.Nil(t, errCompute)
+
+				return cryptoProvider.NewSigner().SignByteSlice(message, holder.GetPrivateKey())
+			},
+			GetBech32Called: holder.GetBech32,
+		}
+	}
+
+	tx := transaction.FrontendTransaction{
+		Nonce:        1,
+		Value:        "11500313000000000000",
+		Receiver:     "erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw",
+		GasPrice:     1000000000,
+		GasLimit:     60000,
+		ChainID:      "T",
+		Version:      uint32(2),
+		Options:      transaction.MaskGuardedTransaction | transaction.MaskSignedWithHash,
+		GuardianAddr: guardianAddress,
+	}
+
+	t.Run("nil signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		txLocal := tx
+		tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+		err := tb.ApplyUserSignatureWithSigner(context.Background(), nil, &txLocal)
+		assert.Equal(t, ErrNilTransactionSigner, err)
+
+		err = tb.ApplyGuardianSignatureWithSigner(context.Background(), nil, &txLocal)
+		assert.Equal(t, ErrNilTransactionSigner, err)
+	})
+	t.Run("signer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		txSigner := &testsCommon.TransactionSignerStub{
+			SignTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		txLocal := tx
+		tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+		err := tb.ApplyUserSignatureWithSigner(context.Background(), txSigner, &txLocal)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("different guardian address should error", func(t *testing.T) {
+		t.Parallel()
+
+		txLocal := tx
+		tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+		err := tb.ApplyUserSignatureWithSigner(context.Background(), createTxSigner(cryptoHolder), &txLocal)
+		require.Nil(t, err)
+
+		err = tb.ApplyGuardianSignatureWithSigner(context.Background(), createTxSigner(cryptoHolder), &txLocal)
+		assert.Equal(t, ErrGuardianDoesNotMatch, err)
+	})
+	t.Run("should produce the same signatures as the crypto holders", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+		expectedTx := tx
+		err := tb.ApplyUserSignature(cryptoHolder, &expectedTx)
+		require.Nil(t, err)
+		err = tb.ApplyGuardianSignature(cryptoHolderGuardian, &expectedTx)
+		require.Nil(t, err)
+
+		txLocal := tx
+		txLocal.Signature = "previous signature"
+		err = tb.ApplyUserSignatureWithSigner(context.Background(), createTxSigner(cryptoHolder), &txLocal)
+		require.Nil(t, err)
+		err = tb.ApplyGuardianSignatureWithSigner(context.Background(), createTxSigner(cryptoHolderGuardian), &txLocal)
+		require.Nil(t, err)
+
+		assert.Equal(t, expectedTx, txLocal)
+	})
+}
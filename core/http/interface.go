@@ -1,8 +1,19 @@
 package http
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // Client is the interface we expect to call in order to do the HTTP requests
 type Client interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// ClientWrapper is the interface of the component created by NewHttpClientWrapper, able to do the HTTP requests on
+// the endpoints of a base URL
+type ClientWrapper interface {
+	GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error)
+	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	IsInterfaceNil() bool
+}
@@ -1,6 +1,11 @@
 package core
 
-import crypto "github.com/multiversx/mx-chain-crypto-go"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
 
 // AddressHandler will handle different implementations of an address
 type AddressHandler interface {
@@ -12,6 +17,15 @@ type AddressHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TransactionSigner is able to sign transactions on behalf of an address without exposing the private key.
+// The provided transaction is unsigned and already contains the sender
+type TransactionSigner interface {
+	SignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error)
+	GetBech32() string
+	GetAddressHandler() AddressHandler
+	IsInterfaceNil() bool
+}
+
 // CryptoComponentsHolder is able to holder and provide all the crypto components
 type CryptoComponentsHolder interface {
 	GetPublicKey() crypto.PublicKey
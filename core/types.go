@@ -14,6 +14,8 @@ const (
 	Proxy RestAPIEntityType = "proxy"
 )
 
+// FilterQuery holds the criteria of a logs query. When the addresses belong to several shards, the FromBlock and ToBlock
+// nonces refer to the shard with the lowest ID
 type FilterQuery struct {
 	BlockHash []byte              // return logs only from block with this hash
 	FromBlock core.OptionalUint64 // beginning of the queried range, no value set means genesis block
@@ -28,4 +30,6 @@ type FilterQuery struct {
 	//
 	// Events are only returned if they match all topics. The order of the topics is not important.
 	Topics [][]byte // Topics is a slice of arrays of 32 bytes each
+
+	Limit uint64 // maximum number of returned events, no value set means no limit
 }
@@ -0,0 +1,17 @@
+package data
+
+import "math/big"
+
+// EGLDTokenIdentifier is the token identifier used for the EGLD deposits
+const EGLDTokenIdentifier = "EGLD"
+
+// Deposit holds the normalized details of a transfer received by a tracked address
+type Deposit struct {
+	Token           string
+	Nonce           uint64
+	Amount          *big.Int
+	Sender          string
+	Receiver        string
+	TxHash          string
+	HyperBlockNonce uint64
+}
@@ -0,0 +1,13 @@
+package data
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// LogEvent holds a log event along with its position on the chain
+type LogEvent struct {
+	ShardID    uint32
+	BlockNonce uint64
+	BlockHash  string
+	TxHash     string
+	EventIndex int
+	Event      *transaction.Events
+}
@@ -25,3 +25,10 @@ type ValidatorsInfoResponse struct {
 	Error string `json:"error"`
 	Code  string `json:"code"`
 }
+
+// EpochNodesConfig holds the data needed to rebuild the nodes config of an epoch
+type EpochNodesConfig struct {
+	Epoch          uint32                      `json:"epoch"`
+	Randomness     []byte                      `json:"randomness"`
+	ValidatorsInfo []*state.ShardValidatorInfo `json:"validatorsInfo"`
+}
@@ -0,0 +1,23 @@
+package data
+
+// SaveBlock holds the log events of a block, as pushed by a notifier service when the block is committed
+type SaveBlock struct {
+	Hash      string
+	ShardID   uint32
+	TimeStamp uint64
+	Events    []*LogEvent
+}
+
+// RevertBlock holds the details of a block that was reverted, as pushed by a notifier service
+type RevertBlock struct {
+	Hash    string `json:"hash"`
+	Nonce   uint64 `json:"nonce"`
+	Round   uint64 `json:"round"`
+	Epoch   uint32 `json:"epoch"`
+	ShardID uint32 `json:"shardId"`
+}
+
+// FinalizedBlock holds the hash of a block that became final, as pushed by a notifier service
+type FinalizedBlock struct {
+	Hash string `json:"hash"`
+}
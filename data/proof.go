@@ -0,0 +1,33 @@
+package data
+
+// ProofResponse holds the account Merkle proof endpoint response
+type ProofResponse struct {
+	Data  AccountProof `json:"data"`
+	Error string       `json:"error"`
+	Code  string       `json:"code"`
+}
+
+// AccountProof holds the hex encoded Merkle proof of an account from the state trie and the hex encoded
+// serialized account, as reported by the proxy
+type AccountProof struct {
+	Proof []string `json:"proof"`
+	Value string   `json:"value"`
+}
+
+// DataTrieProofResponse holds the data trie Merkle proofs endpoint response
+type DataTrieProofResponse struct {
+	Data  DataTrieProof `json:"data"`
+	Error string        `json:"error"`
+	Code  string        `json:"code"`
+}
+
+// DataTrieProof holds the hex encoded Merkle proofs of an account from the state trie and of a key from the
+// account's data trie, together with the value of the key, as reported by the proxy
+type DataTrieProof struct {
+	Proofs struct {
+		MainProof     []string `json:"mainProof"`
+		DataTrieProof []string `json:"dataTrieProof"`
+	} `json:"proofs"`
+	Value            string `json:"value"`
+	DataTrieRootHash string `json:"dataTrieRootHash"`
+}
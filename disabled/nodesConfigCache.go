@@ -0,0 +1,22 @@
+package disabled
+
+import "github.com/multiversx/mx-sdk-go/data"
+
+// NodesConfigCache is a disabled implementation of the nodes config cache
+type NodesConfigCache struct {
+}
+
+// Get returns nil and false
+func (cache *NodesConfigCache) Get(_ uint32) (*data.EpochNodesConfig, bool) {
+	return nil, false
+}
+
+// Put returns nil
+func (cache *NodesConfigCache) Put(_ *data.EpochNodesConfig) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *NodesConfigCache) IsInterfaceNil() bool {
+	return cache == nil
+}
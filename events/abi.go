@@ -0,0 +1,59 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type abiDefinition struct {
+	Events []abiEvent `json:"events"`
+}
+
+type abiEvent struct {
+	Identifier string          `json:"identifier"`
+	Inputs     []abiEventInput `json:"inputs"`
+}
+
+type abiEventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed"`
+}
+
+// LoadABIEvents parses the events section of a smart contract ABI file and returns the event signatures.
+// The indexed inputs are emitted as topics, after the event name, while the other inputs are encoded in the data field
+func LoadABIEvents(abiJSON []byte) ([]EventSignature, error) {
+	definition := &abiDefinition{}
+	err := json.Unmarshal(abiJSON, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([]EventSignature, 0, len(definition.Events))
+	for _, event := range definition.Events {
+		signature := EventSignature{
+			Name:   event.Identifier,
+			Topics: make([]EventField, 0),
+			Data:   make([]EventField, 0),
+		}
+		for _, input := range event.Inputs {
+			field := EventField{
+				Name: input.Name,
+				Type: input.Type,
+			}
+			if input.Indexed {
+				signature.Topics = append(signature.Topics, field)
+			} else {
+				signature.Data = append(signature.Data, field)
+			}
+		}
+
+		err = checkSignature(signature)
+		if err != nil {
+			return nil, fmt.Errorf("%w in ABI event %s", err, event.Identifier)
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
@@ -0,0 +1,270 @@
+package events
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// TransferValueOnlyIdentifier is the identifier of the event emitted on EGLD transfers between accounts
+const TransferValueOnlyIdentifier = "transferValueOnly"
+
+const (
+	numTopicsPerTokenTransfer = 3
+	numTopicsTokenTransfer    = 4
+	numTopicsTransferValue    = 2
+	numTopicsSignalError      = 2
+)
+
+// TokenTransfer holds one token transfer from an ESDT transfer event
+type TokenTransfer struct {
+	Token  string
+	Nonce  uint64
+	Amount *big.Int
+}
+
+// ESDTTransfer is the decoded form of an ESDTTransfer event
+type ESDTTransfer struct {
+	Sender   sdkCore.AddressHandler
+	Receiver sdkCore.AddressHandler
+	Token    string
+	Amount   *big.Int
+	Data     []byte
+}
+
+// ESDTNFTTransfer is the decoded form of an ESDTNFTTransfer event
+type ESDTNFTTransfer struct {
+	Sender   sdkCore.AddressHandler
+	Receiver sdkCore.AddressHandler
+	Token    string
+	Nonce    uint64
+	Amount   *big.Int
+	Data     []byte
+}
+
+// MultiESDTNFTTransfer is the decoded form of a MultiESDTNFTTransfer event
+type MultiESDTNFTTransfer struct {
+	Sender    sdkCore.AddressHandler
+	Receiver  sdkCore.AddressHandler
+	Transfers []TokenTransfer
+	Data      []byte
+}
+
+// TransferValueOnly is the decoded form of a transferValueOnly event
+type TransferValueOnly struct {
+	Sender   sdkCore.AddressHandler
+	Receiver sdkCore.AddressHandler
+	Value    *big.Int
+	Data     []byte
+}
+
+// SignalError is the decoded form of a signalError event. The Sender is the address that emitted the event and the
+// Receiver is the address found in the first topic
+type SignalError struct {
+	Sender   sdkCore.AddressHandler
+	Receiver sdkCore.AddressHandler
+	Message  string
+	Data     []byte
+}
+
+// builtInDecoder adapts a decoding function to the EventDecoder interface
+type builtInDecoder struct {
+	decodeFunc func(event *transaction.Events) (interface{}, error)
+}
+
+// Decode decodes the provided event
+func (decoder *builtInDecoder) Decode(event *transaction.Events) (interface{}, error) {
+	if event == nil {
+		return nil, ErrNilEvent
+	}
+
+	return decoder.decodeFunc(event)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (decoder *builtInDecoder) IsInterfaceNil() bool {
+	return decoder == nil
+}
+
+func createBuiltInDecoders() map[string]EventDecoder {
+	return map[string]EventDecoder{
+		core.BuiltInFunctionESDTTransfer:         &builtInDecoder{decodeFunc: decodeESDTTransfer},
+		core.BuiltInFunctionESDTNFTTransfer:      &builtInDecoder{decodeFunc: decodeESDTNFTTransfer},
+		core.BuiltInFunctionMultiESDTNFTTransfer: &builtInDecoder{decodeFunc: decodeMultiESDTNFTTransfer},
+		TransferValueOnlyIdentifier:              &builtInDecoder{decodeFunc: decodeTransferValueOnly},
+		core.SignalErrorOperation:                &builtInDecoder{decodeFunc: decodeSignalError},
+	}
+}
+
+// decodeESDTTransfer decodes an event with the topics [token, empty nonce, value, receiver]
+func decodeESDTTransfer(event *transaction.Events) (interface{}, error) {
+	transfer, receiver, err := decodeSingleTokenTransfer(event)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := decodeEventAddress(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ESDTTransfer{
+		Sender:   sender,
+		Receiver: receiver,
+		Token:    transfer.Token,
+		Amount:   transfer.Amount,
+		Data:     copyBytes(event.Data),
+	}, nil
+}
+
+// decodeESDTNFTTransfer decodes an event with the topics [token, nonce, value, receiver]
+func decodeESDTNFTTransfer(event *transaction.Events) (interface{}, error) {
+	transfer, receiver, err := decodeSingleTokenTransfer(event)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := decodeEventAddress(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ESDTNFTTransfer{
+		Sender:   sender,
+		Receiver: receiver,
+		Token:    transfer.Token,
+		Nonce:    transfer.Nonce,
+		Amount:   transfer.Amount,
+		Data:     copyBytes(event.Data),
+	}, nil
+}
+
+func decodeSingleTokenTransfer(event *transaction.Events) (TokenTransfer, sdkCore.AddressHandler, error) {
+	if len(event.Topics) != numTopicsTokenTransfer {
+		return TokenTransfer{}, nil, fmt.Errorf("%w for event %s, expected %d, received %d",
+			ErrInvalidNumberOfTopics, event.Identifier, numTopicsTokenTransfer, len(event.Topics))
+	}
+
+	transfer, err := decodeTokenTransfer(event.Topics)
+	if err != nil {
+		return TokenTransfer{}, nil, err
+	}
+
+	receiver, err := decodeTopLevel(AddressType, event.Topics[numTopicsPerTokenTransfer])
+	if err != nil {
+		return TokenTransfer{}, nil, fmt.Errorf("%w for the receiver of event %s", err, event.Identifier)
+	}
+
+	return transfer, receiver.(sdkCore.AddressHandler), nil
+}
+
+// decodeMultiESDTNFTTransfer decodes an event with the topics [token, nonce, value] repeated for each
+// transferred token, followed by the receiver
+func decodeMultiESDTNFTTransfer(event *transaction.Events) (interface{}, error) {
+	numTopics := len(event.Topics)
+	if numTopics < numTopicsTokenTransfer || (numTopics-1)%numTopicsPerTokenTransfer != 0 {
+		return nil, fmt.Errorf("%w for event %s, received %d", ErrInvalidNumberOfTopics, event.Identifier, numTopics)
+	}
+
+	receiver, err := decodeTopLevel(AddressType, event.Topics[numTopics-1])
+	if err != nil {
+		return nil, fmt.Errorf("%w for the receiver of event %s", err, event.Identifier)
+	}
+
+	transfers := make([]TokenTransfer, 0, numTopics/numTopicsPerTokenTransfer)
+	for i := 0; i+numTopicsPerTokenTransfer < numTopics; i += numTopicsPerTokenTransfer {
+		transfer, errDecode := decodeTokenTransfer(event.Topics[i : i+numTopicsPerTokenTransfer])
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	sender, err := decodeEventAddress(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiESDTNFTTransfer{
+		Sender:    sender,
+		Receiver:  receiver.(sdkCore.AddressHandler),
+		Transfers: transfers,
+		Data:      copyBytes(event.Data),
+	}, nil
+}
+
+func decodeTokenTransfer(topics [][]byte) (TokenTransfer, error) {
+	nonce, err := decodeTopLevel(U64Type, topics[1])
+	if err != nil {
+		return TokenTransfer{}, fmt.Errorf("%w for the nonce of token %s", err, topics[0])
+	}
+
+	return TokenTransfer{
+		Token:  string(topics[0]),
+		Nonce:  nonce.(uint64),
+		Amount: big.NewInt(0).SetBytes(topics[2]),
+	}, nil
+}
+
+// decodeTransferValueOnly decodes an event with the topics [value, receiver]
+func decodeTransferValueOnly(event *transaction.Events) (interface{}, error) {
+	if len(event.Topics) != numTopicsTransferValue {
+		return nil, fmt.Errorf("%w for event %s, expected %d, received %d",
+			ErrInvalidNumberOfTopics, event.Identifier, numTopicsTransferValue, len(event.Topics))
+	}
+
+	receiver, err := decodeTopLevel(AddressType, event.Topics[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w for the receiver of event %s", err, event.Identifier)
+	}
+
+	sender, err := decodeEventAddress(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferValueOnly{
+		Sender:   sender,
+		Receiver: receiver.(sdkCore.AddressHandler),
+		Value:    big.NewInt(0).SetBytes(event.Topics[0]),
+		Data:     copyBytes(event.Data),
+	}, nil
+}
+
+// decodeSignalError decodes an event with the topics [address, message]
+func decodeSignalError(event *transaction.Events) (interface{}, error) {
+	if len(event.Topics) != numTopicsSignalError {
+		return nil, fmt.Errorf("%w for event %s, expected %d, received %d",
+			ErrInvalidNumberOfTopics, event.Identifier, numTopicsSignalError, len(event.Topics))
+	}
+
+	receiver, err := decodeTopLevel(AddressType, event.Topics[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w for the receiver of event %s", err, event.Identifier)
+	}
+
+	sender, err := decodeEventAddress(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignalError{
+		Sender:   sender,
+		Receiver: receiver.(sdkCore.AddressHandler),
+		Message:  string(event.Topics[1]),
+		Data:     copyBytes(event.Data),
+	}, nil
+}
+
+func decodeEventAddress(event *transaction.Events) (sdkCore.AddressHandler, error) {
+	address, err := data.NewAddressFromBech32String(event.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the address of event %s", err, event.Identifier)
+	}
+
+	return address, nil
+}
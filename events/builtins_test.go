@@ -0,0 +1,242 @@
+package events
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senderBech32   = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	receiverBech32 = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+)
+
+func createAddressBytes(tb testing.TB, bech32 string) []byte {
+	address, err := data.NewAddressFromBech32String(bech32)
+	require.Nil(tb, err)
+
+	return address.AddressBytes()
+}
+
+func requireBech32(tb testing.TB, expected string, address interface{ AddressAsBech32String() (string, error) }) {
+	bech32, err := address.AddressAsBech32String()
+	require.Nil(tb, err)
+	require.Equal(tb, expected, bech32)
+}
+
+func TestDecodeESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN-abcdef"), nil, big.NewInt(10).Bytes()},
+		}
+		decoded, err := decodeESDTTransfer(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidNumberOfTopics)
+	})
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN-abcdef"), nil, big.NewInt(10).Bytes(), []byte("short")},
+		}
+		decoded, err := decodeESDTTransfer(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidFieldValue)
+	})
+	t.Run("invalid sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    "not a bech32 address",
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN-abcdef"), nil, big.NewInt(10).Bytes(), createAddressBytes(t, receiverBech32)},
+		}
+		decoded, err := decodeESDTTransfer(event)
+		assert.Nil(t, decoded)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN-abcdef"), nil, big.NewInt(1000).Bytes(), createAddressBytes(t, receiverBech32)},
+			Data:       []byte("DirectCall"),
+		}
+		decoded, err := decodeESDTTransfer(event)
+		require.Nil(t, err)
+
+		transfer := decoded.(*ESDTTransfer)
+		requireBech32(t, senderBech32, transfer.Sender)
+		requireBech32(t, receiverBech32, transfer.Receiver)
+		assert.Equal(t, "TKN-abcdef", transfer.Token)
+		assert.Equal(t, big.NewInt(1000), transfer.Amount)
+		assert.Equal(t, []byte("DirectCall"), transfer.Data)
+	})
+}
+
+func TestDecodeESDTNFTTransfer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nonce too large should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "ESDTNFTTransfer",
+			Topics:     [][]byte{[]byte("NFT-abcdef"), make([]byte, 9), big.NewInt(1).Bytes(), createAddressBytes(t, receiverBech32)},
+		}
+		decoded, err := decodeESDTNFTTransfer(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidFieldValue)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "ESDTNFTTransfer",
+			Topics:     [][]byte{[]byte("NFT-abcdef"), {0x01, 0x2c}, big.NewInt(1).Bytes(), createAddressBytes(t, receiverBech32)},
+		}
+		decoded, err := decodeESDTNFTTransfer(event)
+		require.Nil(t, err)
+
+		transfer := decoded.(*ESDTNFTTransfer)
+		requireBech32(t, senderBech32, transfer.Sender)
+		requireBech32(t, receiverBech32, transfer.Receiver)
+		assert.Equal(t, "NFT-abcdef", transfer.Token)
+		assert.Equal(t, uint64(300), transfer.Nonce)
+		assert.Equal(t, big.NewInt(1), transfer.Amount)
+	})
+}
+
+func TestDecodeMultiESDTNFTTransfer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "MultiESDTNFTTransfer",
+			Topics: [][]byte{
+				[]byte("TKN-abcdef"), nil, big.NewInt(10).Bytes(),
+				[]byte("NFT-abcdef"), {0x05},
+				createAddressBytes(t, receiverBech32),
+			},
+		}
+		decoded, err := decodeMultiESDTNFTTransfer(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidNumberOfTopics)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "MultiESDTNFTTransfer",
+			Topics: [][]byte{
+				[]byte("TKN-abcdef"), nil, big.NewInt(10).Bytes(),
+				[]byte("NFT-abcdef"), {0x05}, big.NewInt(1).Bytes(),
+				createAddressBytes(t, receiverBech32),
+			},
+		}
+		decoded, err := decodeMultiESDTNFTTransfer(event)
+		require.Nil(t, err)
+
+		transfer := decoded.(*MultiESDTNFTTransfer)
+		requireBech32(t, senderBech32, transfer.Sender)
+		requireBech32(t, receiverBech32, transfer.Receiver)
+		expectedTransfers := []TokenTransfer{
+			{Token: "TKN-abcdef", Nonce: 0, Amount: big.NewInt(10)},
+			{Token: "NFT-abcdef", Nonce: 5, Amount: big.NewInt(1)},
+		}
+		assert.Equal(t, expectedTransfers, transfer.Transfers)
+	})
+}
+
+func TestDecodeTransferValueOnly(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: TransferValueOnlyIdentifier,
+			Topics:     [][]byte{big.NewInt(10).Bytes()},
+		}
+		decoded, err := decodeTransferValueOnly(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidNumberOfTopics)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		value, _ := hex.DecodeString("879458e92253f40000")
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: TransferValueOnlyIdentifier,
+			Topics:     [][]byte{value, createAddressBytes(t, receiverBech32)},
+			Data:       []byte("DeploySmartContract"),
+		}
+		decoded, err := decodeTransferValueOnly(event)
+		require.Nil(t, err)
+
+		transfer := decoded.(*TransferValueOnly)
+		requireBech32(t, senderBech32, transfer.Sender)
+		requireBech32(t, receiverBech32, transfer.Receiver)
+		expectedValue, _ := big.NewInt(0).SetString("2501000000000000000000", 10)
+		assert.Equal(t, expectedValue, transfer.Value)
+		assert.Equal(t, []byte("DeploySmartContract"), transfer.Data)
+	})
+}
+
+func TestDecodeSignalError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "signalError",
+			Topics:     [][]byte{createAddressBytes(t, receiverBech32)},
+		}
+		decoded, err := decodeSignalError(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidNumberOfTopics)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Events{
+			Address:    senderBech32,
+			Identifier: "signalError",
+			Topics:     [][]byte{createAddressBytes(t, receiverBech32), []byte("insufficient funds")},
+			Data:       []byte("@75736572206572726f72"),
+		}
+		decoded, err := decodeSignalError(event)
+		require.Nil(t, err)
+
+		signalError := decoded.(*SignalError)
+		requireBech32(t, senderBech32, signalError.Sender)
+		requireBech32(t, receiverBech32, signalError.Receiver)
+		assert.Equal(t, "insufficient funds", signalError.Message)
+		assert.Equal(t, []byte("@75736572206572726f72"), signalError.Data)
+	})
+}
@@ -0,0 +1,36 @@
+package events
+
+import "errors"
+
+// ErrNilEvent signals that a nil event was provided
+var ErrNilEvent = errors.New("nil event")
+
+// ErrNilEventDecoder signals that a nil event decoder was provided
+var ErrNilEventDecoder = errors.New("nil event decoder")
+
+// ErrEmptyEventIdentifier signals that an empty event identifier was provided
+var ErrEmptyEventIdentifier = errors.New("empty event identifier")
+
+// ErrDecoderAlreadyRegistered signals that a decoder was already registered for the same identifier
+var ErrDecoderAlreadyRegistered = errors.New("decoder already registered")
+
+// ErrDecoderNotFound signals that no decoder was registered for the provided event
+var ErrDecoderNotFound = errors.New("decoder not found")
+
+// ErrInvalidNumberOfTopics signals that the event contains an invalid number of topics
+var ErrInvalidNumberOfTopics = errors.New("invalid number of topics")
+
+// ErrEventNameMismatch signals that the event name found in the first topic does not match the signature
+var ErrEventNameMismatch = errors.New("event name mismatch")
+
+// ErrUnsupportedFieldType signals that an unsupported field type was provided
+var ErrUnsupportedFieldType = errors.New("unsupported field type")
+
+// ErrInvalidFieldValue signals that a field value could not be decoded
+var ErrInvalidFieldValue = errors.New("invalid field value")
+
+// ErrEmptyFieldName signals that an empty field name was provided
+var ErrEmptyFieldName = errors.New("empty field name")
+
+// ErrInvalidTarget signals that an invalid decoding target was provided
+var ErrInvalidTarget = errors.New("invalid target")
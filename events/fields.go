@@ -0,0 +1,193 @@
+package events
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// The supported field types, named as in the smart contracts ABI files
+const (
+	AddressType                   = "Address"
+	BigUintType                   = "BigUint"
+	BigIntType                    = "BigInt"
+	U8Type                        = "u8"
+	U16Type                       = "u16"
+	U32Type                       = "u32"
+	U64Type                       = "u64"
+	I8Type                        = "i8"
+	I16Type                       = "i16"
+	I32Type                       = "i32"
+	I64Type                       = "i64"
+	BoolType                      = "bool"
+	TokenIdentifierType           = "TokenIdentifier"
+	EgldOrEsdtTokenIdentifierType = "EgldOrEsdtTokenIdentifier"
+	StringType                    = "utf-8 string"
+	BytesType                     = "bytes"
+	ManagedBufferType             = "ManagedBuffer"
+)
+
+const (
+	addressLen      = 32
+	lengthPrefixLen = 4
+)
+
+var fixedSizes = map[string]int{
+	U8Type:   1,
+	U16Type:  2,
+	U32Type:  4,
+	U64Type:  8,
+	I8Type:   1,
+	I16Type:  2,
+	I32Type:  4,
+	I64Type:  8,
+	BoolType: 1,
+}
+
+// EventField describes a named and typed field of an event
+type EventField struct {
+	Name string
+	Type string
+}
+
+func checkFieldType(fieldType string) error {
+	switch fieldType {
+	case AddressType, BigUintType, BigIntType, TokenIdentifierType, EgldOrEsdtTokenIdentifierType,
+		StringType, BytesType, ManagedBufferType:
+		return nil
+	}
+
+	_, found := fixedSizes[fieldType]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFieldType, fieldType)
+	}
+
+	return nil
+}
+
+// decodeTopLevel decodes a value that occupies the whole buffer, as the topics do
+func decodeTopLevel(fieldType string, buff []byte) (interface{}, error) {
+	switch fieldType {
+	case AddressType:
+		if len(buff) != addressLen {
+			return nil, fmt.Errorf("%w, address length %d", ErrInvalidFieldValue, len(buff))
+		}
+		return data.NewAddressFromBytes(buff), nil
+	case BigUintType:
+		return big.NewInt(0).SetBytes(buff), nil
+	case BigIntType:
+		return decodeSignedBigInt(buff), nil
+	case TokenIdentifierType, EgldOrEsdtTokenIdentifierType, StringType:
+		return string(buff), nil
+	case BytesType, ManagedBufferType:
+		return copyBytes(buff), nil
+	}
+
+	size, found := fixedSizes[fieldType]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFieldType, fieldType)
+	}
+	if len(buff) > size {
+		return nil, fmt.Errorf("%w, %d bytes for type %s", ErrInvalidFieldValue, len(buff), fieldType)
+	}
+
+	padded := make([]byte, size)
+	copy(padded[size-len(buff):], buff)
+	if isSignedType(fieldType) && len(buff) > 0 && buff[0]&0x80 != 0 {
+		for i := 0; i < size-len(buff); i++ {
+			padded[i] = 0xFF
+		}
+	}
+
+	return decodeFixedSize(fieldType, padded)
+}
+
+// decodeNested decodes a value from the beginning of the buffer, returning the number of bytes used
+func decodeNested(fieldType string, buff []byte) (interface{}, int, error) {
+	switch fieldType {
+	case AddressType:
+		if len(buff) < addressLen {
+			return nil, 0, fmt.Errorf("%w, not enough bytes for type %s", ErrInvalidFieldValue, fieldType)
+		}
+		return data.NewAddressFromBytes(buff[:addressLen]), addressLen, nil
+	case BigUintType, BigIntType, TokenIdentifierType, EgldOrEsdtTokenIdentifierType,
+		StringType, BytesType, ManagedBufferType:
+		if len(buff) < lengthPrefixLen {
+			return nil, 0, fmt.Errorf("%w, not enough bytes for the length of type %s", ErrInvalidFieldValue, fieldType)
+		}
+		length := binary.BigEndian.Uint32(buff)
+		if uint64(len(buff)-lengthPrefixLen) < uint64(length) {
+			return nil, 0, fmt.Errorf("%w, not enough bytes for type %s", ErrInvalidFieldValue, fieldType)
+		}
+		end := lengthPrefixLen + int(length)
+		value, err := decodeTopLevel(fieldType, buff[lengthPrefixLen:end])
+		return value, end, err
+	}
+
+	size, found := fixedSizes[fieldType]
+	if !found {
+		return nil, 0, fmt.Errorf("%w: %s", ErrUnsupportedFieldType, fieldType)
+	}
+	if len(buff) < size {
+		return nil, 0, fmt.Errorf("%w, not enough bytes for type %s", ErrInvalidFieldValue, fieldType)
+	}
+
+	value, err := decodeFixedSize(fieldType, buff[:size])
+	return value, size, err
+}
+
+func decodeFixedSize(fieldType string, buff []byte) (interface{}, error) {
+	switch fieldType {
+	case U8Type:
+		return buff[0], nil
+	case U16Type:
+		return binary.BigEndian.Uint16(buff), nil
+	case U32Type:
+		return binary.BigEndian.Uint32(buff), nil
+	case U64Type:
+		return binary.BigEndian.Uint64(buff), nil
+	case I8Type:
+		return int8(buff[0]), nil
+	case I16Type:
+		return int16(binary.BigEndian.Uint16(buff)), nil
+	case I32Type:
+		return int32(binary.BigEndian.Uint32(buff)), nil
+	case I64Type:
+		return int64(binary.BigEndian.Uint64(buff)), nil
+	case BoolType:
+		if buff[0] > 1 {
+			return nil, fmt.Errorf("%w, %d for type %s", ErrInvalidFieldValue, buff[0], fieldType)
+		}
+		return buff[0] == 1, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedFieldType, fieldType)
+}
+
+func isSignedType(fieldType string) bool {
+	switch fieldType {
+	case I8Type, I16Type, I32Type, I64Type:
+		return true
+	default:
+		return false
+	}
+}
+
+func decodeSignedBigInt(buff []byte) *big.Int {
+	value := big.NewInt(0).SetBytes(buff)
+	if len(buff) > 0 && buff[0]&0x80 != 0 {
+		modulus := big.NewInt(0).Lsh(big.NewInt(1), uint(len(buff)*8))
+		value.Sub(value, modulus)
+	}
+
+	return value
+}
+
+func copyBytes(buff []byte) []byte {
+	result := make([]byte, len(buff))
+	copy(result, buff)
+
+	return result
+}
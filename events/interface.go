@@ -0,0 +1,9 @@
+package events
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// EventDecoder defines the behavior of a component able to decode a log event into a Go structure
+type EventDecoder interface {
+	Decode(event *transaction.Events) (interface{}, error)
+	IsInterfaceNil() bool
+}
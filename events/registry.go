@@ -0,0 +1,165 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// eventDecoderRegistry holds the event decoders and selects the right one for each event. The decoders are looked
+// up by the event identifier first and then by the event name found in the first topic, as the smart contract
+// events are emitted with the endpoint name as identifier and the event name as the first topic
+type eventDecoderRegistry struct {
+	mut                  sync.RWMutex
+	decodersByIdentifier map[string]EventDecoder
+	decodersByName       map[string]EventDecoder
+}
+
+// NewEventDecoderRegistry creates a new instance of the eventDecoderRegistry that already contains the decoders
+// for the ESDTTransfer, ESDTNFTTransfer, MultiESDTNFTTransfer, transferValueOnly and signalError events
+func NewEventDecoderRegistry() *eventDecoderRegistry {
+	return &eventDecoderRegistry{
+		decodersByIdentifier: createBuiltInDecoders(),
+		decodersByName:       make(map[string]EventDecoder),
+	}
+}
+
+// RegisterDecoder registers a custom decoder for the events with the provided identifier
+func (registry *eventDecoderRegistry) RegisterDecoder(identifier string, decoder EventDecoder) error {
+	if len(identifier) == 0 {
+		return ErrEmptyEventIdentifier
+	}
+	if check.IfNil(decoder) {
+		return ErrNilEventDecoder
+	}
+
+	registry.mut.Lock()
+	defer registry.mut.Unlock()
+
+	return addDecoder(registry.decodersByIdentifier, identifier, decoder)
+}
+
+// RegisterSignature registers a decoder for the provided event signature. The signature is registered by its
+// identifier, if set, otherwise by its name
+func (registry *eventDecoderRegistry) RegisterSignature(signature EventSignature) error {
+	decoder, err := NewSignatureDecoder(signature)
+	if err != nil {
+		return err
+	}
+
+	registry.mut.Lock()
+	defer registry.mut.Unlock()
+
+	return registry.addSignatureDecoder(decoder)
+}
+
+// RegisterABI registers decoders for all the events defined in the provided smart contract ABI
+func (registry *eventDecoderRegistry) RegisterABI(abiJSON []byte) error {
+	signatures, err := LoadABIEvents(abiJSON)
+	if err != nil {
+		return err
+	}
+
+	decoders := make([]*signatureDecoder, 0, len(signatures))
+	for _, signature := range signatures {
+		decoder, errCreate := NewSignatureDecoder(signature)
+		if errCreate != nil {
+			return errCreate
+		}
+		decoders = append(decoders, decoder)
+	}
+
+	registry.mut.Lock()
+	defer registry.mut.Unlock()
+
+	for _, decoder := range decoders {
+		err = registry.addSignatureDecoder(decoder)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (registry *eventDecoderRegistry) addSignatureDecoder(decoder *signatureDecoder) error {
+	if len(decoder.signature.Identifier) > 0 {
+		return addDecoder(registry.decodersByIdentifier, decoder.signature.Identifier, decoder)
+	}
+
+	return addDecoder(registry.decodersByName, decoder.signature.Name, decoder)
+}
+
+func addDecoder(decoders map[string]EventDecoder, key string, decoder EventDecoder) error {
+	_, found := decoders[key]
+	if found {
+		return fmt.Errorf("%w for %s", ErrDecoderAlreadyRegistered, key)
+	}
+
+	decoders[key] = decoder
+
+	return nil
+}
+
+// Decode decodes the provided event with the matching registered decoder. Returns ErrDecoderNotFound if no
+// decoder matches the event
+func (registry *eventDecoderRegistry) Decode(event *transaction.Events) (interface{}, error) {
+	if event == nil {
+		return nil, ErrNilEvent
+	}
+
+	decoder, found := registry.getDecoder(event)
+	if !found {
+		return nil, fmt.Errorf("%w for event %s", ErrDecoderNotFound, event.Identifier)
+	}
+
+	return decoder.Decode(event)
+}
+
+func (registry *eventDecoderRegistry) getDecoder(event *transaction.Events) (EventDecoder, bool) {
+	registry.mut.RLock()
+	defer registry.mut.RUnlock()
+
+	decoder, found := registry.decodersByIdentifier[event.Identifier]
+	if found {
+		return decoder, true
+	}
+	if len(event.Topics) == 0 {
+		return nil, false
+	}
+
+	decoder, found = registry.decodersByName[string(event.Topics[0])]
+
+	return decoder, found
+}
+
+// DecodeEvents decodes the provided events, as returned by FilterLogs. The result has the same length as the
+// provided slice and holds nil values for the events that do not have a registered decoder
+func (registry *eventDecoderRegistry) DecodeEvents(events []*transaction.Events) ([]interface{}, error) {
+	results := make([]interface{}, len(events))
+	for index, event := range events {
+		if event == nil {
+			continue
+		}
+
+		decoder, found := registry.getDecoder(event)
+		if !found {
+			continue
+		}
+
+		decoded, err := decoder.Decode(event)
+		if err != nil {
+			return nil, fmt.Errorf("%w for event at index %d", err, index)
+		}
+		results[index] = decoded
+	}
+
+	return results, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (registry *eventDecoderRegistry) IsInterfaceNil() bool {
+	return registry == nil
+}
@@ -0,0 +1,232 @@
+package events
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eventDecoderStub struct {
+	DecodeCalled func(event *transaction.Events) (interface{}, error)
+}
+
+func (stub *eventDecoderStub) Decode(event *transaction.Events) (interface{}, error) {
+	if stub.DecodeCalled != nil {
+		return stub.DecodeCalled(event)
+	}
+
+	return nil, nil
+}
+
+func (stub *eventDecoderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestEventDecoderRegistry_RegisterDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterDecoder("", &eventDecoderStub{})
+		assert.Equal(t, ErrEmptyEventIdentifier, err)
+	})
+	t.Run("nil decoder should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterDecoder("identifier", nil)
+		assert.Equal(t, ErrNilEventDecoder, err)
+	})
+	t.Run("built in identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterDecoder("ESDTTransfer", &eventDecoderStub{})
+		assert.ErrorIs(t, err, ErrDecoderAlreadyRegistered)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterDecoder("custom", &eventDecoderStub{
+			DecodeCalled: func(event *transaction.Events) (interface{}, error) {
+				return string(event.Data), nil
+			},
+		})
+		require.Nil(t, err)
+
+		decoded, err := registry.Decode(&transaction.Events{Identifier: "custom", Data: []byte("data")})
+		assert.Nil(t, err)
+		assert.Equal(t, "data", decoded)
+	})
+}
+
+func TestEventDecoderRegistry_RegisterSignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterSignature(EventSignature{})
+		assert.Equal(t, ErrEmptyEventIdentifier, err)
+	})
+	t.Run("same signature twice should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterSignature(createBuyOfferSignature())
+		require.Nil(t, err)
+
+		err = registry.RegisterSignature(createBuyOfferSignature())
+		assert.ErrorIs(t, err, ErrDecoderAlreadyRegistered)
+	})
+	t.Run("should decode by identifier", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterSignature(createBuyOfferSignature())
+		require.Nil(t, err)
+
+		decoded, err := registry.Decode(createBuyOfferEvent(t))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), decoded.(*DecodedEvent).Fields["offer_id"])
+	})
+	t.Run("should decode by name", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		signature := createBuyOfferSignature()
+		signature.Identifier = ""
+		err := registry.RegisterSignature(signature)
+		require.Nil(t, err)
+
+		event := createBuyOfferEvent(t)
+		event.Identifier = "anotherEndpoint"
+		decoded, err := registry.Decode(event)
+		require.Nil(t, err)
+		assert.Equal(t, "anotherEndpoint", decoded.(*DecodedEvent).Identifier)
+		assert.Equal(t, big.NewInt(10000), decoded.(*DecodedEvent).Fields["price"])
+	})
+}
+
+func TestEventDecoderRegistry_RegisterABI(t *testing.T) {
+	t.Parallel()
+
+	abiJSON := `{"events": [
+		{"identifier": "buy_offer", "inputs": [
+			{"name": "caller", "type": "Address", "indexed": true},
+			{"name": "offer_id", "type": "u64", "indexed": true},
+			{"name": "token_identifier", "type": "TokenIdentifier", "indexed": true},
+			{"name": "price", "type": "BigUint"},
+			{"name": "delta", "type": "i16"},
+			{"name": "accepted", "type": "bool"}
+		]}
+	]}`
+
+	t.Run("invalid ABI should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterABI([]byte("{"))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		err := registry.RegisterABI([]byte(abiJSON))
+		require.Nil(t, err)
+
+		decoded, err := registry.Decode(createBuyOfferEvent(t))
+		require.Nil(t, err)
+		assert.Equal(t, "TKN-abcdef", decoded.(*DecodedEvent).Fields["token_identifier"])
+
+		err = registry.RegisterABI([]byte(abiJSON))
+		assert.ErrorIs(t, err, ErrDecoderAlreadyRegistered)
+	})
+}
+
+func TestEventDecoderRegistry_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil event should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		decoded, err := registry.Decode(nil)
+		assert.Nil(t, decoded)
+		assert.Equal(t, ErrNilEvent, err)
+	})
+	t.Run("unknown event should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		decoded, err := registry.Decode(&transaction.Events{Identifier: "unknown", Topics: [][]byte{[]byte("unknown")}})
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrDecoderNotFound)
+	})
+	t.Run("built in event should work", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		decoded, err := registry.Decode(&transaction.Events{
+			Address:    senderBech32,
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN-abcdef"), nil, big.NewInt(1000).Bytes(), createAddressBytes(t, receiverBech32)},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000), decoded.(*ESDTTransfer).Amount)
+	})
+}
+
+func TestEventDecoderRegistry_DecodeEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("decoding error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		registry := NewEventDecoderRegistry()
+		_ = registry.RegisterDecoder("custom", &eventDecoderStub{
+			DecodeCalled: func(event *transaction.Events) (interface{}, error) {
+				return nil, expectedErr
+			},
+		})
+
+		results, err := registry.DecodeEvents([]*transaction.Events{{Identifier: "unknown"}, {Identifier: "custom"}})
+		assert.Nil(t, results)
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Contains(t, err.Error(), "index 1")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewEventDecoderRegistry()
+		_ = registry.RegisterSignature(createBuyOfferSignature())
+
+		events := []*transaction.Events{
+			createBuyOfferEvent(t),
+			{Identifier: "unknown"},
+			nil,
+			{
+				Address:    senderBech32,
+				Identifier: "signalError",
+				Topics:     [][]byte{createAddressBytes(t, receiverBech32), []byte("error")},
+			},
+		}
+		results, err := registry.DecodeEvents(events)
+		require.Nil(t, err)
+		require.Equal(t, 4, len(results))
+		assert.IsType(t, &DecodedEvent{}, results[0])
+		assert.Nil(t, results[1])
+		assert.Nil(t, results[2])
+		assert.Equal(t, "error", results[3].(*SignalError).Message)
+	})
+}
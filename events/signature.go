@@ -0,0 +1,212 @@
+package events
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const eventFieldTag = "event"
+
+// EventSignature describes the layout of an event. At least one of the Identifier and Name should be set
+type EventSignature struct {
+	// Identifier is the identifier of the log event, usually the name of the function that emitted it
+	Identifier string
+	// Name is the smart contract event name, emitted as the first topic. The Topics fields describe the topics that follow
+	Name   string
+	Topics []EventField
+	// Data describes the fields encoded in the event's data. A single field is top level encoded while more fields
+	// are nested encoded, one after another
+	Data []EventField
+}
+
+// DecodedEvent is the result of decoding an event based on an EventSignature
+type DecodedEvent struct {
+	Identifier string
+	Name       string
+	Address    core.AddressHandler
+	Fields     map[string]interface{}
+}
+
+// Into copies the decoded fields into the provided struct pointer. A struct field receives the decoded field that
+// has the name set in its `event` tag or, if no tag is set, the decoded field whose name converted to PascalCase
+// is the struct field name (token_identifier and tokenIdentifier both match TokenIdentifier). Decoded fields
+// without a matching struct field are ignored
+func (event *DecodedEvent) Into(target interface{}) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w, a non nil struct pointer is required", ErrInvalidTarget)
+	}
+
+	fieldsByStructName := make(map[string]interface{}, len(event.Fields))
+	for name, fieldValue := range event.Fields {
+		fieldsByStructName[toPascalCase(name)] = fieldValue
+	}
+
+	structValue := value.Elem()
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		fieldValue, found := event.Fields[structField.Tag.Get(eventFieldTag)]
+		if !found {
+			fieldValue, found = fieldsByStructName[structField.Name]
+		}
+		if !found || fieldValue == nil {
+			continue
+		}
+
+		reflectedFieldValue := reflect.ValueOf(fieldValue)
+		if !reflectedFieldValue.Type().AssignableTo(structField.Type) {
+			return fmt.Errorf("%w, field %s of type %s can not hold a value of type %T",
+				ErrInvalidTarget, structField.Name, structField.Type, fieldValue)
+		}
+		structValue.Field(i).Set(reflectedFieldValue)
+	}
+
+	return nil
+}
+
+func toPascalCase(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' '
+	})
+
+	builder := strings.Builder{}
+	for _, part := range parts {
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		builder.WriteString(string(runes))
+	}
+
+	return builder.String()
+}
+
+type signatureDecoder struct {
+	signature EventSignature
+}
+
+// NewSignatureDecoder creates a new event decoder that outputs *DecodedEvent values based on the provided signature
+func NewSignatureDecoder(signature EventSignature) (*signatureDecoder, error) {
+	err := checkSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signatureDecoder{
+		signature: signature,
+	}, nil
+}
+
+func checkSignature(signature EventSignature) error {
+	if len(signature.Identifier) == 0 && len(signature.Name) == 0 {
+		return ErrEmptyEventIdentifier
+	}
+
+	for _, fields := range [][]EventField{signature.Topics, signature.Data} {
+		for index, field := range fields {
+			if len(field.Name) == 0 {
+				return fmt.Errorf("%w at index %d", ErrEmptyFieldName, index)
+			}
+			err := checkFieldType(field.Type)
+			if err != nil {
+				return fmt.Errorf("%w for field %s", err, field.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Decode decodes the provided event into a *DecodedEvent value
+func (decoder *signatureDecoder) Decode(event *transaction.Events) (interface{}, error) {
+	if event == nil {
+		return nil, ErrNilEvent
+	}
+
+	topics := event.Topics
+	if len(decoder.signature.Name) > 0 {
+		if len(topics) == 0 || string(topics[0]) != decoder.signature.Name {
+			return nil, fmt.Errorf("%w, expected %s", ErrEventNameMismatch, decoder.signature.Name)
+		}
+		topics = topics[1:]
+	}
+	if len(topics) != len(decoder.signature.Topics) {
+		return nil, fmt.Errorf("%w for event %s, expected %d, received %d",
+			ErrInvalidNumberOfTopics, decoder.getEventName(), len(decoder.signature.Topics), len(topics))
+	}
+
+	address, err := decodeEventAddress(event)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &DecodedEvent{
+		Identifier: event.Identifier,
+		Name:       decoder.signature.Name,
+		Address:    address,
+		Fields:     make(map[string]interface{}, len(decoder.signature.Topics)+len(decoder.signature.Data)),
+	}
+
+	for index, field := range decoder.signature.Topics {
+		value, err := decodeTopLevel(field.Type, topics[index])
+		if err != nil {
+			return nil, fmt.Errorf("%w for field %s", err, field.Name)
+		}
+		decoded.Fields[field.Name] = value
+	}
+
+	err = decoder.decodeData(event.Data, decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+func (decoder *signatureDecoder) decodeData(buff []byte, decoded *DecodedEvent) error {
+	if len(decoder.signature.Data) == 1 {
+		field := decoder.signature.Data[0]
+		value, err := decodeTopLevel(field.Type, buff)
+		if err != nil {
+			return fmt.Errorf("%w for field %s", err, field.Name)
+		}
+		decoded.Fields[field.Name] = value
+
+		return nil
+	}
+
+	for _, field := range decoder.signature.Data {
+		value, numBytes, err := decodeNested(field.Type, buff)
+		if err != nil {
+			return fmt.Errorf("%w for field %s", err, field.Name)
+		}
+		decoded.Fields[field.Name] = value
+		buff = buff[numBytes:]
+	}
+	if len(decoder.signature.Data) > 0 && len(buff) > 0 {
+		return fmt.Errorf("%w, %d unused data bytes for event %s", ErrInvalidFieldValue, len(buff), decoder.getEventName())
+	}
+
+	return nil
+}
+
+func (decoder *signatureDecoder) getEventName() string {
+	if len(decoder.signature.Name) > 0 {
+		return decoder.signature.Name
+	}
+
+	return decoder.signature.Identifier
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (decoder *signatureDecoder) IsInterfaceNil() bool {
+	return decoder == nil
+}
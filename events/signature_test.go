@@ -0,0 +1,280 @@
+package events
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBuyOfferSignature() EventSignature {
+	return EventSignature{
+		Identifier: "buyOffer",
+		Name:       "buy_offer",
+		Topics: []EventField{
+			{Name: "caller", Type: AddressType},
+			{Name: "offer_id", Type: U64Type},
+			{Name: "token_identifier", Type: TokenIdentifierType},
+		},
+		Data: []EventField{
+			{Name: "price", Type: BigUintType},
+			{Name: "delta", Type: I16Type},
+			{Name: "accepted", Type: BoolType},
+		},
+	}
+}
+
+func createBuyOfferEvent(tb testing.TB) *transaction.Events {
+	eventData, _ := hex.DecodeString("000000022710" + "fffe" + "01")
+	return &transaction.Events{
+		Address:    senderBech32,
+		Identifier: "buyOffer",
+		Topics: [][]byte{
+			[]byte("buy_offer"),
+			createAddressBytes(tb, receiverBech32),
+			{0x07},
+			[]byte("TKN-abcdef"),
+		},
+		Data: eventData,
+	}
+}
+
+func TestNewSignatureDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty identifier and name should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, err := NewSignatureDecoder(EventSignature{})
+		assert.Nil(t, decoder)
+		assert.Equal(t, ErrEmptyEventIdentifier, err)
+	})
+	t.Run("empty field name should error", func(t *testing.T) {
+		t.Parallel()
+
+		signature := createBuyOfferSignature()
+		signature.Data[1].Name = ""
+		decoder, err := NewSignatureDecoder(signature)
+		assert.Nil(t, decoder)
+		assert.ErrorIs(t, err, ErrEmptyFieldName)
+	})
+	t.Run("unsupported field type should error", func(t *testing.T) {
+		t.Parallel()
+
+		signature := createBuyOfferSignature()
+		signature.Topics[0].Type = "Option<Address>"
+		decoder, err := NewSignatureDecoder(signature)
+		assert.Nil(t, decoder)
+		assert.ErrorIs(t, err, ErrUnsupportedFieldType)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, err := NewSignatureDecoder(createBuyOfferSignature())
+		assert.Nil(t, err)
+		assert.False(t, decoder.IsInterfaceNil())
+	})
+}
+
+func TestSignatureDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil event should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(createBuyOfferSignature())
+		decoded, err := decoder.Decode(nil)
+		assert.Nil(t, decoded)
+		assert.Equal(t, ErrNilEvent, err)
+	})
+	t.Run("different event name should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(createBuyOfferSignature())
+		event := createBuyOfferEvent(t)
+		event.Topics[0] = []byte("sell_offer")
+		decoded, err := decoder.Decode(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrEventNameMismatch)
+	})
+	t.Run("invalid number of topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(createBuyOfferSignature())
+		event := createBuyOfferEvent(t)
+		event.Topics = event.Topics[:3]
+		decoded, err := decoder.Decode(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidNumberOfTopics)
+	})
+	t.Run("not enough data bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(createBuyOfferSignature())
+		event := createBuyOfferEvent(t)
+		event.Data = event.Data[:len(event.Data)-1]
+		decoded, err := decoder.Decode(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidFieldValue)
+	})
+	t.Run("unused data bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(createBuyOfferSignature())
+		event := createBuyOfferEvent(t)
+		event.Data = append(event.Data, 0x00)
+		decoded, err := decoder.Decode(event)
+		assert.Nil(t, decoded)
+		assert.ErrorIs(t, err, ErrInvalidFieldValue)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(createBuyOfferSignature())
+		decoded, err := decoder.Decode(createBuyOfferEvent(t))
+		require.Nil(t, err)
+
+		event := decoded.(*DecodedEvent)
+		assert.Equal(t, "buyOffer", event.Identifier)
+		assert.Equal(t, "buy_offer", event.Name)
+		requireBech32(t, senderBech32, event.Address)
+		requireBech32(t, receiverBech32, event.Fields["caller"].(core.AddressHandler))
+		assert.Equal(t, uint64(7), event.Fields["offer_id"])
+		assert.Equal(t, "TKN-abcdef", event.Fields["token_identifier"])
+		assert.Equal(t, big.NewInt(10000), event.Fields["price"])
+		assert.Equal(t, int16(-2), event.Fields["delta"])
+		assert.Equal(t, true, event.Fields["accepted"])
+	})
+	t.Run("single data field is top level encoded", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(EventSignature{
+			Identifier: "withdraw",
+			Topics:     []EventField{{Name: "amount", Type: BigIntType}},
+			Data:       []EventField{{Name: "epoch", Type: U32Type}},
+		})
+		decoded, err := decoder.Decode(&transaction.Events{
+			Address:    senderBech32,
+			Identifier: "withdraw",
+			Topics:     [][]byte{{0xff, 0x38}},
+			Data:       []byte{0x02, 0x9a},
+		})
+		require.Nil(t, err)
+
+		event := decoded.(*DecodedEvent)
+		assert.Equal(t, big.NewInt(-200), event.Fields["amount"])
+		assert.Equal(t, uint32(666), event.Fields["epoch"])
+	})
+}
+
+func TestDecodedEvent_Into(t *testing.T) {
+	t.Parallel()
+
+	type buyOffer struct {
+		Caller          core.AddressHandler
+		OfferID         uint64 `event:"offer_id"`
+		TokenIdentifier string
+		Price           *big.Int
+		Accepted        bool
+		Unmatched       string
+		unexported      int
+	}
+
+	t.Run("invalid target should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &DecodedEvent{}
+		assert.ErrorIs(t, event.Into(nil), ErrInvalidTarget)
+		assert.ErrorIs(t, event.Into(buyOffer{}), ErrInvalidTarget)
+		var nilTarget *buyOffer
+		assert.ErrorIs(t, event.Into(nilTarget), ErrInvalidTarget)
+	})
+	t.Run("type mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		event := &DecodedEvent{
+			Fields: map[string]interface{}{
+				"price": uint64(10),
+			},
+		}
+		err := event.Into(&buyOffer{})
+		assert.ErrorIs(t, err, ErrInvalidTarget)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, _ := NewSignatureDecoder(createBuyOfferSignature())
+		decoded, err := decoder.Decode(createBuyOfferEvent(t))
+		require.Nil(t, err)
+
+		result := &buyOffer{}
+		err = decoded.(*DecodedEvent).Into(result)
+		require.Nil(t, err)
+
+		requireBech32(t, receiverBech32, result.Caller)
+		assert.Equal(t, uint64(7), result.OfferID)
+		assert.Equal(t, "TKN-abcdef", result.TokenIdentifier)
+		assert.Equal(t, big.NewInt(10000), result.Price)
+		assert.True(t, result.Accepted)
+		assert.Empty(t, result.Unmatched)
+		assert.Zero(t, result.unexported)
+	})
+}
+
+func TestLoadABIEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid JSON should error", func(t *testing.T) {
+		t.Parallel()
+
+		signatures, err := LoadABIEvents([]byte("not a JSON"))
+		assert.Nil(t, signatures)
+		assert.NotNil(t, err)
+	})
+	t.Run("unsupported type should error", func(t *testing.T) {
+		t.Parallel()
+
+		abiJSON := `{"events": [{"identifier": "swap", "inputs": [{"name": "tokens", "type": "List<TokenIdentifier>", "indexed": true}]}]}`
+		signatures, err := LoadABIEvents([]byte(abiJSON))
+		assert.Nil(t, signatures)
+		assert.ErrorIs(t, err, ErrUnsupportedFieldType)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		abiJSON := `{
+			"name": "Marketplace",
+			"endpoints": [],
+			"events": [
+				{
+					"identifier": "buy_offer",
+					"inputs": [
+						{"name": "caller", "type": "Address", "indexed": true},
+						{"name": "offer_id", "type": "u64", "indexed": true},
+						{"name": "price", "type": "BigUint"}
+					]
+				}
+			]
+		}`
+		signatures, err := LoadABIEvents([]byte(abiJSON))
+		require.Nil(t, err)
+
+		expected := []EventSignature{
+			{
+				Name: "buy_offer",
+				Topics: []EventField{
+					{Name: "caller", Type: AddressType},
+					{Name: "offer_id", Type: U64Type},
+				},
+				Data: []EventField{
+					{Name: "price", Type: BigUintType},
+				},
+			},
+		}
+		assert.Equal(t, expected, signatures)
+	})
+}
@@ -0,0 +1,21 @@
+package guardians
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// SignTransactionEndpoint is the endpoint of the guardian service used to co-sign a transaction
+const SignTransactionEndpoint = "guardian/sign-transaction"
+
+// SignTransactionRequest is the request sent to the guardian service
+type SignTransactionRequest struct {
+	Code        string                           `json:"code"`
+	Transaction *transaction.FrontendTransaction `json:"transaction"`
+}
+
+// SignTransactionResponse is the response of the guardian service
+type SignTransactionResponse struct {
+	Data struct {
+		Transaction *transaction.FrontendTransaction `json:"transaction"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
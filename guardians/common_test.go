@@ -0,0 +1,51 @@
+package guardians
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/signers"
+	"github.com/stretchr/testify/require"
+)
+
+var keyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+const (
+	guardianSecretKey = "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68"
+	guardianAddress   = "erd1lta2vgd0tkeqqadkvgef73y0efs6n3xe5ss589ufhvmt6tcur8kq34qkwr"
+	ownerAddress      = "erd1p5jgz605m47fq5mlqklpcjth9hdl3au53dg8a5tlkgegfnep3d7stdk09x"
+)
+
+func createGuardianSigner(tb testing.TB) core.TransactionSigner {
+	sk, err := hex.DecodeString(guardianSecretKey)
+	require.Nil(tb, err)
+
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(tb, err)
+
+	txSigner, err := signers.NewInMemoryTransactionSigner(holder, cryptoProvider.NewSigner())
+	require.Nil(tb, err)
+
+	return txSigner
+}
+
+func createGuardedTransaction() *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Nonce:        7,
+		Value:        "0",
+		Receiver:     ownerAddress,
+		Sender:       ownerAddress,
+		GasPrice:     1000000000,
+		GasLimit:     50000 + ExtraGasLimitForGuardedTx,
+		ChainID:      "T",
+		Version:      2,
+		Options:      transaction.MaskGuardedTransaction,
+		GuardianAddr: guardianAddress,
+		Signature:    "aabb",
+	}
+}
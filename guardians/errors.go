@@ -0,0 +1,57 @@
+package guardians
+
+import "errors"
+
+// ErrNilProxy signals that a nil proxy was provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilCoSigner signals that a nil co-signer was provided
+var ErrNilCoSigner = errors.New("nil co-signer")
+
+// ErrNilTransactionSigner signals that a nil transaction signer was provided
+var ErrNilTransactionSigner = errors.New("nil transaction signer")
+
+// ErrNilAddress signals that a nil address was provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilGuardianData signals that nil guardian data was received
+var ErrNilGuardianData = errors.New("nil guardian data")
+
+// ErrEmptyServiceUID signals that an empty service UID was provided
+var ErrEmptyServiceUID = errors.New("empty service UID")
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrAccountNotGuarded signals that the account is not guarded
+var ErrAccountNotGuarded = errors.New("account is not guarded")
+
+// ErrAccountAlreadyGuarded signals that the account is already guarded
+var ErrAccountAlreadyGuarded = errors.New("account is already guarded")
+
+// ErrNoActiveGuardian signals that the account does not have an active guardian
+var ErrNoActiveGuardian = errors.New("no active guardian")
+
+// ErrGuardianMismatch signals that the guardian of the transaction is not the one handled by the co-signer
+var ErrGuardianMismatch = errors.New("guardian mismatch")
+
+// ErrMissingGuardianOption signals that the guarded option is not set on the transaction
+var ErrMissingGuardianOption = errors.New("missing guardian option")
+
+// ErrInvalidCode signals that an invalid 2FA code was provided
+var ErrInvalidCode = errors.New("invalid code")
+
+// ErrCoSigner signals that the guardian service returned an error
+var ErrCoSigner = errors.New("co-signer error")
+
+// ErrInvalidSignature signals that an invalid guardian signature was received
+var ErrInvalidSignature = errors.New("invalid guardian signature")
+
+// ErrNilNetworkStatus signals that a nil network status was received
+var ErrNilNetworkStatus = errors.New("nil network status")
+
+// ErrMissingOwnerSignature signals that the transaction is not signed by its owner
+var ErrMissingOwnerSignature = errors.New("missing owner signature")
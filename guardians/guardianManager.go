@@ -0,0 +1,281 @@
+package guardians
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const (
+	setGuardianFunction    = "SetGuardian"
+	guardAccountFunction   = "GuardAccount"
+	unGuardAccountFunction = "UnGuardAccount"
+
+	// GuardianBuiltInFunctionGasCost is the gas cost of the guardian related built-in functions
+	GuardianBuiltInFunctionGasCost = 250000
+	// ExtraGasLimitForGuardedTx is the extra gas limit required by a guarded transaction
+	ExtraGasLimitForGuardedTx = 50000
+
+	minGuardedTxVersion = 2
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/guardians")
+
+// ArgsGuardianManager is the argument DTO for the NewGuardianManager constructor function
+type ArgsGuardianManager struct {
+	Proxy    Proxy
+	CoSigner CoSigner
+}
+
+// GuardianInfo holds the data of a guardian of an account
+type GuardianInfo struct {
+	Address               string
+	ServiceUID            string
+	ActivationEpoch       uint32
+	EpochsUntilActivation uint32
+}
+
+// GuardianStatus holds the guardian state of an account
+type GuardianStatus struct {
+	Guarded         bool
+	CurrentEpoch    uint32
+	ActiveGuardian  *GuardianInfo
+	PendingGuardian *GuardianInfo
+}
+
+// guardianManager is able to build the transactions needed to set, activate and remove the guardian of an account
+// and to co-sign the guarded transactions through a guardian service
+type guardianManager struct {
+	proxy    Proxy
+	coSigner CoSigner
+}
+
+// NewGuardianManager creates a new instance of type guardianManager
+func NewGuardianManager(args ArgsGuardianManager) (*guardianManager, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &guardianManager{
+		proxy:    args.Proxy,
+		coSigner: args.CoSigner,
+	}, nil
+}
+
+func checkArgs(args ArgsGuardianManager) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilProxy
+	}
+	if check.IfNil(args.CoSigner) {
+		return ErrNilCoSigner
+	}
+
+	return nil
+}
+
+// CreateSetGuardianTransaction creates the unsigned SetGuardian transaction that registers the provided guardian
+// as the pending guardian of the owner account. The guardian becomes active after the network defined delay
+func (gm *guardianManager) CreateSetGuardianTransaction(
+	ctx context.Context,
+	owner core.AddressHandler,
+	guardian core.AddressHandler,
+	serviceUID string,
+) (*transaction.FrontendTransaction, error) {
+	if check.IfNil(guardian) {
+		return nil, ErrNilAddress
+	}
+	if len(serviceUID) == 0 {
+		return nil, ErrEmptyServiceUID
+	}
+
+	dataBytes, err := builders.NewTxDataBuilder().
+		Function(setGuardianFunction).
+		ArgAddress(guardian).
+		ArgBytes([]byte(serviceUID)).
+		ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return gm.createSelfTransaction(ctx, owner, dataBytes)
+}
+
+// CreateGuardAccountTransaction creates the unsigned GuardAccount transaction. The owner account should have an
+// active guardian and should not be already guarded
+func (gm *guardianManager) CreateGuardAccountTransaction(ctx context.Context, owner core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	guardianData, err := gm.getGuardianData(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if guardianData.Guarded {
+		return nil, ErrAccountAlreadyGuarded
+	}
+	if guardianData.ActiveGuardian == nil {
+		return nil, ErrNoActiveGuardian
+	}
+
+	return gm.createSelfTransaction(ctx, owner, []byte(guardAccountFunction))
+}
+
+// CreateUnGuardAccountTransaction creates the unsigned UnGuardAccount transaction. Since the owner account is
+// guarded, the transaction is marked as guarded by the active guardian and should be co-signed before sending
+func (gm *guardianManager) CreateUnGuardAccountTransaction(ctx context.Context, owner core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	guardianData, err := gm.getGuardianData(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if !guardianData.Guarded {
+		return nil, ErrAccountNotGuarded
+	}
+	if guardianData.ActiveGuardian == nil {
+		return nil, ErrNoActiveGuardian
+	}
+
+	tx, err := gm.createSelfTransaction(ctx, owner, []byte(unGuardAccountFunction))
+	if err != nil {
+		return nil, err
+	}
+
+	SetGuardedTransactionOptions(tx, guardianData.ActiveGuardian.Address)
+
+	return tx, nil
+}
+
+// SetGuardedTransactionOptions marks the transaction as guarded by the provided guardian, adjusting the version,
+// the options and the gas limit accordingly
+func SetGuardedTransactionOptions(tx *transaction.FrontendTransaction, guardian string) {
+	if tx.Version < minGuardedTxVersion {
+		tx.Version = minGuardedTxVersion
+	}
+	if tx.Options&transaction.MaskGuardedTransaction == 0 {
+		tx.GasLimit += ExtraGasLimitForGuardedTx
+	}
+
+	tx.Options |= transaction.MaskGuardedTransaction
+	tx.GuardianAddr = guardian
+}
+
+// GetGuardianStatus returns the guardian status of the provided account. The pending guardian, if any, is
+// reported with the number of epochs left until its activation
+func (gm *guardianManager) GetGuardianStatus(ctx context.Context, address core.AddressHandler) (*GuardianStatus, error) {
+	guardianData, err := gm.getGuardianData(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	networkStatus, err := gm.proxy.GetNetworkStatus(ctx, chainCore.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	if networkStatus == nil {
+		return nil, ErrNilNetworkStatus
+	}
+
+	currentEpoch := uint32(networkStatus.EpochNumber)
+
+	return &GuardianStatus{
+		Guarded:         guardianData.Guarded,
+		CurrentEpoch:    currentEpoch,
+		ActiveGuardian:  newGuardianInfo(guardianData.ActiveGuardian, currentEpoch),
+		PendingGuardian: newGuardianInfo(guardianData.PendingGuardian, currentEpoch),
+	}, nil
+}
+
+// CoSignTransaction requests the guardian signature from the guardian service, using the provided 2FA code, and
+// sets it on the transaction. The transaction should already be signed by the owner
+func (gm *guardianManager) CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction, code string) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+	if tx.Options&transaction.MaskGuardedTransaction == 0 || len(tx.GuardianAddr) == 0 {
+		return ErrMissingGuardianOption
+	}
+
+	if len(tx.Signature) == 0 {
+		return ErrMissingOwnerSignature
+	}
+
+	// the guardian service requires the owner signature, only a previous guardian signature is dropped
+	txToCoSign := *tx
+	txToCoSign.GuardianSignature = ""
+	signature, err := gm.coSigner.CoSignTransaction(ctx, &txToCoSign, code)
+	if err != nil {
+		return err
+	}
+
+	tx.GuardianSignature = hex.EncodeToString(signature)
+	log.Debug("transaction co-signed", "sender", tx.Sender, "nonce", tx.Nonce, "guardian", tx.GuardianAddr)
+
+	return nil
+}
+
+func (gm *guardianManager) getGuardianData(ctx context.Context, address core.AddressHandler) (*api.GuardianData, error) {
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+
+	guardianData, err := gm.proxy.GetGuardianData(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if guardianData == nil {
+		return nil, ErrNilGuardianData
+	}
+
+	return guardianData, nil
+}
+
+func (gm *guardianManager) createSelfTransaction(ctx context.Context, owner core.AddressHandler, dataBytes []byte) (*transaction.FrontendTransaction, error) {
+	if check.IfNil(owner) {
+		return nil, ErrNilAddress
+	}
+
+	networkConfig, err := gm.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, _, err := gm.proxy.GetDefaultTransactionArguments(ctx, owner, networkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("%w while preparing the %s transaction", err, string(dataBytes))
+	}
+
+	tx.Receiver = tx.Sender
+	tx.Value = "0"
+	tx.Data = dataBytes
+	tx.GasLimit = networkConfig.MinGasLimit + uint64(len(dataBytes))*networkConfig.GasPerDataByte + GuardianBuiltInFunctionGasCost
+
+	return &tx, nil
+}
+
+func newGuardianInfo(guardian *api.Guardian, currentEpoch uint32) *GuardianInfo {
+	if guardian == nil {
+		return nil
+	}
+
+	info := &GuardianInfo{
+		Address:         guardian.Address,
+		ServiceUID:      guardian.ServiceUID,
+		ActivationEpoch: guardian.ActivationEpoch,
+	}
+	if guardian.ActivationEpoch > currentEpoch {
+		info.EpochsUntilActivation = guardian.ActivationEpoch - currentEpoch
+	}
+
+	return info
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gm *guardianManager) IsInterfaceNil() bool {
+	return gm == nil
+}
@@ -0,0 +1,390 @@
+package guardians
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceUID = "serviceUID"
+
+func createProxyStub(guardianData *api.GuardianData) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{
+				ChainID:               "T",
+				GasPerDataByte:        1500,
+				MinGasLimit:           50000,
+				MinGasPrice:           1000000000,
+				MinTransactionVersion: 1,
+			}, nil
+		},
+		GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			return &data.NetworkStatus{EpochNumber: 100}, nil
+		},
+		GetDefaultTransactionArgumentsCalled: func(ctx context.Context, address core.AddressHandler, networkConfigs *data.NetworkConfig) (transaction.FrontendTransaction, string, error) {
+			bech32, _ := address.AddressAsBech32String()
+			return transaction.FrontendTransaction{
+				Nonce:    7,
+				Sender:   bech32,
+				GasPrice: networkConfigs.MinGasPrice,
+				GasLimit: networkConfigs.MinGasLimit,
+				ChainID:  networkConfigs.ChainID,
+				Version:  networkConfigs.MinTransactionVersion,
+			}, "1000", nil
+		},
+		GetGuardianDataCalled: func(ctx context.Context, address core.AddressHandler) (*api.GuardianData, error) {
+			return guardianData, nil
+		},
+	}
+}
+
+func createMockArgsGuardianManager() ArgsGuardianManager {
+	return ArgsGuardianManager{
+		Proxy:    createProxyStub(&api.GuardianData{}),
+		CoSigner: &testsCommon.CoSignerStub{},
+	}
+}
+
+func addressFromBech32(tb testing.TB, bech32 string) core.AddressHandler {
+	address, err := data.NewAddressFromBech32String(bech32)
+	require.Nil(tb, err)
+
+	return address
+}
+
+func TestNewGuardianManager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = nil
+		gm, err := NewGuardianManager(args)
+		assert.Nil(t, gm)
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil co-signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.CoSigner = nil
+		gm, err := NewGuardianManager(args)
+		assert.Nil(t, gm)
+		assert.Equal(t, ErrNilCoSigner, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gm, err := NewGuardianManager(createMockArgsGuardianManager())
+		assert.Nil(t, err)
+		assert.False(t, gm.IsInterfaceNil())
+	})
+}
+
+func TestGuardianManager_CreateSetGuardianTransaction(t *testing.T) {
+	t.Parallel()
+
+	gm, _ := NewGuardianManager(createMockArgsGuardianManager())
+	owner := addressFromBech32(t, ownerAddress)
+	guardian := addressFromBech32(t, guardianAddress)
+
+	t.Run("nil addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := gm.CreateSetGuardianTransaction(context.Background(), owner, nil, testServiceUID)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilAddress, err)
+
+		tx, err = gm.CreateSetGuardianTransaction(context.Background(), nil, guardian, testServiceUID)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("empty service UID should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := gm.CreateSetGuardianTransaction(context.Background(), owner, guardian, "")
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrEmptyServiceUID, err)
+	})
+	t.Run("proxy error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsGuardianManager()
+		proxy := createProxyStub(&api.GuardianData{})
+		proxy.GetDefaultTransactionArgumentsCalled = func(ctx context.Context, address core.AddressHandler, networkConfigs *data.NetworkConfig) (transaction.FrontendTransaction, string, error) {
+			return transaction.FrontendTransaction{}, "", expectedErr
+		}
+		args.Proxy = proxy
+		localManager, _ := NewGuardianManager(args)
+
+		tx, err := localManager.CreateSetGuardianTransaction(context.Background(), owner, guardian, testServiceUID)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := gm.CreateSetGuardianTransaction(context.Background(), owner, guardian, testServiceUID)
+		require.Nil(t, err)
+
+		expectedData := "SetGuardian@" + hex.EncodeToString(guardian.AddressBytes()) + "@" + hex.EncodeToString([]byte(testServiceUID))
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, ownerAddress, tx.Sender)
+		assert.Equal(t, ownerAddress, tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(7), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+GuardianBuiltInFunctionGasCost), tx.GasLimit)
+		assert.Empty(t, tx.Signature)
+	})
+}
+
+func TestGuardianManager_CreateGuardAccountTransaction(t *testing.T) {
+	t.Parallel()
+
+	owner := addressFromBech32(t, ownerAddress)
+	activeGuardian := &api.Guardian{Address: guardianAddress, ActivationEpoch: 80, ServiceUID: testServiceUID}
+
+	t.Run("already guarded should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = createProxyStub(&api.GuardianData{ActiveGuardian: activeGuardian, Guarded: true})
+		gm, _ := NewGuardianManager(args)
+
+		tx, err := gm.CreateGuardAccountTransaction(context.Background(), owner)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrAccountAlreadyGuarded, err)
+	})
+	t.Run("no active guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = createProxyStub(&api.GuardianData{PendingGuardian: activeGuardian})
+		gm, _ := NewGuardianManager(args)
+
+		tx, err := gm.CreateGuardAccountTransaction(context.Background(), owner)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoActiveGuardian, err)
+	})
+	t.Run("nil guardian data should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = createProxyStub(nil)
+		gm, _ := NewGuardianManager(args)
+
+		tx, err := gm.CreateGuardAccountTransaction(context.Background(), owner)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilGuardianData, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = createProxyStub(&api.GuardianData{ActiveGuardian: activeGuardian})
+		gm, _ := NewGuardianManager(args)
+
+		tx, err := gm.CreateGuardAccountTransaction(context.Background(), owner)
+		require.Nil(t, err)
+		assert.Equal(t, "GuardAccount", string(tx.Data))
+		assert.Equal(t, ownerAddress, tx.Receiver)
+		assert.Equal(t, uint32(0), tx.Options)
+		assert.Empty(t, tx.GuardianAddr)
+	})
+}
+
+func TestGuardianManager_CreateUnGuardAccountTransaction(t *testing.T) {
+	t.Parallel()
+
+	owner := addressFromBech32(t, ownerAddress)
+	activeGuardian := &api.Guardian{Address: guardianAddress, ActivationEpoch: 80, ServiceUID: testServiceUID}
+
+	t.Run("not guarded should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = createProxyStub(&api.GuardianData{ActiveGuardian: activeGuardian})
+		gm, _ := NewGuardianManager(args)
+
+		tx, err := gm.CreateUnGuardAccountTransaction(context.Background(), owner)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrAccountNotGuarded, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = createProxyStub(&api.GuardianData{ActiveGuardian: activeGuardian, Guarded: true})
+		gm, _ := NewGuardianManager(args)
+
+		tx, err := gm.CreateUnGuardAccountTransaction(context.Background(), owner)
+		require.Nil(t, err)
+		assert.Equal(t, "UnGuardAccount", string(tx.Data))
+		assert.Equal(t, guardianAddress, tx.GuardianAddr)
+		assert.Equal(t, transaction.MaskGuardedTransaction, tx.Options)
+		assert.Equal(t, uint32(2), tx.Version)
+		assert.Equal(t, uint64(50000+1500*len("UnGuardAccount")+GuardianBuiltInFunctionGasCost+ExtraGasLimitForGuardedTx), tx.GasLimit)
+	})
+}
+
+func TestGuardianManager_GetGuardianStatus(t *testing.T) {
+	t.Parallel()
+
+	owner := addressFromBech32(t, ownerAddress)
+
+	t.Run("network status error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsGuardianManager()
+		proxy := createProxyStub(&api.GuardianData{})
+		proxy.GetNetworkStatusCalled = func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			return nil, expectedErr
+		}
+		args.Proxy = proxy
+		gm, _ := NewGuardianManager(args)
+
+		status, err := gm.GetGuardianStatus(context.Background(), owner)
+		assert.Nil(t, status)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("nil network status should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		proxy := createProxyStub(&api.GuardianData{})
+		proxy.GetNetworkStatusCalled = func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			return nil, nil
+		}
+		args.Proxy = proxy
+		gm, _ := NewGuardianManager(args)
+
+		status, err := gm.GetGuardianStatus(context.Background(), owner)
+		assert.Nil(t, status)
+		assert.Equal(t, ErrNilNetworkStatus, err)
+	})
+	t.Run("should report the active and the pending guardians", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.Proxy = createProxyStub(&api.GuardianData{
+			ActiveGuardian:  &api.Guardian{Address: guardianAddress, ActivationEpoch: 80, ServiceUID: testServiceUID},
+			PendingGuardian: &api.Guardian{Address: ownerAddress, ActivationEpoch: 120, ServiceUID: "other"},
+			Guarded:         true,
+		})
+		gm, _ := NewGuardianManager(args)
+
+		status, err := gm.GetGuardianStatus(context.Background(), owner)
+		require.Nil(t, err)
+		expectedStatus := &GuardianStatus{
+			Guarded:      true,
+			CurrentEpoch: 100,
+			ActiveGuardian: &GuardianInfo{
+				Address:         guardianAddress,
+				ServiceUID:      testServiceUID,
+				ActivationEpoch: 80,
+			},
+			PendingGuardian: &GuardianInfo{
+				Address:               ownerAddress,
+				ServiceUID:            "other",
+				ActivationEpoch:       120,
+				EpochsUntilActivation: 20,
+			},
+		}
+		assert.Equal(t, expectedStatus, status)
+	})
+}
+
+func TestGuardianManager_CoSignTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		gm, _ := NewGuardianManager(createMockArgsGuardianManager())
+		err := gm.CoSignTransaction(context.Background(), nil, "")
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("not guarded transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		gm, _ := NewGuardianManager(createMockArgsGuardianManager())
+		tx := createGuardedTransaction()
+		tx.Options = 0
+		err := gm.CoSignTransaction(context.Background(), tx, "")
+		assert.Equal(t, ErrMissingGuardianOption, err)
+	})
+	t.Run("transaction not signed by the owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		gm, _ := NewGuardianManager(createMockArgsGuardianManager())
+		tx := createGuardedTransaction()
+		tx.Signature = ""
+		err := gm.CoSignTransaction(context.Background(), tx, "")
+		assert.Equal(t, ErrMissingOwnerSignature, err)
+	})
+	t.Run("should send the owner signature to the co-signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.CoSigner = &testsCommon.CoSignerStub{
+			CoSignTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction, code string) ([]byte, error) {
+				assert.Equal(t, "aabb", tx.Signature)
+				assert.Empty(t, tx.GuardianSignature)
+				assert.Equal(t, "123456", code)
+				return []byte{0xcc, 0xdd}, nil
+			},
+		}
+		gm, _ := NewGuardianManager(args)
+
+		tx := createGuardedTransaction()
+		tx.GuardianSignature = "eeff"
+		err := gm.CoSignTransaction(context.Background(), tx, "123456")
+		require.Nil(t, err)
+		assert.Equal(t, "aabb", tx.Signature)
+		assert.Equal(t, "ccdd", tx.GuardianSignature)
+	})
+	t.Run("co-signer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.CoSigner = &testsCommon.CoSignerStub{
+			CoSignTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction, code string) ([]byte, error) {
+				return nil, ErrInvalidCode
+			},
+		}
+		gm, _ := NewGuardianManager(args)
+
+		tx := createGuardedTransaction()
+		err := gm.CoSignTransaction(context.Background(), tx, "")
+		assert.Equal(t, ErrInvalidCode, err)
+		assert.Empty(t, tx.GuardianSignature)
+	})
+	t.Run("should work with the local co-signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianManager()
+		args.CoSigner, _ = NewLocalCoSigner(ArgsLocalCoSigner{GuardianSigner: createGuardianSigner(t)})
+		gm, _ := NewGuardianManager(args)
+
+		tx := createGuardedTransaction()
+		err := gm.CoSignTransaction(context.Background(), tx, "")
+		require.Nil(t, err)
+		assert.Equal(t, "aabb", tx.Signature)
+
+		expectedSignature, _ := createGuardianSigner(t).SignTransaction(context.Background(), tx)
+		assert.Equal(t, hex.EncodeToString(expectedSignature), tx.GuardianSignature)
+	})
+}
@@ -0,0 +1,104 @@
+package guardians
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/signers"
+)
+
+// ArgsHttpCoSigner is the argument DTO for the NewHttpCoSigner constructor function
+type ArgsHttpCoSigner struct {
+	URL string
+	// Client is optional, it can be used to customize the requests, like adding authentication headers
+	Client sdkHttp.Client
+}
+
+// httpCoSigner is the client of a trusted co-signer HTTP service. The received guardian signatures are verified
+// against the guardian public key before being returned
+type httpCoSigner struct {
+	httpClientWrapper sdkHttp.ClientWrapper
+	signer            signers.Signer
+}
+
+// NewHttpCoSigner creates a new instance of type httpCoSigner
+func NewHttpCoSigner(args ArgsHttpCoSigner) (*httpCoSigner, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+
+	return &httpCoSigner{
+		httpClientWrapper: sdkHttp.NewHttpClientWrapper(args.Client, args.URL),
+		signer:            cryptoProvider.NewSigner(),
+	}, nil
+}
+
+// CoSignTransaction sends the transaction and the code to the guardian service and returns the guardian signature
+func (hcs *httpCoSigner) CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction, code string) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	requestBytes, err := json.Marshal(&SignTransactionRequest{
+		Code:        code,
+		Transaction: tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buff, statusCode, err := hcs.httpClientWrapper.PostHTTP(ctx, SignTransactionEndpoint, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignTransactionResponse{}
+	errUnmarshal := json.Unmarshal(buff, response)
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status code %d, code: %s, message: %s", ErrCoSigner, statusCode, response.Code, response.Error)
+	}
+	if errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+	if response.Data.Transaction == nil {
+		return nil, fmt.Errorf("%w: missing transaction in response", ErrCoSigner)
+	}
+
+	signature, err := hex.DecodeString(response.Data.Transaction.GuardianSignature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	err = hcs.verifyGuardianSignature(tx, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+func (hcs *httpCoSigner) verifyGuardianSignature(tx *transaction.FrontendTransaction, signature []byte) error {
+	guardianAddress, err := data.NewAddressFromBech32String(tx.GuardianAddr)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrGuardianMismatch, err.Error())
+	}
+
+	err = signers.VerifyTransactionSignature(hcs.signer, tx, guardianAddress, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hcs *httpCoSigner) IsInterfaceNil() bool {
+	return hcs == nil
+}
@@ -0,0 +1,107 @@
+package guardians
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCoSignerServer(tb testing.TB, handler func(request *SignTransactionRequest) (int, *SignTransactionResponse)) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(tb, "/"+SignTransactionEndpoint, req.URL.Path)
+
+		request := &SignTransactionRequest{}
+		err := json.NewDecoder(req.Body).Decode(request)
+		require.Nil(tb, err)
+
+		statusCode, response := handler(request)
+		buff, _ := json.Marshal(response)
+		rw.WriteHeader(statusCode)
+		_, _ = rw.Write(buff)
+	}))
+	tb.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewHttpCoSigner(t *testing.T) {
+	t.Parallel()
+
+	hcs, err := NewHttpCoSigner(ArgsHttpCoSigner{})
+	assert.Nil(t, hcs)
+	assert.Equal(t, ErrEmptyURL, err)
+
+	hcs, err = NewHttpCoSigner(ArgsHttpCoSigner{URL: "http://localhost"})
+	assert.Nil(t, err)
+	assert.False(t, hcs.IsInterfaceNil())
+}
+
+func TestHttpCoSigner_CoSignTransaction(t *testing.T) {
+	t.Parallel()
+
+	localSigner, _ := NewLocalCoSigner(ArgsLocalCoSigner{
+		GuardianSigner: createGuardianSigner(t),
+		Code:           "123456",
+	})
+	server := createCoSignerServer(t, func(request *SignTransactionRequest) (int, *SignTransactionResponse) {
+		response := &SignTransactionResponse{}
+		signature, err := localSigner.CoSignTransaction(context.Background(), request.Transaction, request.Code)
+		if err != nil {
+			response.Error = err.Error()
+			response.Code = "bad_request"
+			return http.StatusBadRequest, response
+		}
+
+		if request.Transaction.Nonce == 0 {
+			signature[0]++
+		}
+		request.Transaction.GuardianSignature = hex.EncodeToString(signature)
+		response.Data.Transaction = request.Transaction
+
+		return http.StatusOK, response
+	})
+	hcs, _ := NewHttpCoSigner(ArgsHttpCoSigner{URL: server.URL})
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		signature, err := hcs.CoSignTransaction(context.Background(), nil, "123456")
+		assert.Nil(t, signature)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("service error should error", func(t *testing.T) {
+		t.Parallel()
+
+		signature, err := hcs.CoSignTransaction(context.Background(), createGuardedTransaction(), "000000")
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(err, ErrCoSigner))
+		assert.Contains(t, err.Error(), ErrInvalidCode.Error())
+	})
+	t.Run("invalid signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createGuardedTransaction()
+		tx.Nonce = 0
+		signature, err := hcs.CoSignTransaction(context.Background(), tx, "123456")
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createGuardedTransaction()
+		signature, err := hcs.CoSignTransaction(context.Background(), tx, "123456")
+		require.Nil(t, err)
+
+		expectedSignature, err := localSigner.CoSignTransaction(context.Background(), tx, "123456")
+		require.Nil(t, err)
+		assert.Equal(t, expectedSignature, signature)
+	})
+}
@@ -0,0 +1,26 @@
+package guardians
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// Proxy defines the proxy operations used by the guardian manager
+type Proxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+	GetDefaultTransactionArguments(ctx context.Context, address core.AddressHandler, networkConfigs *data.NetworkConfig) (transaction.FrontendTransaction, string, error)
+	GetGuardianData(ctx context.Context, address core.AddressHandler) (*api.GuardianData, error)
+	IsInterfaceNil() bool
+}
+
+// CoSigner defines a guardian service able to provide the guardian signature of a guarded transaction after
+// validating the 2FA code of the account owner
+type CoSigner interface {
+	CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction, code string) ([]byte, error)
+	IsInterfaceNil() bool
+}
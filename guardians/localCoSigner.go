@@ -0,0 +1,55 @@
+package guardians
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// ArgsLocalCoSigner is the argument DTO for the NewLocalCoSigner constructor function
+type ArgsLocalCoSigner struct {
+	GuardianSigner core.TransactionSigner
+	// Code is optional, if set, only the requests providing the same code are co-signed
+	Code string
+}
+
+// localCoSigner is a guardian service replacement that co-signs the transactions in-process, useful for
+// tests or for the setups where the guardian key is managed locally
+type localCoSigner struct {
+	guardianSigner core.TransactionSigner
+	code           string
+}
+
+// NewLocalCoSigner creates a new instance of type localCoSigner
+func NewLocalCoSigner(args ArgsLocalCoSigner) (*localCoSigner, error) {
+	if check.IfNil(args.GuardianSigner) {
+		return nil, ErrNilTransactionSigner
+	}
+
+	return &localCoSigner{
+		guardianSigner: args.GuardianSigner,
+		code:           args.Code,
+	}, nil
+}
+
+// CoSignTransaction validates the code and signs the transaction with the guardian key
+func (lcs *localCoSigner) CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction, code string) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+	if len(lcs.code) > 0 && code != lcs.code {
+		return nil, ErrInvalidCode
+	}
+	if tx.GuardianAddr != lcs.guardianSigner.GetBech32() {
+		return nil, ErrGuardianMismatch
+	}
+
+	return lcs.guardianSigner.SignTransaction(ctx, tx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lcs *localCoSigner) IsInterfaceNil() bool {
+	return lcs == nil
+}
@@ -0,0 +1,74 @@
+package guardians
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLocalCoSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil guardian signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		lcs, err := NewLocalCoSigner(ArgsLocalCoSigner{})
+		assert.Nil(t, lcs)
+		assert.Equal(t, ErrNilTransactionSigner, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lcs, err := NewLocalCoSigner(ArgsLocalCoSigner{GuardianSigner: createGuardianSigner(t)})
+		assert.Nil(t, err)
+		assert.False(t, lcs.IsInterfaceNil())
+	})
+}
+
+func TestLocalCoSigner_CoSignTransaction(t *testing.T) {
+	t.Parallel()
+
+	guardianSigner := createGuardianSigner(t)
+	lcs, _ := NewLocalCoSigner(ArgsLocalCoSigner{
+		GuardianSigner: guardianSigner,
+		Code:           "123456",
+	})
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		signature, err := lcs.CoSignTransaction(context.Background(), nil, "123456")
+		assert.Nil(t, signature)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("invalid code should error", func(t *testing.T) {
+		t.Parallel()
+
+		signature, err := lcs.CoSignTransaction(context.Background(), createGuardedTransaction(), "000000")
+		assert.Nil(t, signature)
+		assert.Equal(t, ErrInvalidCode, err)
+	})
+	t.Run("other guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createGuardedTransaction()
+		tx.GuardianAddr = ownerAddress
+		signature, err := lcs.CoSignTransaction(context.Background(), tx, "123456")
+		assert.Nil(t, signature)
+		assert.Equal(t, ErrGuardianMismatch, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := builders.TransactionToUnsignedTx(createGuardedTransaction())
+		signature, err := lcs.CoSignTransaction(context.Background(), tx, "123456")
+		require.Nil(t, err)
+
+		expectedSignature, err := guardianSigner.SignTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		assert.Equal(t, expectedSignature, signature)
+	})
+}
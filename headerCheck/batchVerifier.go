@@ -0,0 +1,183 @@
+package headerCheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const maxConcurrentBatchRequests = 16
+
+// HeaderRequest identifies a header that should be verified in a batch
+type HeaderRequest struct {
+	ShardID uint32
+	Hash    string
+}
+
+// HeaderVerificationResult holds the outcome of a header verification from a batch
+type HeaderVerificationResult struct {
+	ShardID  uint32
+	Hash     string
+	Epoch    uint32
+	Verified bool
+	Err      error
+}
+
+type epochNodesConfigTask struct {
+	config    *data.EpochNodesConfig
+	fromCache bool
+	err       error
+}
+
+// VerifyHeadersBatch verifies the signatures of the provided headers and returns the results in the order of the
+// requests. The headers are grouped by epoch, the missing nodes configs are fetched concurrently and each epoch is
+// set in the nodes coordinator only once, before verifying its headers concurrently. A header that can not be
+// fetched or verified is reported in its result, while the whole batch fails only if the context is done
+func (hch *headerVerifier) VerifyHeadersBatch(ctx context.Context, requests []HeaderRequest) ([]*HeaderVerificationResult, error) {
+	results := make([]*HeaderVerificationResult, len(requests))
+	headers := make([]coreData.HeaderHandler, len(requests))
+	runConcurrently(ctx, len(requests), func(idx int) {
+		results[idx] = &HeaderVerificationResult{
+			ShardID: requests[idx].ShardID,
+			Hash:    requests[idx].Hash,
+		}
+		headers[idx], results[idx].Err = hch.fetchBatchHeader(ctx, requests[idx])
+		if results[idx].Err == nil {
+			results[idx].Epoch = headers[idx].GetEpoch()
+		}
+	})
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	epochs, indexesPerEpoch := groupHeadersByEpoch(results)
+	nodesConfigTasks := hch.prepareEpochsNodesConfigs(ctx, epochs)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	for _, epoch := range epochs {
+		indexes := indexesPerEpoch[epoch]
+		setNodesConfig := func() error {
+			task, found := nodesConfigTasks[epoch]
+			if !found {
+				return fmt.Errorf("%w %d", ErrEpochConfigUnavailable, epoch)
+			}
+			if task.err != nil {
+				return task.err
+			}
+
+			return hch.applyEpochNodesConfig(task.config, task.fromCache)
+		}
+
+		err := hch.runWithEpochNodesConfig(epoch, setNodesConfig, func() {
+			runConcurrently(ctx, len(indexes), func(idx int) {
+				result := results[indexes[idx]]
+				result.Err = hch.headerSigVerifier.VerifySignature(headers[indexes[idx]])
+				result.Verified = result.Err == nil
+			})
+		})
+		if err != nil {
+			log.Debug("could not prepare the nodes config for the batch", "epoch", epoch, "error", err)
+			for _, idx := range indexes {
+				results[idx].Err = err
+			}
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	return results, nil
+}
+
+func (hch *headerVerifier) fetchBatchHeader(ctx context.Context, request HeaderRequest) (coreData.HeaderHandler, error) {
+	header, err := hch.fetchHeaderByHashAndShard(ctx, request.ShardID, request.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(header) {
+		return nil, fmt.Errorf("%w: shard %d, hash %s", ErrNilHeader, request.ShardID, request.Hash)
+	}
+
+	return header, nil
+}
+
+// groupHeadersByEpoch returns the sorted epochs of the fetched headers and the results indexes for each epoch
+func groupHeadersByEpoch(results []*HeaderVerificationResult) ([]uint32, map[uint32][]int) {
+	indexesPerEpoch := make(map[uint32][]int)
+	for idx, result := range results {
+		if result.Err != nil {
+			continue
+		}
+
+		indexesPerEpoch[result.Epoch] = append(indexesPerEpoch[result.Epoch], idx)
+	}
+
+	epochs := make([]uint32, 0, len(indexesPerEpoch))
+	for epoch := range indexesPerEpoch {
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, indexesPerEpoch
+}
+
+// prepareEpochsNodesConfigs concurrently fetches the nodes configs of the epochs missing from the nodes coordinator
+func (hch *headerVerifier) prepareEpochsNodesConfigs(ctx context.Context, epochs []uint32) map[uint32]*epochNodesConfigTask {
+	missingEpochs := make([]uint32, 0, len(epochs))
+	for _, epoch := range epochs {
+		if !hch.nodesCoordinator.IsEpochInConfig(epoch) {
+			missingEpochs = append(missingEpochs, epoch)
+		}
+	}
+
+	tasks := make([]*epochNodesConfigTask, len(missingEpochs))
+	runConcurrently(ctx, len(missingEpochs), func(idx int) {
+		task := &epochNodesConfigTask{}
+		task.config, task.fromCache, task.err = hch.fetchEpochNodesConfig(ctx, missingEpochs[idx])
+		tasks[idx] = task
+	})
+
+	tasksPerEpoch := make(map[uint32]*epochNodesConfigTask, len(missingEpochs))
+	for idx, task := range tasks {
+		if task != nil {
+			tasksPerEpoch[missingEpochs[idx]] = task
+		}
+	}
+
+	return tasksPerEpoch
+}
+
+// runConcurrently calls the handler for each index in [0, numItems), with at most maxConcurrentBatchRequests calls
+// in parallel. It stops launching new calls once the context is done
+func runConcurrently(ctx context.Context, numItems int, handler func(idx int)) {
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrentBatchRequests)
+
+	defer wg.Wait()
+	for idx := 0; idx < numItems; idx++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		wg.Add(1)
+		go func(index int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			handler(index)
+		}(idx)
+	}
+}
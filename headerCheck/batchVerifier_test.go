@@ -0,0 +1,216 @@
+package headerCheck_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/state"
+	sdkData "github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/headerCheck"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type batchFixture struct {
+	mut                sync.Mutex
+	epochsInConfig     map[uint32]bool
+	numSetsPerEpoch    map[uint32]int
+	numFetchesPerEpoch map[uint32]int
+}
+
+func newBatchFixture(epochsInConfig ...uint32) *batchFixture {
+	fixture := &batchFixture{
+		epochsInConfig:     make(map[uint32]bool),
+		numSetsPerEpoch:    make(map[uint32]int),
+		numFetchesPerEpoch: make(map[uint32]int),
+	}
+	for _, epoch := range epochsInConfig {
+		fixture.epochsInConfig[epoch] = true
+	}
+
+	return fixture
+}
+
+// createArgs returns header verifier arguments where the header hash "<epoch>-<name>" resolves to a shard header
+// of that epoch, a hash prefixed by "missing" can not be fetched and a header with the nonce 666 has an invalid
+// signature
+func (fixture *batchFixture) createArgs() headerCheck.ArgsHeaderVerifier {
+	args := createMockArgHeaderVerifier()
+	args.HeaderHandler = &testsCommon.RawHeaderHandlerStub{
+		GetShardBlockByHashCalled: func(shardId uint32, hash string) (data.HeaderHandler, error) {
+			var epoch uint32
+			var nonce uint64
+			_, err := fmt.Sscanf(hash, "%d-%d", &epoch, &nonce)
+			if err != nil {
+				return nil, errors.New("header not found")
+			}
+
+			return &block.Header{ShardID: shardId, Epoch: epoch, Nonce: nonce}, nil
+		},
+		GetValidatorsInfoPerEpochCalled: func(epoch uint32) ([]*state.ShardValidatorInfo, []byte, error) {
+			fixture.mut.Lock()
+			fixture.numFetchesPerEpoch[epoch]++
+			fixture.mut.Unlock()
+
+			return []*state.ShardValidatorInfo{{PublicKey: []byte("pk")}}, []byte("randomness"), nil
+		},
+	}
+	args.NodesCoordinator = &testsCommon.NodesCoordinatorStub{
+		IsEpochInConfigCalled: func(epoch uint32) bool {
+			fixture.mut.Lock()
+			defer fixture.mut.Unlock()
+
+			return fixture.epochsInConfig[epoch]
+		},
+		SetNodesConfigFromValidatorsInfoCalled: func(epoch uint32, _ []byte, _ []*state.ShardValidatorInfo) error {
+			fixture.mut.Lock()
+			defer fixture.mut.Unlock()
+
+			fixture.epochsInConfig[epoch] = true
+			fixture.numSetsPerEpoch[epoch]++
+			return nil
+		},
+	}
+	args.HeaderSigVerifier = &testsCommon.HeaderSigVerifierStub{
+		VerifySignatureCalled: func(header data.HeaderHandler) error {
+			fixture.mut.Lock()
+			defer fixture.mut.Unlock()
+
+			if !fixture.epochsInConfig[header.GetEpoch()] {
+				return errors.New("epoch not in config")
+			}
+			if header.GetNonce() == 666 {
+				return errors.New("invalid signature")
+			}
+
+			return nil
+		},
+	}
+
+	return args
+}
+
+func TestHeaderVerifier_VerifyHeadersBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty batch should return empty results", func(t *testing.T) {
+		t.Parallel()
+
+		hv, _ := headerCheck.NewHeaderVerifier(newBatchFixture().createArgs())
+		results, err := hv.VerifyHeadersBatch(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Empty(t, results)
+	})
+	t.Run("should prepare each epoch once and return the results in order", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := newBatchFixture(2)
+		hv, _ := headerCheck.NewHeaderVerifier(fixture.createArgs())
+
+		requests := []headerCheck.HeaderRequest{
+			{ShardID: 0, Hash: "3-1"},
+			{ShardID: 1, Hash: "2-2"},
+			{ShardID: 0, Hash: "missing"},
+			{ShardID: 2, Hash: "3-666"},
+			{ShardID: 1, Hash: "4-3"},
+		}
+		for i := 0; i < 50; i++ {
+			requests = append(requests, headerCheck.HeaderRequest{ShardID: 1, Hash: fmt.Sprintf("%d-%d", 3+i%2, 100+i)})
+		}
+
+		results, err := hv.VerifyHeadersBatch(context.Background(), requests)
+		require.Nil(t, err)
+		require.Len(t, results, len(requests))
+
+		assert.Equal(t, &headerCheck.HeaderVerificationResult{ShardID: 0, Hash: "3-1", Epoch: 3, Verified: true}, results[0])
+		assert.Equal(t, &headerCheck.HeaderVerificationResult{ShardID: 1, Hash: "2-2", Epoch: 2, Verified: true}, results[1])
+		assert.False(t, results[2].Verified)
+		assert.Equal(t, "header not found", results[2].Err.Error())
+		assert.False(t, results[3].Verified)
+		assert.Equal(t, "invalid signature", results[3].Err.Error())
+		assert.Equal(t, uint32(3), results[3].Epoch)
+		assert.True(t, results[4].Verified)
+		for _, result := range results[5:] {
+			assert.True(t, result.Verified)
+			assert.Nil(t, result.Err)
+		}
+
+		assert.Equal(t, map[uint32]int{3: 1, 4: 1}, fixture.numSetsPerEpoch)
+		assert.Equal(t, map[uint32]int{3: 1, 4: 1}, fixture.numFetchesPerEpoch)
+	})
+	t.Run("cached nodes configs should not be fetched", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := newBatchFixture()
+		args := fixture.createArgs()
+		args.NodesConfigCache = &testsCommon.NodesConfigCacheStub{
+			GetCalled: func(epoch uint32) (*sdkData.EpochNodesConfig, bool) {
+				return &sdkData.EpochNodesConfig{Epoch: epoch}, epoch == 5
+			},
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		results, err := hv.VerifyHeadersBatch(context.Background(), []headerCheck.HeaderRequest{
+			{ShardID: 0, Hash: "5-1"},
+			{ShardID: 0, Hash: "6-2"},
+		})
+		require.Nil(t, err)
+		assert.True(t, results[0].Verified)
+		assert.True(t, results[1].Verified)
+		assert.Equal(t, map[uint32]int{6: 1}, fixture.numFetchesPerEpoch)
+	})
+	t.Run("epoch preparation error should fail only the headers of that epoch", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		fixture := newBatchFixture()
+		args := fixture.createArgs()
+		args.HeaderHandler.(*testsCommon.RawHeaderHandlerStub).GetValidatorsInfoPerEpochCalled = func(epoch uint32) ([]*state.ShardValidatorInfo, []byte, error) {
+			if epoch == 7 {
+				return nil, nil, expectedErr
+			}
+
+			return nil, nil, nil
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		results, err := hv.VerifyHeadersBatch(context.Background(), []headerCheck.HeaderRequest{
+			{ShardID: 0, Hash: "7-1"},
+			{ShardID: 0, Hash: "8-2"},
+			{ShardID: 1, Hash: "7-3"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, expectedErr, results[0].Err)
+		assert.False(t, results[0].Verified)
+		assert.True(t, results[1].Verified)
+		assert.Equal(t, expectedErr, results[2].Err)
+	})
+	t.Run("nil header should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := newBatchFixture().createArgs()
+		args.HeaderHandler = &testsCommon.RawHeaderHandlerStub{}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		results, err := hv.VerifyHeadersBatch(context.Background(), []headerCheck.HeaderRequest{{ShardID: 0, Hash: "aaaa"}})
+		require.Nil(t, err)
+		assert.True(t, errors.Is(results[0].Err, headerCheck.ErrNilHeader))
+	})
+	t.Run("canceled context should error", func(t *testing.T) {
+		t.Parallel()
+
+		hv, _ := headerCheck.NewHeaderVerifier(newBatchFixture().createArgs())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		results, err := hv.VerifyHeadersBatch(ctx, []headerCheck.HeaderRequest{{ShardID: 0, Hash: "1-1"}})
+		assert.Nil(t, results)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
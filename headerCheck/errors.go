@@ -16,3 +16,105 @@ var ErrNilRawHeaderHandler = errors.New("nil raw header handler")
 
 // ErrNilMarshaller signals that a nil marshaller was provided
 var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilEpochStartHeaderHandler signals that a nil epoch start header handler was provided
+var ErrNilEpochStartHeaderHandler = errors.New("nil epoch start header handler")
+
+// ErrInvalidCheckpoint signals that an invalid trusted checkpoint was provided
+var ErrInvalidCheckpoint = errors.New("invalid trusted checkpoint")
+
+// ErrCheckpointMismatch signals that the start of epoch metablock does not match the trusted checkpoint
+var ErrCheckpointMismatch = errors.New("start of epoch metablock does not match the trusted checkpoint")
+
+// ErrEpochBeforeCheckpoint signals that the requested epoch is older than the trusted checkpoint
+var ErrEpochBeforeCheckpoint = errors.New("epoch is older than the trusted checkpoint")
+
+// ErrInvalidEpochStartBlock signals that the received metablock is not the expected start of epoch metablock
+var ErrInvalidEpochStartBlock = errors.New("invalid start of epoch metablock")
+
+// ErrBrokenEpochChain signals that the start of epoch metablock does not link to the previous verified one
+var ErrBrokenEpochChain = errors.New("start of epoch metablock does not link to the previous verified one")
+
+// ErrInvalidEpochStartSignature signals that the start of epoch metablock signature verification failed
+var ErrInvalidEpochStartSignature = errors.New("invalid start of epoch metablock signature")
+
+// ErrMiniBlockHashMismatch signals that the received miniblock does not match the committed hash
+var ErrMiniBlockHashMismatch = errors.New("miniblock hash mismatch")
+
+// ErrValidatorsInfoMismatch signals that the received validators info does not match the one committed in the start of epoch metablock
+var ErrValidatorsInfoMismatch = errors.New("validators info mismatch")
+
+// ErrHeaderHashMismatch signals that the received header does not match the requested hash
+var ErrHeaderHashMismatch = errors.New("header hash mismatch")
+
+// ErrEpochConfigUnavailable signals that the nodes config of the requested epoch is not available anymore
+var ErrEpochConfigUnavailable = errors.New("nodes config unavailable for epoch")
+
+// ErrNilTransactionInfoProvider signals that a nil transaction info provider was provided
+var ErrNilTransactionInfoProvider = errors.New("nil transaction info provider")
+
+// ErrNilInclusionHeaderHandler signals that a nil inclusion header handler was provided
+var ErrNilInclusionHeaderHandler = errors.New("nil inclusion header handler")
+
+// ErrNilHeaderVerifier signals that a nil header verifier was provided
+var ErrNilHeaderVerifier = errors.New("nil header verifier")
+
+// ErrNilTransactionInfo signals that a nil transaction info was received
+var ErrNilTransactionInfo = errors.New("nil transaction info")
+
+// ErrTransactionNotInBlock signals that the transaction is not included in a block yet
+var ErrTransactionNotInBlock = errors.New("transaction is not included in a block")
+
+// ErrInvalidHeaderSignature signals that the header signature verification failed
+var ErrInvalidHeaderSignature = errors.New("invalid header signature")
+
+// ErrMiniBlockNotInHeader signals that the miniblock is not referenced by the header
+var ErrMiniBlockNotInHeader = errors.New("miniblock is not referenced by the header")
+
+// ErrTransactionNotInMiniBlock signals that the transaction hash is not included in the miniblock
+var ErrTransactionNotInMiniBlock = errors.New("transaction is not included in the miniblock")
+
+// ErrMissingMetaNotarization signals that the cross shard transaction is not notarized by the metachain yet
+var ErrMissingMetaNotarization = errors.New("missing metachain notarization")
+
+// ErrMiniBlockNotNotarized signals that the metablock does not notarize the miniblock
+var ErrMiniBlockNotNotarized = errors.New("miniblock is not notarized by the metablock")
+
+// ErrWrongTypeAssertion signals that a wrong type assertion occurred
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilStateProofProvider signals that a nil state proof provider was provided
+var ErrNilStateProofProvider = errors.New("nil state proof provider")
+
+// ErrNilAddress signals that a nil address was provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrEmptyKey signals that an empty key was provided
+var ErrEmptyKey = errors.New("empty key")
+
+// ErrInvalidProof signals that the Merkle proof verification failed
+var ErrInvalidProof = errors.New("invalid Merkle proof")
+
+// ErrProofValueMismatch signals that the value reported by the proxy does not match the proven one
+var ErrProofValueMismatch = errors.New("proof value mismatch")
+
+// ErrEmptyDirectory signals that an empty directory was provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidEvictionWindow signals that an invalid eviction window was provided
+var ErrInvalidEvictionWindow = errors.New("invalid eviction window")
+
+// ErrNilEpochNodesConfig signals that a nil epoch nodes config was provided
+var ErrNilEpochNodesConfig = errors.New("nil epoch nodes config")
+
+// ErrNilHeader signals that a nil header was received
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilGenesisNodes signals that a nil genesis nodes config was provided
+var ErrNilGenesisNodes = errors.New("nil genesis nodes config")
+
+// ErrEpochNodesConfigUnavailable signals that the nodes config of an epoch could not be kept in the nodes coordinator
+var ErrEpochNodesConfigUnavailable = errors.New("epoch nodes config unavailable")
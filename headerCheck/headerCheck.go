@@ -2,14 +2,22 @@ package headerCheck
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/disabled"
 )
 
+// maxSetNodesConfigAttempts bounds how many times the nodes config of an epoch is set while concurrent updates keep
+// evicting it from the nodes coordinator
+const maxSetNodesConfigAttempts = 3
+
 var log = logger.GetOrCreate("mx-sdk-go/headerCheck")
 
 // ArgsHeaderVerifier holds all dependencies required by headerVerifier in
@@ -18,12 +26,16 @@ type ArgsHeaderVerifier struct {
 	HeaderHandler     RawHeaderHandler
 	HeaderSigVerifier HeaderSigVerifierHandler
 	NodesCoordinator  nodesCoordinator.EpochsConfigUpdateHandler
+	// NodesConfigCache is optional, the nodes config of each epoch being fetched from proxy if not provided
+	NodesConfigCache NodesConfigCache
 }
 
 type headerVerifier struct {
 	rawHeaderHandler  RawHeaderHandler
 	headerSigVerifier HeaderSigVerifierHandler
 	nodesCoordinator  nodesCoordinator.EpochsConfigUpdateHandler
+	nodesConfigCache  NodesConfigCache
+	mutNodesConfig    sync.RWMutex
 }
 
 // NewHeaderVerifier creates new instance of headerVerifier
@@ -33,10 +45,16 @@ func NewHeaderVerifier(args ArgsHeaderVerifier) (*headerVerifier, error) {
 		return nil, err
 	}
 
+	nodesConfigCache := args.NodesConfigCache
+	if check.IfNil(nodesConfigCache) {
+		nodesConfigCache = &disabled.NodesConfigCache{}
+	}
+
 	return &headerVerifier{
 		rawHeaderHandler:  args.HeaderHandler,
 		headerSigVerifier: args.HeaderSigVerifier,
 		nodesCoordinator:  args.NodesCoordinator,
+		nodesConfigCache:  nodesConfigCache,
 	}, nil
 }
 
@@ -50,7 +68,6 @@ func checkArguments(arguments ArgsHeaderVerifier) error {
 	if check.IfNil(arguments.HeaderSigVerifier) {
 		return ErrNilHeaderSigVerifier
 	}
-
 	return nil
 }
 
@@ -62,36 +79,93 @@ func (hch *headerVerifier) VerifyHeaderSignatureByHash(ctx context.Context, shar
 		return false, err
 	}
 
+	return hch.VerifyHeader(ctx, header)
+}
+
+// VerifyHeader verifies the signature of the provided header, fetching the nodes config of its epoch if needed
+func (hch *headerVerifier) VerifyHeader(ctx context.Context, header coreData.HeaderHandler) (bool, error) {
+	if check.IfNil(header) {
+		return false, ErrNilHeader
+	}
+
 	headerEpoch := header.GetEpoch()
 	log.Debug("fetched header in", "epoch", headerEpoch)
 
-	if !hch.nodesCoordinator.IsEpochInConfig(headerEpoch) {
+	setNodesConfig := func() error {
 		log.Info("nodes config is set for epoch", "epoch", headerEpoch)
-		errUpdate := hch.updateNodesConfigPerEpoch(ctx, headerEpoch)
-		if errUpdate != nil {
-			return false, errUpdate
-		}
+		return hch.updateNodesConfigPerEpoch(ctx, headerEpoch)
 	}
-
-	err = hch.headerSigVerifier.VerifySignature(header)
+	var errVerify error
+	err := hch.runWithEpochNodesConfig(headerEpoch, setNodesConfig, func() {
+		errVerify = hch.headerSigVerifier.VerifySignature(header)
+	})
 	if err != nil {
 		return false, err
 	}
+	if errVerify != nil {
+		return false, errVerify
+	}
 
 	return true, nil
 }
 
+// runWithEpochNodesConfig calls the handler while the nodes config of the provided epoch is set in the nodes
+// coordinator. If the epoch is missing, the setter is called first. The nodes coordinator keeps a limited number of
+// epochs, so the epoch is checked again after re-acquiring the read lock, as a concurrent update might have evicted it
+func (hch *headerVerifier) runWithEpochNodesConfig(epoch uint32, setNodesConfig func() error, handler func()) error {
+	for attempt := 0; attempt < maxSetNodesConfigAttempts; attempt++ {
+		if hch.runIfEpochInConfig(epoch, handler) {
+			return nil
+		}
+
+		err := hch.setNodesConfigIfMissing(epoch, setNodesConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w, epoch %d", ErrEpochNodesConfigUnavailable, epoch)
+}
+
+func (hch *headerVerifier) runIfEpochInConfig(epoch uint32, handler func()) bool {
+	hch.mutNodesConfig.RLock()
+	defer hch.mutNodesConfig.RUnlock()
+
+	if !hch.nodesCoordinator.IsEpochInConfig(epoch) {
+		return false
+	}
+
+	handler()
+
+	return true
+}
+
+func (hch *headerVerifier) setNodesConfigIfMissing(epoch uint32, setNodesConfig func() error) error {
+	hch.mutNodesConfig.Lock()
+	defer hch.mutNodesConfig.Unlock()
+
+	if hch.nodesCoordinator.IsEpochInConfig(epoch) {
+		return nil
+	}
+
+	return setNodesConfig()
+}
+
 func (hch *headerVerifier) fetchHeaderByHashAndShard(ctx context.Context, shardId uint32, hash string) (coreData.HeaderHandler, error) {
+	return fetchHeaderByHashAndShard(ctx, hch.rawHeaderHandler, shardId, hash)
+}
+
+func fetchHeaderByHashAndShard(ctx context.Context, rawHeaderHandler RawHeaderHandler, shardId uint32, hash string) (coreData.HeaderHandler, error) {
 	var err error
 	var header coreData.HeaderHandler
 
 	if shardId == core.MetachainShardId {
-		header, err = hch.rawHeaderHandler.GetMetaBlockByHash(ctx, hash)
+		header, err = rawHeaderHandler.GetMetaBlockByHash(ctx, hash)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		header, err = hch.rawHeaderHandler.GetShardBlockByHash(ctx, shardId, hash)
+		header, err = rawHeaderHandler.GetShardBlockByHash(ctx, shardId, hash)
 		if err != nil {
 			return nil, err
 		}
@@ -103,15 +177,50 @@ func (hch *headerVerifier) fetchHeaderByHashAndShard(ctx context.Context, shardI
 func (hch *headerVerifier) updateNodesConfigPerEpoch(ctx context.Context, epoch uint32) error {
 	log.Debug("epoch", epoch, "not in cache")
 
-	validatorInfo, randomness, err := hch.rawHeaderHandler.GetValidatorsInfoPerEpoch(ctx, epoch)
+	config, fromCache, err := hch.fetchEpochNodesConfig(ctx, epoch)
 	if err != nil {
 		return err
 	}
 
-	err = hch.nodesCoordinator.SetNodesConfigFromValidatorsInfo(epoch, randomness, validatorInfo)
+	return hch.applyEpochNodesConfig(config, fromCache)
+}
+
+// fetchEpochNodesConfig returns the nodes config of the provided epoch, from the local cache if available, otherwise
+// from proxy. It also returns whether the config was loaded from the local cache
+func (hch *headerVerifier) fetchEpochNodesConfig(ctx context.Context, epoch uint32) (*data.EpochNodesConfig, bool, error) {
+	config, found := hch.nodesConfigCache.Get(epoch)
+	if found {
+		log.Debug("nodes config loaded from the local cache", "epoch", epoch)
+		return config, true, nil
+	}
+
+	validatorInfo, randomness, err := hch.rawHeaderHandler.GetValidatorsInfoPerEpoch(ctx, epoch)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &data.EpochNodesConfig{
+		Epoch:          epoch,
+		Randomness:     randomness,
+		ValidatorsInfo: validatorInfo,
+	}, false, nil
+}
+
+// applyEpochNodesConfig sets the nodes config in the nodes coordinator and stores it in the local cache, if it was
+// not loaded from there
+func (hch *headerVerifier) applyEpochNodesConfig(config *data.EpochNodesConfig, fromCache bool) error {
+	err := hch.nodesCoordinator.SetNodesConfigFromValidatorsInfo(config.Epoch, config.Randomness, config.ValidatorsInfo)
 	if err != nil {
 		return err
 	}
+	if fromCache {
+		return nil
+	}
+
+	err = hch.nodesConfigCache.Put(config)
+	if err != nil {
+		log.Warn("could not store the nodes config in the local cache", "epoch", config.Epoch, "error", err)
+	}
 
 	return nil
 }
@@ -4,20 +4,98 @@ import (
 	"context"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/factory/crypto"
 	"github.com/multiversx/mx-chain-go/process/headerCheck"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/multiversx/mx-sdk-go/disabled"
 	"github.com/multiversx/mx-sdk-go/headerCheck/factory"
 )
 
+type headerCheckComponents struct {
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	nodesCoordinator  nodesCoordinator.EpochsConfigUpdateHandler
+	headerSigVerifier HeaderSigVerifierHandler
+	rawHeaderHandler  *rawHeaderHandler
+	genesisNodes      *data.GenesisNodes
+}
+
 // NewHeaderCheckHandler will create all components needed for header
 // verification and returns the header verifier component. It behaves like a
 // main factory for header verification components
 func NewHeaderCheckHandler(
 	proxy Proxy,
 	enableEpochsConfig *data.EnableEpochsConfig,
+) (BatchHeaderVerifier, error) {
+	return NewHeaderCheckHandlerWithCache(proxy, enableEpochsConfig, &disabled.NodesConfigCache{})
+}
+
+// NewHeaderCheckHandlerWithCache will create all components needed for header
+// verification and returns the header verifier component. The nodes config of
+// each epoch is loaded from, and stored in, the provided nodes config cache
+func NewHeaderCheckHandlerWithCache(
+	proxy Proxy,
+	enableEpochsConfig *data.EnableEpochsConfig,
+	nodesConfigCache NodesConfigCache,
+) (BatchHeaderVerifier, error) {
+	components, err := createHeaderCheckComponents(proxy, enableEpochsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	headerVerifierArgs := ArgsHeaderVerifier{
+		HeaderHandler:     components.rawHeaderHandler,
+		HeaderSigVerifier: components.headerSigVerifier,
+		NodesCoordinator:  components.nodesCoordinator,
+		NodesConfigCache:  nodesConfigCache,
+	}
+	headerVerifierInstance, err := NewHeaderVerifier(headerVerifierArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return headerVerifierInstance, nil
+}
+
+// NewLightClientHandler will create all components needed for header
+// verification and returns a light client that trusts only the provided
+// checkpoint instead of the validators info provided by the proxy. For the
+// genesis checkpoint, the genesis nodes config provided by the proxy is
+// checked against the checkpoint genesis nodes config hash
+func NewLightClientHandler(
+	proxy Proxy,
+	enableEpochsConfig *data.EnableEpochsConfig,
+	checkpoint TrustedCheckpoint,
 ) (HeaderVerifier, error) {
+	components, err := createHeaderCheckComponents(proxy, enableEpochsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	lightClientArgs := ArgsLightClient{
+		HeaderHandler:     components.rawHeaderHandler,
+		HeaderSigVerifier: components.headerSigVerifier,
+		NodesCoordinator:  components.nodesCoordinator,
+		Marshaller:        components.marshaller,
+		Hasher:            components.hasher,
+		Checkpoint:        checkpoint,
+		GenesisNodes:      components.genesisNodes,
+	}
+	lightClientInstance, err := NewLightClient(lightClientArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return lightClientInstance, nil
+}
+
+func createHeaderCheckComponents(
+	proxy Proxy,
+	enableEpochsConfig *data.EnableEpochsConfig,
+) (*headerCheckComponents, error) {
 	if check.IfNil(proxy) {
 		return nil, ErrNilProxy
 	}
@@ -89,15 +167,12 @@ func NewHeaderCheckHandler(
 		return nil, err
 	}
 
-	headerVerifierArgs := ArgsHeaderVerifier{
-		HeaderHandler:     rawHeaderHandlerInstance,
-		HeaderSigVerifier: headerSigVerifier,
-		NodesCoordinator:  nodesCoordinator,
-	}
-	headerVerifierInstance, err := NewHeaderVerifier(headerVerifierArgs)
-	if err != nil {
-		return nil, err
-	}
-
-	return headerVerifierInstance, nil
+	return &headerCheckComponents{
+		marshaller:        coreComp.Marshaller,
+		hasher:            coreComp.Hasher,
+		nodesCoordinator:  nodesCoordinator,
+		headerSigVerifier: headerSigVerifier,
+		rawHeaderHandler:  rawHeaderHandlerInstance,
+		genesisNodes:      genesisNodesConfig,
+	}, nil
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/state"
+	sdkData "github.com/multiversx/mx-sdk-go/data"
 	"github.com/multiversx/mx-sdk-go/headerCheck"
 	"github.com/multiversx/mx-sdk-go/testsCommon"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +25,14 @@ func createMockArgHeaderVerifier() headerCheck.ArgsHeaderVerifier {
 	}
 }
 
+func createNodesCoordinatorWithEpochInConfig() *testsCommon.NodesCoordinatorStub {
+	return &testsCommon.NodesCoordinatorStub{
+		IsEpochInConfigCalled: func(_ uint32) bool {
+			return true
+		},
+	}
+}
+
 func TestNewHeaderVerifier(t *testing.T) {
 	t.Parallel()
 
@@ -62,6 +72,16 @@ func TestNewHeaderVerifier(t *testing.T) {
 		args := createMockArgHeaderVerifier()
 		hv, err := headerCheck.NewHeaderVerifier(args)
 
+		assert.False(t, check.IfNil(hv))
+		assert.Nil(t, err)
+	})
+	t.Run("should work with nodes config cache", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgHeaderVerifier()
+		args.NodesConfigCache = &testsCommon.NodesConfigCacheStub{}
+		hv, err := headerCheck.NewHeaderVerifier(args)
+
 		assert.False(t, check.IfNil(hv))
 		assert.Nil(t, err)
 	})
@@ -85,6 +105,7 @@ func TestNewHeaderVerifier_VerifyHeaderSignatureByHash_ShouldFail(t *testing.T)
 	args := createMockArgHeaderVerifier()
 	args.HeaderSigVerifier = headerSigVerifier
 	args.HeaderHandler = rawHeaderHandler
+	args.NodesCoordinator = createNodesCoordinatorWithEpochInConfig()
 	hv, err := headerCheck.NewHeaderVerifier(args)
 	require.Nil(t, err)
 
@@ -111,6 +132,7 @@ func TestNewHeaderVerifier_VerifyHeaderSignatureByHash_ShouldWork(t *testing.T)
 	args := createMockArgHeaderVerifier()
 	args.HeaderHandler = rawHeaderHandler
 	args.HeaderSigVerifier = headerSigVerifier
+	args.NodesCoordinator = createNodesCoordinatorWithEpochInConfig()
 	hv, err := headerCheck.NewHeaderVerifier(args)
 	require.Nil(t, err)
 
@@ -119,6 +141,61 @@ func TestNewHeaderVerifier_VerifyHeaderSignatureByHash_ShouldWork(t *testing.T)
 	assert.True(t, status)
 }
 
+func TestNewHeaderVerifier_VerifyHeader_EpochEviction(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Epoch: 1}
+
+	t.Run("epoch evicted after being set should set it again", func(t *testing.T) {
+		t.Parallel()
+
+		numSetCalls := 0
+		numChecks := 0
+		args := createMockArgHeaderVerifier()
+		args.NodesCoordinator = &testsCommon.NodesCoordinatorStub{
+			IsEpochInConfigCalled: func(_ uint32) bool {
+				numChecks++
+				// the first set is evicted by a concurrent update before the read lock is re-acquired
+				return numSetCalls > 1
+			},
+			SetNodesConfigFromValidatorsInfoCalled: func(_ uint32, _ []byte, _ []*state.ShardValidatorInfo) error {
+				numSetCalls++
+				return nil
+			},
+		}
+		verifyCalled := false
+		args.HeaderSigVerifier = &testsCommon.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(_ data.HeaderHandler) error {
+				verifyCalled = true
+				assert.Equal(t, 2, numSetCalls)
+				return nil
+			},
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		status, err := hv.VerifyHeader(context.Background(), header)
+		assert.Nil(t, err)
+		assert.True(t, status)
+		assert.True(t, verifyCalled)
+	})
+	t.Run("epoch never kept in config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgHeaderVerifier()
+		args.HeaderSigVerifier = &testsCommon.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(_ data.HeaderHandler) error {
+				require.Fail(t, "should not verify the signature without the epoch nodes config")
+				return nil
+			},
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		status, err := hv.VerifyHeader(context.Background(), header)
+		assert.False(t, status)
+		assert.True(t, errors.Is(err, headerCheck.ErrEpochNodesConfigUnavailable))
+	})
+}
+
 func TestNewHeaderVerifier_FetchHeaderByHashAndShard_ShouldFail(t *testing.T) {
 	t.Parallel()
 
@@ -193,3 +270,103 @@ func TestNewHeaderVerifier_FetchHeaderByHashAndShard_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedMetaBlock, metaBlock)
 }
+
+func TestHeaderVerifier_UpdateNodesConfigPerEpoch(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	randomness := []byte("randomness")
+	validatorsInfo := []*state.ShardValidatorInfo{{PublicKey: []byte("pk1"), ShardId: 1, List: "eligible"}}
+	t.Run("cached epoch should not fetch from proxy", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgHeaderVerifier()
+		args.HeaderHandler = &testsCommon.RawHeaderHandlerStub{
+			GetValidatorsInfoPerEpochCalled: func(_ uint32) ([]*state.ShardValidatorInfo, []byte, error) {
+				require.Fail(t, "should not fetch the validators info")
+				return nil, nil, nil
+			},
+		}
+		args.NodesConfigCache = &testsCommon.NodesConfigCacheStub{
+			GetCalled: func(e uint32) (*sdkData.EpochNodesConfig, bool) {
+				return &sdkData.EpochNodesConfig{Epoch: e, Randomness: randomness, ValidatorsInfo: validatorsInfo}, true
+			},
+			PutCalled: func(_ *sdkData.EpochNodesConfig) error {
+				require.Fail(t, "should not store the nodes config")
+				return nil
+			},
+		}
+		setCalled := false
+		args.NodesCoordinator = &testsCommon.NodesCoordinatorStub{
+			SetNodesConfigFromValidatorsInfoCalled: func(e uint32, r []byte, vi []*state.ShardValidatorInfo) error {
+				setCalled = true
+				assert.Equal(t, epoch, e)
+				assert.Equal(t, randomness, r)
+				assert.Equal(t, validatorsInfo, vi)
+				return nil
+			},
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		err := hv.UpdateNodesConfigPerEpoch(context.Background(), epoch)
+		assert.Nil(t, err)
+		assert.True(t, setCalled)
+	})
+	t.Run("missing epoch should fetch from proxy and store in cache", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgHeaderVerifier()
+		args.HeaderHandler = &testsCommon.RawHeaderHandlerStub{
+			GetValidatorsInfoPerEpochCalled: func(_ uint32) ([]*state.ShardValidatorInfo, []byte, error) {
+				return validatorsInfo, randomness, nil
+			},
+		}
+		var storedConfig *sdkData.EpochNodesConfig
+		args.NodesConfigCache = &testsCommon.NodesConfigCacheStub{
+			PutCalled: func(config *sdkData.EpochNodesConfig) error {
+				storedConfig = config
+				return nil
+			},
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		err := hv.UpdateNodesConfigPerEpoch(context.Background(), epoch)
+		assert.Nil(t, err)
+		assert.Equal(t, &sdkData.EpochNodesConfig{Epoch: epoch, Randomness: randomness, ValidatorsInfo: validatorsInfo}, storedConfig)
+	})
+	t.Run("cache store error should not fail the update", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgHeaderVerifier()
+		args.NodesConfigCache = &testsCommon.NodesConfigCacheStub{
+			PutCalled: func(_ *sdkData.EpochNodesConfig) error {
+				return errors.New("disk full")
+			},
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		err := hv.UpdateNodesConfigPerEpoch(context.Background(), epoch)
+		assert.Nil(t, err)
+	})
+	t.Run("nodes coordinator error should not store in cache", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgHeaderVerifier()
+		args.NodesCoordinator = &testsCommon.NodesCoordinatorStub{
+			SetNodesConfigFromValidatorsInfoCalled: func(_ uint32, _ []byte, _ []*state.ShardValidatorInfo) error {
+				return expectedErr
+			},
+		}
+		args.NodesConfigCache = &testsCommon.NodesConfigCacheStub{
+			PutCalled: func(_ *sdkData.EpochNodesConfig) error {
+				require.Fail(t, "should not store the nodes config")
+				return nil
+			},
+		}
+		hv, _ := headerCheck.NewHeaderVerifier(args)
+
+		err := hv.UpdateNodesConfigPerEpoch(context.Background(), epoch)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,296 @@
+package headerCheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsInclusionVerifier holds all dependencies required by inclusionVerifier in order to create a new instance
+type ArgsInclusionVerifier struct {
+	Proxy          TransactionInfoProvider
+	HeaderHandler  InclusionHeaderHandler
+	HeaderVerifier HeaderVerifier
+	Marshaller     marshal.Marshalizer
+	Hasher         hashing.Hasher
+}
+
+// InclusionProof holds the data that proves the inclusion of a transaction in a signature verified block
+type InclusionProof struct {
+	TxHash                           string
+	ShardID                          uint32
+	HeaderHash                       string
+	MiniBlockHash                    string
+	Epoch                            uint32
+	NotarizedAtSourceInMetaHash      string
+	NotarizedAtDestinationInMetaHash string
+	SmartContractResults             []*InclusionProof
+}
+
+// inclusionVerifier checks that a transaction, as reported by the proxy, is part of a miniblock referenced by a
+// header whose signature is verified. For cross shard transactions, the metablocks notarizing the miniblock on
+// both the source and the destination shards are verified as well
+type inclusionVerifier struct {
+	proxy         TransactionInfoProvider
+	headerHandler InclusionHeaderHandler
+	headerFetcher *verifiedHeaderFetcher
+	marshaller    marshal.Marshalizer
+	hasher        hashing.Hasher
+}
+
+// NewInclusionVerifier creates a new instance of type inclusionVerifier
+func NewInclusionVerifier(args ArgsInclusionVerifier) (*inclusionVerifier, error) {
+	err := checkInclusionVerifierArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inclusionVerifier{
+		proxy:         args.Proxy,
+		headerHandler: args.HeaderHandler,
+		headerFetcher: newVerifiedHeaderFetcher(args.HeaderHandler, args.HeaderVerifier, args.Marshaller, args.Hasher),
+		marshaller:    args.Marshaller,
+		hasher:        args.Hasher,
+	}, nil
+}
+
+func checkInclusionVerifierArgs(args ArgsInclusionVerifier) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilTransactionInfoProvider
+	}
+	if check.IfNil(args.HeaderHandler) {
+		return ErrNilInclusionHeaderHandler
+	}
+	if check.IfNil(args.HeaderVerifier) {
+		return ErrNilHeaderVerifier
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	return nil
+}
+
+// VerifyTransactionInclusion verifies the inclusion of the provided transaction and of all its smart contract
+// results, returning the resulted proof
+func (iv *inclusionVerifier) VerifyTransactionInclusion(ctx context.Context, txHash string) (*InclusionProof, error) {
+	tx, err := iv.getTransaction(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := iv.verifyTransaction(ctx, txHash, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scr := range tx.ScResults {
+		scrProof, errScr := iv.verifySmartContractResult(ctx, scr.Hash)
+		if errScr != nil {
+			return nil, fmt.Errorf("%w for smart contract result %s", errScr, scr.Hash)
+		}
+
+		proof.SmartContractResults = append(proof.SmartContractResults, scrProof)
+	}
+
+	return proof, nil
+}
+
+func (iv *inclusionVerifier) verifySmartContractResult(ctx context.Context, scrHash string) (*InclusionProof, error) {
+	scr, err := iv.getTransaction(ctx, scrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return iv.verifyTransaction(ctx, scrHash, scr)
+}
+
+func (iv *inclusionVerifier) getTransaction(ctx context.Context, txHash string) (*data.TransactionOnNetwork, error) {
+	txInfo, err := iv.proxy.GetTransactionInfoWithResults(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if txInfo == nil {
+		return nil, ErrNilTransactionInfo
+	}
+
+	return &txInfo.Data.Transaction, nil
+}
+
+func (iv *inclusionVerifier) verifyTransaction(ctx context.Context, txHash string, tx *data.TransactionOnNetwork) (*InclusionProof, error) {
+	txHashBytes, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.BlockHash) == 0 || len(tx.MiniblockHash) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionNotInBlock, txHash)
+	}
+
+	miniBlockHash, err := hex.DecodeString(tx.MiniblockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	shardId := blockShardOfTransaction(tx)
+	header, err := iv.headerFetcher.fetchVerifiedHeader(ctx, shardId, tx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !headerReferencesMiniBlock(header.GetMiniBlockHeaderHandlers(), miniBlockHash) {
+		return nil, fmt.Errorf("%w: miniblock %s, header %s", ErrMiniBlockNotInHeader, tx.MiniblockHash, tx.BlockHash)
+	}
+
+	err = iv.checkMiniBlockContainsTransaction(ctx, shardId, miniBlockHash, header.GetEpoch(), txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.SourceShard != tx.DestinationShard {
+		err = iv.checkMetaNotarization(ctx, tx, shardId, miniBlockHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debug("verified transaction inclusion", "hash", txHash, "shard", shardId, "block", tx.BlockHash)
+
+	return &InclusionProof{
+		TxHash:                           txHash,
+		ShardID:                          shardId,
+		HeaderHash:                       tx.BlockHash,
+		MiniBlockHash:                    tx.MiniblockHash,
+		Epoch:                            header.GetEpoch(),
+		NotarizedAtSourceInMetaHash:      tx.NotarizedAtSourceInMetaHash,
+		NotarizedAtDestinationInMetaHash: tx.NotarizedAtDestinationInMetaHash,
+	}, nil
+}
+
+// blockShardOfTransaction returns the shard of the block reported for the transaction: a cross shard transaction
+// is reported from the destination shard once it was notarized there, otherwise from the source shard
+func blockShardOfTransaction(tx *data.TransactionOnNetwork) uint32 {
+	if tx.SourceShard != tx.DestinationShard && len(tx.NotarizedAtDestinationInMetaHash) > 0 {
+		return tx.DestinationShard
+	}
+
+	return tx.SourceShard
+}
+
+func (iv *inclusionVerifier) checkMiniBlockContainsTransaction(
+	ctx context.Context,
+	shardId uint32,
+	miniBlockHash []byte,
+	epoch uint32,
+	txHash []byte,
+) error {
+	miniBlock, err := iv.headerHandler.GetMiniBlockByHash(ctx, shardId, miniBlockHash, epoch)
+	if err != nil {
+		return err
+	}
+
+	computedHash, err := core.CalculateHash(iv.marshaller, iv.hasher, miniBlock)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(miniBlockHash, computedHash) {
+		return fmt.Errorf("%w: requested %s, computed %s", ErrMiniBlockHashMismatch,
+			hex.EncodeToString(miniBlockHash), hex.EncodeToString(computedHash))
+	}
+
+	for _, hash := range miniBlock.TxHashes {
+		if bytes.Equal(hash, txHash) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: transaction %s, miniblock %s", ErrTransactionNotInMiniBlock,
+		hex.EncodeToString(txHash), hex.EncodeToString(miniBlockHash))
+}
+
+func (iv *inclusionVerifier) checkMetaNotarization(
+	ctx context.Context,
+	tx *data.TransactionOnNetwork,
+	blockShardId uint32,
+	miniBlockHash []byte,
+) error {
+	if tx.SourceShard != core.MetachainShardId {
+		err := iv.checkNotarizedInMetaBlock(ctx, tx.NotarizedAtSourceInMetaHash, tx.SourceShard, blockShardId, tx.BlockHash, miniBlockHash)
+		if err != nil {
+			return fmt.Errorf("%w at source", err)
+		}
+	}
+	if tx.DestinationShard != core.MetachainShardId {
+		err := iv.checkNotarizedInMetaBlock(ctx, tx.NotarizedAtDestinationInMetaHash, tx.DestinationShard, blockShardId, tx.BlockHash, miniBlockHash)
+		if err != nil {
+			return fmt.Errorf("%w at destination", err)
+		}
+	}
+
+	return nil
+}
+
+// checkNotarizedInMetaBlock checks that the verified metablock notarizes a header of the provided shard which
+// references the miniblock. If the notarized header belongs to the shard of the transaction block, it should be
+// the transaction block itself
+func (iv *inclusionVerifier) checkNotarizedInMetaBlock(
+	ctx context.Context,
+	metaBlockHash string,
+	shardId uint32,
+	blockShardId uint32,
+	blockHash string,
+	miniBlockHash []byte,
+) error {
+	if len(metaBlockHash) == 0 {
+		return fmt.Errorf("%w for shard %d", ErrMissingMetaNotarization, shardId)
+	}
+
+	header, err := iv.headerFetcher.fetchVerifiedHeader(ctx, core.MetachainShardId, metaBlockHash)
+	if err != nil {
+		return err
+	}
+	metaBlock, ok := header.(coreData.MetaHeaderHandler)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrWrongTypeAssertion, metaBlockHash)
+	}
+
+	for _, shardData := range metaBlock.GetShardInfoHandlers() {
+		if shardData.GetShardID() != shardId {
+			continue
+		}
+		if !headerReferencesMiniBlock(shardData.GetShardMiniBlockHeaderHandlers(), miniBlockHash) {
+			continue
+		}
+		if shardId == blockShardId && hex.EncodeToString(shardData.GetHeaderHash()) != blockHash {
+			continue
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: shard %d, metablock %s", ErrMiniBlockNotNotarized, shardId, metaBlockHash)
+}
+
+func headerReferencesMiniBlock(miniBlockHeaders []coreData.MiniBlockHeaderHandler, miniBlockHash []byte) bool {
+	for _, miniBlockHeader := range miniBlockHeaders {
+		if bytes.Equal(miniBlockHeader.GetHash(), miniBlockHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (iv *inclusionVerifier) IsInterfaceNil() bool {
+	return iv == nil
+}
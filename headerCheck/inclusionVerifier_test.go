@@ -0,0 +1,460 @@
+package headerCheck_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/headerCheck"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testInclusionEpoch = uint32(3)
+
+var (
+	testTxHash  = []byte("transaction hash")
+	testScrHash = []byte("smart contract result hash")
+)
+
+// inclusionFixture holds a cross shard transaction, from shard 0 to shard 1, executed in a block of each shard
+// and notarized by the metachain, together with an intra shard smart contract result executed in shard 1
+type inclusionFixture struct {
+	miniBlocks   map[string]*block.MiniBlock
+	shardHeaders map[string]*block.Header
+	metaBlocks   map[string]*block.MetaBlock
+	txInfos      map[string]*data.TransactionInfo
+}
+
+func (fixture *inclusionFixture) addMiniBlock(tb testing.TB, miniBlock *block.MiniBlock) []byte {
+	hash := calculateHash(tb, miniBlock)
+	fixture.miniBlocks[string(hash)] = miniBlock
+
+	return hash
+}
+
+func (fixture *inclusionFixture) addShardHeader(tb testing.TB, header *block.Header) []byte {
+	hash := calculateHash(tb, header)
+	fixture.shardHeaders[hex.EncodeToString(hash)] = header
+
+	return hash
+}
+
+func (fixture *inclusionFixture) addMetaBlock(tb testing.TB, metaBlock *block.MetaBlock) []byte {
+	hash := calculateHash(tb, metaBlock)
+	fixture.metaBlocks[hex.EncodeToString(hash)] = metaBlock
+
+	return hash
+}
+
+func createInclusionFixture(tb testing.TB) *inclusionFixture {
+	fixture := &inclusionFixture{
+		miniBlocks:   make(map[string]*block.MiniBlock),
+		shardHeaders: make(map[string]*block.Header),
+		metaBlocks:   make(map[string]*block.MetaBlock),
+		txInfos:      make(map[string]*data.TransactionInfo),
+	}
+
+	txMiniBlockHash := fixture.addMiniBlock(tb, &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("other transaction"), testTxHash},
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	})
+	scrMiniBlockHash := fixture.addMiniBlock(tb, &block.MiniBlock{
+		TxHashes:        [][]byte{testScrHash},
+		SenderShardID:   1,
+		ReceiverShardID: 1,
+		Type:            block.SmartContractResultBlock,
+	})
+
+	sourceHeaderHash := fixture.addShardHeader(tb, &block.Header{
+		Nonce:            10,
+		ShardID:          0,
+		Epoch:            testInclusionEpoch,
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: txMiniBlockHash, SenderShardID: 0, ReceiverShardID: 1}},
+	})
+	destinationHeaderHash := fixture.addShardHeader(tb, &block.Header{
+		Nonce:   12,
+		ShardID: 1,
+		Epoch:   testInclusionEpoch,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: txMiniBlockHash, SenderShardID: 0, ReceiverShardID: 1},
+			{Hash: scrMiniBlockHash, SenderShardID: 1, ReceiverShardID: 1},
+		},
+	})
+
+	metaAtSourceHash := fixture.addMetaBlock(tb, &block.MetaBlock{
+		Nonce: 20,
+		Epoch: testInclusionEpoch,
+		ShardInfo: []block.ShardData{
+			{
+				ShardID:               0,
+				HeaderHash:            sourceHeaderHash,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{{Hash: txMiniBlockHash}},
+			},
+		},
+	})
+	metaAtDestinationHash := fixture.addMetaBlock(tb, &block.MetaBlock{
+		Nonce: 21,
+		Epoch: testInclusionEpoch,
+		ShardInfo: []block.ShardData{
+			{
+				ShardID:               1,
+				HeaderHash:            destinationHeaderHash,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{{Hash: txMiniBlockHash}, {Hash: scrMiniBlockHash}},
+			},
+		},
+	})
+
+	txInfo := &data.TransactionInfo{}
+	txInfo.Data.Transaction = data.TransactionOnNetwork{
+		Hash:                             hex.EncodeToString(testTxHash),
+		SourceShard:                      0,
+		DestinationShard:                 1,
+		BlockHash:                        hex.EncodeToString(destinationHeaderHash),
+		MiniblockHash:                    hex.EncodeToString(txMiniBlockHash),
+		NotarizedAtSourceInMetaHash:      hex.EncodeToString(metaAtSourceHash),
+		NotarizedAtDestinationInMetaHash: hex.EncodeToString(metaAtDestinationHash),
+		ScResults: []*transaction.ApiSmartContractResult{
+			{Hash: hex.EncodeToString(testScrHash)},
+		},
+	}
+	fixture.txInfos[hex.EncodeToString(testTxHash)] = txInfo
+
+	scrInfo := &data.TransactionInfo{}
+	scrInfo.Data.Transaction = data.TransactionOnNetwork{
+		Hash:             hex.EncodeToString(testScrHash),
+		SourceShard:      1,
+		DestinationShard: 1,
+		BlockHash:        hex.EncodeToString(destinationHeaderHash),
+		MiniblockHash:    hex.EncodeToString(scrMiniBlockHash),
+	}
+	fixture.txInfos[hex.EncodeToString(testScrHash)] = scrInfo
+
+	return fixture
+}
+
+func (fixture *inclusionFixture) txInfo() *data.TransactionOnNetwork {
+	return &fixture.txInfos[hex.EncodeToString(testTxHash)].Data.Transaction
+}
+
+func (fixture *inclusionFixture) createProxy() *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+			txInfo, found := fixture.txInfos[hash]
+			if !found {
+				return nil, errors.New("transaction not found")
+			}
+
+			return txInfo, nil
+		},
+	}
+}
+
+func (fixture *inclusionFixture) createHeaderHandler() *testsCommon.RawHeaderHandlerStub {
+	return &testsCommon.RawHeaderHandlerStub{
+		GetShardBlockByHashCalled: func(shardId uint32, hash string) (coreData.HeaderHandler, error) {
+			header, found := fixture.shardHeaders[hash]
+			if !found || header.ShardID != shardId {
+				return nil, errors.New("header not found")
+			}
+
+			return header, nil
+		},
+		GetMetaBlockByHashCalled: func(hash string) (coreData.MetaHeaderHandler, error) {
+			metaBlock, found := fixture.metaBlocks[hash]
+			if !found {
+				return nil, errors.New("metablock not found")
+			}
+
+			return metaBlock, nil
+		},
+		GetMiniBlockByHashCalled: func(shardId uint32, hash []byte, epoch uint32) (*block.MiniBlock, error) {
+			miniBlock, found := fixture.miniBlocks[string(hash)]
+			if !found {
+				return nil, errors.New("miniblock not found")
+			}
+
+			return miniBlock, nil
+		},
+	}
+}
+
+func createMockArgsInclusionVerifier(fixture *inclusionFixture) headerCheck.ArgsInclusionVerifier {
+	return headerCheck.ArgsInclusionVerifier{
+		Proxy:          fixture.createProxy(),
+		HeaderHandler:  fixture.createHeaderHandler(),
+		HeaderVerifier: &testsCommon.HeaderVerifierStub{},
+		Marshaller:     testMarshaller,
+		Hasher:         testHasher,
+	}
+}
+
+func TestNewInclusionVerifier(t *testing.T) {
+	t.Parallel()
+
+	fixture := createInclusionFixture(t)
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInclusionVerifier(fixture)
+		args.Proxy = nil
+		iv, err := headerCheck.NewInclusionVerifier(args)
+		assert.True(t, check.IfNil(iv))
+		assert.Equal(t, headerCheck.ErrNilTransactionInfoProvider, err)
+	})
+	t.Run("nil header handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInclusionVerifier(fixture)
+		args.HeaderHandler = nil
+		iv, err := headerCheck.NewInclusionVerifier(args)
+		assert.True(t, check.IfNil(iv))
+		assert.Equal(t, headerCheck.ErrNilInclusionHeaderHandler, err)
+	})
+	t.Run("nil header verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInclusionVerifier(fixture)
+		args.HeaderVerifier = nil
+		iv, err := headerCheck.NewInclusionVerifier(args)
+		assert.True(t, check.IfNil(iv))
+		assert.Equal(t, headerCheck.ErrNilHeaderVerifier, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInclusionVerifier(fixture)
+		args.Marshaller = nil
+		iv, err := headerCheck.NewInclusionVerifier(args)
+		assert.True(t, check.IfNil(iv))
+		assert.Equal(t, headerCheck.ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsInclusionVerifier(fixture)
+		args.Hasher = nil
+		iv, err := headerCheck.NewInclusionVerifier(args)
+		assert.True(t, check.IfNil(iv))
+		assert.Equal(t, headerCheck.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		iv, err := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+		assert.False(t, check.IfNil(iv))
+		assert.Nil(t, err)
+	})
+}
+
+func TestInclusionVerifier_VerifyTransactionInclusion(t *testing.T) {
+	t.Parallel()
+
+	txHash := hex.EncodeToString(testTxHash)
+	t.Run("transaction not in a block should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		fixture.txInfo().BlockHash = ""
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrTransactionNotInBlock))
+	})
+	t.Run("tampered header should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		fixture.shardHeaders[fixture.txInfo().BlockHash].Nonce++
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrHeaderHashMismatch))
+	})
+	t.Run("invalid header signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		args := createMockArgsInclusionVerifier(fixture)
+		args.HeaderVerifier = &testsCommon.HeaderVerifierStub{
+			VerifyHeaderCalled: func(ctx context.Context, header coreData.HeaderHandler) (bool, error) {
+				return false, nil
+			},
+		}
+		iv, _ := headerCheck.NewInclusionVerifier(args)
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidHeaderSignature))
+	})
+	t.Run("header verifier error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		fixture := createInclusionFixture(t)
+		args := createMockArgsInclusionVerifier(fixture)
+		args.HeaderVerifier = &testsCommon.HeaderVerifierStub{
+			VerifyHeaderCalled: func(ctx context.Context, header coreData.HeaderHandler) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		iv, _ := headerCheck.NewInclusionVerifier(args)
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("miniblock not referenced by the header should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		fixture.txInfo().MiniblockHash = hex.EncodeToString([]byte("unknown miniblock"))
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrMiniBlockNotInHeader))
+	})
+	t.Run("tampered miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		miniBlockHash, _ := hex.DecodeString(fixture.txInfo().MiniblockHash)
+		fixture.miniBlocks[string(miniBlockHash)].TxHashes = [][]byte{testTxHash}
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrMiniBlockHashMismatch))
+	})
+	t.Run("transaction not in miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		otherTxHash := hex.EncodeToString([]byte("other transaction hash"))
+		fixture.txInfos[otherTxHash] = fixture.txInfos[txHash]
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), otherTxHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrTransactionNotInMiniBlock))
+	})
+	t.Run("missing notarization at source should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		fixture.txInfo().NotarizedAtSourceInMetaHash = ""
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrMissingMetaNotarization))
+	})
+	t.Run("metablock not notarizing the miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		fixture.txInfo().NotarizedAtSourceInMetaHash = fixture.txInfo().NotarizedAtDestinationInMetaHash
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrMiniBlockNotNotarized))
+	})
+	t.Run("metablock notarizing another destination header should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		metaBlock := fixture.metaBlocks[fixture.txInfo().NotarizedAtDestinationInMetaHash]
+		delete(fixture.metaBlocks, fixture.txInfo().NotarizedAtDestinationInMetaHash)
+		metaBlock.ShardInfo[0].HeaderHash = []byte("another header")
+		fixture.txInfo().NotarizedAtDestinationInMetaHash = hex.EncodeToString(fixture.addMetaBlock(t, metaBlock))
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrMiniBlockNotNotarized))
+	})
+	t.Run("invalid smart contract result should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		fixture.txInfos[hex.EncodeToString(testScrHash)].Data.Transaction.MiniblockHash = fixture.txInfo().MiniblockHash
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrTransactionNotInMiniBlock))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		verifiedHeaders := make(map[string]uint32)
+		args := createMockArgsInclusionVerifier(fixture)
+		args.HeaderVerifier = &testsCommon.HeaderVerifierStub{
+			VerifyHeaderCalled: func(ctx context.Context, header coreData.HeaderHandler) (bool, error) {
+				verifiedHeaders[hex.EncodeToString(calculateHash(t, header))] = header.GetShardID()
+				return true, nil
+			},
+		}
+		iv, _ := headerCheck.NewInclusionVerifier(args)
+
+		tx := fixture.txInfo()
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		require.Nil(t, err)
+		assert.Equal(t, &headerCheck.InclusionProof{
+			TxHash:                           txHash,
+			ShardID:                          1,
+			HeaderHash:                       tx.BlockHash,
+			MiniBlockHash:                    tx.MiniblockHash,
+			Epoch:                            testInclusionEpoch,
+			NotarizedAtSourceInMetaHash:      tx.NotarizedAtSourceInMetaHash,
+			NotarizedAtDestinationInMetaHash: tx.NotarizedAtDestinationInMetaHash,
+			SmartContractResults: []*headerCheck.InclusionProof{
+				{
+					TxHash:        hex.EncodeToString(testScrHash),
+					ShardID:       1,
+					HeaderHash:    tx.BlockHash,
+					MiniBlockHash: fixture.txInfos[hex.EncodeToString(testScrHash)].Data.Transaction.MiniblockHash,
+					Epoch:         testInclusionEpoch,
+				},
+			},
+		}, proof)
+		assert.Equal(t, map[string]uint32{
+			tx.BlockHash:                        1,
+			tx.NotarizedAtSourceInMetaHash:      core.MetachainShardId,
+			tx.NotarizedAtDestinationInMetaHash: core.MetachainShardId,
+		}, verifiedHeaders)
+	})
+	t.Run("pending cross shard transaction should be verified on the source shard", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createInclusionFixture(t)
+		for hash, header := range fixture.shardHeaders {
+			if header.ShardID == 0 {
+				fixture.txInfo().BlockHash = hash
+			}
+		}
+		fixture.txInfo().NotarizedAtDestinationInMetaHash = ""
+		fixture.txInfo().ScResults = nil
+		iv, _ := headerCheck.NewInclusionVerifier(createMockArgsInclusionVerifier(fixture))
+
+		proof, err := iv.VerifyTransactionInclusion(context.Background(), txHash)
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, headerCheck.ErrMissingMetaNotarization))
+		assert.Contains(t, err.Error(), "at destination")
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
@@ -34,12 +35,54 @@ type RawHeaderHandler interface {
 	IsInterfaceNil() bool
 }
 
+// EpochStartHeaderHandler holds the behaviour needed by the light client to fetch the epoch start data from proxy
+type EpochStartHeaderHandler interface {
+	RawHeaderHandler
+	GetStartOfEpochMetaBlock(ctx context.Context, epoch uint32) (coreData.MetaHeaderHandler, error)
+	GetMiniBlockByHash(ctx context.Context, shardId uint32, hash []byte, epoch uint32) (*block.MiniBlock, error)
+	GetValidatorsInfoByEpoch(ctx context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error)
+}
+
+// InclusionHeaderHandler holds the behaviour needed to fetch the headers and the miniblocks used in the transaction
+// inclusion proofs
+type InclusionHeaderHandler interface {
+	RawHeaderHandler
+	GetMiniBlockByHash(ctx context.Context, shardId uint32, hash []byte, epoch uint32) (*block.MiniBlock, error)
+}
+
+// TransactionInfoProvider holds the behaviour needed to fetch the transaction info from proxy
+type TransactionInfoProvider interface {
+	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	IsInterfaceNil() bool
+}
+
+// StateProofProvider holds the behaviour needed to fetch the state Merkle proofs from proxy
+type StateProofProvider interface {
+	GetProof(ctx context.Context, rootHash []byte, address core.AddressHandler) (*data.AccountProof, error)
+	GetProofDataTrie(ctx context.Context, rootHash []byte, address core.AddressHandler, key []byte) (*data.DataTrieProof, error)
+	IsInterfaceNil() bool
+}
+
+// NodesConfigCache defines the operations of a cache holding the nodes config of each epoch
+type NodesConfigCache interface {
+	Get(epoch uint32) (*data.EpochNodesConfig, bool)
+	Put(config *data.EpochNodesConfig) error
+	IsInterfaceNil() bool
+}
+
 // HeaderVerifier defines the functions needed for verifying headers
 type HeaderVerifier interface {
 	VerifyHeaderSignatureByHash(ctx context.Context, shardId uint32, hash string) (bool, error)
+	VerifyHeader(ctx context.Context, header coreData.HeaderHandler) (bool, error)
 	IsInterfaceNil() bool
 }
 
+// BatchHeaderVerifier defines the functions needed for verifying headers, one by one or in batches
+type BatchHeaderVerifier interface {
+	HeaderVerifier
+	VerifyHeadersBatch(ctx context.Context, requests []HeaderRequest) ([]*HeaderVerificationResult, error)
+}
+
 // HeaderSigVerifierHandler defines the functions needed to verify headers signature
 type HeaderSigVerifierHandler interface {
 	VerifySignature(header coreData.HeaderHandler) error
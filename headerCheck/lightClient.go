@@ -0,0 +1,441 @@
+package headerCheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// TrustedCheckpoint defines the trust root of the light client
+type TrustedCheckpoint struct {
+	Epoch uint32
+	// MetaBlockHash is the hex encoded hash of the start of epoch metablock for the checkpoint epoch, obtained
+	// out-of-band. It is not used for the genesis checkpoint (epoch 0)
+	MetaBlockHash string
+	// GenesisNodesConfigHash is the hex encoded hash of the genesis nodes config, as computed by
+	// ComputeGenesisNodesConfigHash, obtained out-of-band. It is required only for the genesis checkpoint (epoch 0)
+	GenesisNodesConfigHash string
+}
+
+// ArgsLightClient holds all dependencies required by lightClient in order to create a new instance
+type ArgsLightClient struct {
+	HeaderHandler     EpochStartHeaderHandler
+	HeaderSigVerifier HeaderSigVerifierHandler
+	NodesCoordinator  nodesCoordinator.EpochsConfigUpdateHandler
+	Marshaller        marshal.Marshalizer
+	Hasher            hashing.Hasher
+	Checkpoint        TrustedCheckpoint
+	// GenesisNodes is the genesis nodes config the nodes coordinator was created with. It is checked against the
+	// checkpoint for the genesis checkpoint (epoch 0)
+	GenesisNodes *data.GenesisNodes
+}
+
+type verifiedEpoch struct {
+	metaBlockHash  []byte
+	randomness     []byte
+	validatorsInfo []*state.ShardValidatorInfo
+}
+
+// lightClient verifies headers without trusting the validators info provided by the proxy. Starting from the
+// trusted checkpoint, each start of epoch metablock is verified with the validators of the previous epoch and the
+// validators info of the new epoch is checked against the peer miniblocks committed in that metablock
+type lightClient struct {
+	headerHandler     EpochStartHeaderHandler
+	headerSigVerifier HeaderSigVerifierHandler
+	nodesCoordinator  nodesCoordinator.EpochsConfigUpdateHandler
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	checkpoint        TrustedCheckpoint
+	checkpointHash    []byte
+
+	mutEpochs           sync.Mutex
+	verifiedEpochs      map[uint32]*verifiedEpoch
+	latestVerifiedEpoch uint32
+}
+
+// NewLightClient creates a new instance of type lightClient
+func NewLightClient(args ArgsLightClient) (*lightClient, error) {
+	err := checkLightClientArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointHash, err := hex.DecodeString(args.Checkpoint.MetaBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCheckpoint, err.Error())
+	}
+	if args.Checkpoint.Epoch > 0 && len(checkpointHash) == 0 {
+		return nil, fmt.Errorf("%w: missing metablock hash for epoch %d", ErrInvalidCheckpoint, args.Checkpoint.Epoch)
+	}
+	if args.Checkpoint.Epoch == 0 {
+		err = checkGenesisNodesConfig(args.Hasher, args.GenesisNodes, args.Checkpoint.GenesisNodesConfigHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lightClient{
+		headerHandler:     args.HeaderHandler,
+		headerSigVerifier: args.HeaderSigVerifier,
+		nodesCoordinator:  args.NodesCoordinator,
+		marshaller:        args.Marshaller,
+		hasher:            args.Hasher,
+		checkpoint:        args.Checkpoint,
+		checkpointHash:    checkpointHash,
+		verifiedEpochs:    make(map[uint32]*verifiedEpoch),
+	}, nil
+}
+
+func checkLightClientArgs(args ArgsLightClient) error {
+	if check.IfNil(args.HeaderHandler) {
+		return ErrNilEpochStartHeaderHandler
+	}
+	if check.IfNil(args.HeaderSigVerifier) {
+		return ErrNilHeaderSigVerifier
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	return nil
+}
+
+// checkGenesisNodesConfig checks that the genesis nodes config, usually provided by the proxy, is the one trusted by
+// the checkpoint
+func checkGenesisNodesConfig(hasher hashing.Hasher, genesisNodes *data.GenesisNodes, trustedHash string) error {
+	trustedHashBytes, err := hex.DecodeString(trustedHash)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCheckpoint, err.Error())
+	}
+	if len(trustedHashBytes) == 0 {
+		return fmt.Errorf("%w: missing genesis nodes config hash for epoch 0", ErrInvalidCheckpoint)
+	}
+	if genesisNodes == nil {
+		return ErrNilGenesisNodes
+	}
+
+	genesisNodesHash, err := ComputeGenesisNodesConfigHash(hasher, genesisNodes)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(genesisNodesHash, trustedHashBytes) {
+		return fmt.Errorf("%w: genesis nodes config hash %s, trusted %s", ErrCheckpointMismatch,
+			hex.EncodeToString(genesisNodesHash), trustedHash)
+	}
+
+	return nil
+}
+
+// ComputeGenesisNodesConfigHash returns the hash of the JSON encoded genesis nodes config, the encoding being
+// deterministic as the shards are sorted by their ID
+func ComputeGenesisNodesConfigHash(hasher hashing.Hasher, genesisNodes *data.GenesisNodes) ([]byte, error) {
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if genesisNodes == nil {
+		return nil, ErrNilGenesisNodes
+	}
+
+	buff, err := json.Marshal(genesisNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return hasher.Compute(string(buff)), nil
+}
+
+// VerifyHeaderSignatureByHash fetches the header, checks that it matches the provided hash and verifies its
+// signature using the validators set obtained through the verified chain of start of epoch metablocks
+func (lc *lightClient) VerifyHeaderSignatureByHash(ctx context.Context, shardId uint32, hash string) (bool, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return false, err
+	}
+
+	header, err := fetchHeaderByHashAndShard(ctx, lc.headerHandler, shardId, hash)
+	if err != nil {
+		return false, err
+	}
+
+	computedHash, err := core.CalculateHash(lc.marshaller, lc.hasher, header)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(hashBytes, computedHash) {
+		return false, fmt.Errorf("%w: requested %s, computed %s", ErrHeaderHashMismatch, hash, hex.EncodeToString(computedHash))
+	}
+
+	return lc.VerifyHeader(ctx, header)
+}
+
+// VerifyHeader verifies the signature of the provided header using the validators set obtained through the verified
+// chain of start of epoch metablocks
+func (lc *lightClient) VerifyHeader(ctx context.Context, header coreData.HeaderHandler) (bool, error) {
+	if check.IfNil(header) {
+		return false, ErrNilHeader
+	}
+
+	lc.mutEpochs.Lock()
+	defer lc.mutEpochs.Unlock()
+
+	err := lc.syncToEpoch(ctx, header.GetEpoch())
+	if err != nil {
+		return false, err
+	}
+
+	err = lc.ensureNodesConfig(consensusEpoch(header))
+	if err != nil {
+		return false, err
+	}
+
+	err = lc.headerSigVerifier.VerifySignature(header)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SyncToEpoch verifies, in order, all the start of epoch metablocks up to the provided epoch
+func (lc *lightClient) SyncToEpoch(ctx context.Context, epoch uint32) error {
+	lc.mutEpochs.Lock()
+	defer lc.mutEpochs.Unlock()
+
+	return lc.syncToEpoch(ctx, epoch)
+}
+
+// LatestVerifiedEpoch returns the latest epoch whose validators set was verified
+func (lc *lightClient) LatestVerifiedEpoch() uint32 {
+	lc.mutEpochs.Lock()
+	defer lc.mutEpochs.Unlock()
+
+	return lc.latestVerifiedEpoch
+}
+
+func (lc *lightClient) syncToEpoch(ctx context.Context, epoch uint32) error {
+	if epoch < lc.checkpoint.Epoch {
+		return fmt.Errorf("%w: epoch %d, checkpoint epoch %d", ErrEpochBeforeCheckpoint, epoch, lc.checkpoint.Epoch)
+	}
+
+	if len(lc.verifiedEpochs) == 0 {
+		err := lc.initializeFromCheckpoint(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for nextEpoch := lc.latestVerifiedEpoch + 1; nextEpoch <= epoch; nextEpoch++ {
+		err := lc.verifyEpochTransition(ctx, nextEpoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lc *lightClient) initializeFromCheckpoint(ctx context.Context) error {
+	if lc.checkpoint.Epoch == 0 {
+		log.Debug("light client initialized from the trusted genesis nodes config")
+		lc.verifiedEpochs[0] = &verifiedEpoch{}
+		lc.latestVerifiedEpoch = 0
+
+		return nil
+	}
+
+	metaBlock, metaBlockHash, err := lc.fetchStartOfEpochMetaBlock(ctx, lc.checkpoint.Epoch)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(metaBlockHash, lc.checkpointHash) {
+		return fmt.Errorf("%w: checkpoint %s, computed %s", ErrCheckpointMismatch,
+			lc.checkpoint.MetaBlockHash, hex.EncodeToString(metaBlockHash))
+	}
+
+	log.Debug("light client initialized from checkpoint", "epoch", lc.checkpoint.Epoch, "hash", lc.checkpoint.MetaBlockHash)
+
+	return lc.applyEpoch(ctx, metaBlock, metaBlockHash)
+}
+
+func (lc *lightClient) verifyEpochTransition(ctx context.Context, epoch uint32) error {
+	metaBlock, metaBlockHash, err := lc.fetchStartOfEpochMetaBlock(ctx, epoch)
+	if err != nil {
+		return err
+	}
+
+	previous := lc.verifiedEpochs[epoch-1]
+	if len(previous.metaBlockHash) > 0 {
+		prevEpochStartHash := metaBlock.GetEpochStartHandler().GetEconomicsHandler().GetPrevEpochStartHash()
+		if !bytes.Equal(prevEpochStartHash, previous.metaBlockHash) {
+			return fmt.Errorf("%w: epoch %d", ErrBrokenEpochChain, epoch)
+		}
+	}
+
+	err = lc.ensureNodesConfig(epoch - 1)
+	if err != nil {
+		return err
+	}
+
+	err = lc.headerSigVerifier.VerifySignature(metaBlock)
+	if err != nil {
+		return fmt.Errorf("%w for epoch %d: %w", ErrInvalidEpochStartSignature, epoch, err)
+	}
+
+	log.Debug("light client verified start of epoch metablock", "epoch", epoch, "hash", hex.EncodeToString(metaBlockHash))
+
+	return lc.applyEpoch(ctx, metaBlock, metaBlockHash)
+}
+
+func (lc *lightClient) fetchStartOfEpochMetaBlock(ctx context.Context, epoch uint32) (coreData.MetaHeaderHandler, []byte, error) {
+	metaBlock, err := lc.headerHandler.GetStartOfEpochMetaBlock(ctx, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if check.IfNil(metaBlock) || !metaBlock.IsStartOfEpochBlock() || metaBlock.GetEpoch() != epoch {
+		return nil, nil, fmt.Errorf("%w for epoch %d", ErrInvalidEpochStartBlock, epoch)
+	}
+
+	metaBlockHash, err := core.CalculateHash(lc.marshaller, lc.hasher, metaBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metaBlock, metaBlockHash, nil
+}
+
+func (lc *lightClient) applyEpoch(ctx context.Context, metaBlock coreData.MetaHeaderHandler, metaBlockHash []byte) error {
+	epoch := metaBlock.GetEpoch()
+	validatorsInfo, err := lc.headerHandler.GetValidatorsInfoByEpoch(ctx, epoch)
+	if err != nil {
+		return err
+	}
+
+	err = lc.verifyValidatorsInfo(ctx, metaBlock, validatorsInfo)
+	if err != nil {
+		return err
+	}
+
+	verified := &verifiedEpoch{
+		metaBlockHash:  metaBlockHash,
+		randomness:     metaBlock.GetPrevRandSeed(),
+		validatorsInfo: validatorsInfo,
+	}
+	err = lc.nodesCoordinator.SetNodesConfigFromValidatorsInfo(epoch, verified.randomness, validatorsInfo)
+	if err != nil {
+		return err
+	}
+
+	lc.verifiedEpochs[epoch] = verified
+	lc.latestVerifiedEpoch = epoch
+
+	return nil
+}
+
+// verifyValidatorsInfo checks that the validators info is exactly the one committed in the peer miniblocks of the
+// start of epoch metablock. Depending on the epoch, the miniblocks hold either the hashes of the marshalled
+// validators info or the marshalled validators info itself
+func (lc *lightClient) verifyValidatorsInfo(
+	ctx context.Context,
+	metaBlock coreData.MetaHeaderHandler,
+	validatorsInfo []*state.ShardValidatorInfo,
+) error {
+	epoch := metaBlock.GetEpoch()
+	committed := make(map[string]int)
+	numCommitted := 0
+	for _, miniBlockHeader := range metaBlock.GetMiniBlockHeaderHandlers() {
+		if miniBlockHeader.GetTypeInt32() != int32(block.PeerBlock) {
+			continue
+		}
+
+		miniBlock, err := lc.headerHandler.GetMiniBlockByHash(ctx, core.MetachainShardId, miniBlockHeader.GetHash(), epoch)
+		if err != nil {
+			return err
+		}
+
+		miniBlockHash, err := core.CalculateHash(lc.marshaller, lc.hasher, miniBlock)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(miniBlockHash, miniBlockHeader.GetHash()) || miniBlock.Type != block.PeerBlock {
+			return fmt.Errorf("%w: %s in epoch %d", ErrMiniBlockHashMismatch, hex.EncodeToString(miniBlockHeader.GetHash()), epoch)
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			committed[string(txHash)]++
+			numCommitted++
+		}
+	}
+
+	if numCommitted != len(validatorsInfo) {
+		return fmt.Errorf("%w: %d committed entries, %d received for epoch %d",
+			ErrValidatorsInfoMismatch, numCommitted, len(validatorsInfo), epoch)
+	}
+
+	for _, validatorInfo := range validatorsInfo {
+		buff, err := lc.marshaller.Marshal(validatorInfo)
+		if err != nil {
+			return err
+		}
+
+		key := string(lc.hasher.Compute(string(buff)))
+		if committed[key] == 0 {
+			key = string(buff)
+		}
+		if committed[key] == 0 {
+			return fmt.Errorf("%w: validator %s not committed for epoch %d",
+				ErrValidatorsInfoMismatch, hex.EncodeToString(validatorInfo.PublicKey), epoch)
+		}
+
+		committed[key]--
+	}
+
+	return nil
+}
+
+func (lc *lightClient) ensureNodesConfig(epoch uint32) error {
+	if lc.nodesCoordinator.IsEpochInConfig(epoch) {
+		return nil
+	}
+
+	verified, found := lc.verifiedEpochs[epoch]
+	if !found || verified.validatorsInfo == nil {
+		return fmt.Errorf("%w %d", ErrEpochConfigUnavailable, epoch)
+	}
+
+	return lc.nodesCoordinator.SetNodesConfigFromValidatorsInfo(epoch, verified.randomness, verified.validatorsInfo)
+}
+
+// consensusEpoch returns the epoch of the validators that signed the header. The start of epoch blocks are
+// signed by the validators of the previous epoch
+func consensusEpoch(header coreData.HeaderHandler) uint32 {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		return epoch - 1
+	}
+
+	return epoch
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lc *lightClient) IsInterfaceNil() bool {
+	return lc == nil
+}
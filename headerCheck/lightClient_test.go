@@ -0,0 +1,501 @@
+package headerCheck_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/state"
+	sdkData "github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/headerCheck"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+)
+
+type testEpoch struct {
+	metaBlock      *block.MetaBlock
+	hash           []byte
+	miniBlock      *block.MiniBlock
+	validatorsInfo []*state.ShardValidatorInfo
+}
+
+type testChain struct {
+	epochs map[uint32]*testEpoch
+}
+
+func calculateHash(tb testing.TB, object interface{}) []byte {
+	hash, err := core.CalculateHash(testMarshaller, testHasher, object)
+	require.Nil(tb, err)
+
+	return hash
+}
+
+// createTestChain creates consistent start of epoch metablocks for the epochs in the [1, lastEpoch] range. The
+// validators info of even epochs is committed using the marshalled data instead of hashes, as in the older epochs
+func createTestChain(tb testing.TB, lastEpoch uint32) *testChain {
+	chain := &testChain{
+		epochs: make(map[uint32]*testEpoch),
+	}
+
+	var prevHash []byte
+	for epoch := uint32(1); epoch <= lastEpoch; epoch++ {
+		validatorsInfo := make([]*state.ShardValidatorInfo, 0)
+		miniBlock := &block.MiniBlock{
+			Type:            block.PeerBlock,
+			SenderShardID:   core.MetachainShardId,
+			ReceiverShardID: core.AllShardId,
+		}
+		for index := 0; index < 3; index++ {
+			validatorInfo := &state.ShardValidatorInfo{
+				PublicKey:  []byte(fmt.Sprintf("validator %d in epoch %d", index, epoch)),
+				ShardId:    uint32(index % 2),
+				List:       "eligible",
+				Index:      uint32(index),
+				TempRating: 50,
+			}
+			validatorsInfo = append(validatorsInfo, validatorInfo)
+
+			buff, err := testMarshaller.Marshal(validatorInfo)
+			require.Nil(tb, err)
+			if epoch%2 == 0 {
+				miniBlock.TxHashes = append(miniBlock.TxHashes, buff)
+			} else {
+				miniBlock.TxHashes = append(miniBlock.TxHashes, testHasher.Compute(string(buff)))
+			}
+		}
+
+		metaBlock := &block.MetaBlock{
+			Nonce:        uint64(epoch) * 100,
+			Epoch:        epoch,
+			PrevRandSeed: []byte(fmt.Sprintf("randomness %d", epoch)),
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+				Economics: block.Economics{
+					PrevEpochStartHash: prevHash,
+				},
+			},
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: []byte("other miniblock"), Type: block.TxBlock},
+				{Hash: calculateHash(tb, miniBlock), Type: block.PeerBlock},
+			},
+		}
+
+		hash := calculateHash(tb, metaBlock)
+		chain.epochs[epoch] = &testEpoch{
+			metaBlock:      metaBlock,
+			hash:           hash,
+			miniBlock:      miniBlock,
+			validatorsInfo: validatorsInfo,
+		}
+		prevHash = hash
+	}
+
+	return chain
+}
+
+func (chain *testChain) createHeaderHandler() *testsCommon.RawHeaderHandlerStub {
+	return &testsCommon.RawHeaderHandlerStub{
+		GetStartOfEpochMetaBlockCalled: func(epoch uint32) (data.MetaHeaderHandler, error) {
+			testEpochData, found := chain.epochs[epoch]
+			if !found {
+				return nil, errors.New("missing epoch")
+			}
+
+			return testEpochData.metaBlock, nil
+		},
+		GetMiniBlockByHashCalled: func(shardId uint32, hash []byte, epoch uint32) (*block.MiniBlock, error) {
+			return chain.epochs[epoch].miniBlock, nil
+		},
+		GetValidatorsInfoByEpochCalled: func(epoch uint32) ([]*state.ShardValidatorInfo, error) {
+			return chain.epochs[epoch].validatorsInfo, nil
+		},
+	}
+}
+
+func (chain *testChain) checkpoint(epoch uint32) headerCheck.TrustedCheckpoint {
+	return headerCheck.TrustedCheckpoint{
+		Epoch:         epoch,
+		MetaBlockHash: hex.EncodeToString(chain.epochs[epoch].hash),
+	}
+}
+
+func createGenesisNodes() *sdkData.GenesisNodes {
+	return &sdkData.GenesisNodes{
+		Eligible: map[uint32][]string{
+			0:                     {"genesis validator 0"},
+			1:                     {"genesis validator 1"},
+			core.MetachainShardId: {"genesis validator meta"},
+		},
+		Waiting: map[uint32][]string{},
+	}
+}
+
+func createGenesisCheckpoint(tb testing.TB) headerCheck.TrustedCheckpoint {
+	genesisNodesHash, err := headerCheck.ComputeGenesisNodesConfigHash(testHasher, createGenesisNodes())
+	require.Nil(tb, err)
+
+	return headerCheck.TrustedCheckpoint{
+		GenesisNodesConfigHash: hex.EncodeToString(genesisNodesHash),
+	}
+}
+
+func createMockArgsLightClient(chain *testChain, checkpointEpoch uint32) headerCheck.ArgsLightClient {
+	return headerCheck.ArgsLightClient{
+		HeaderHandler:     chain.createHeaderHandler(),
+		HeaderSigVerifier: &testsCommon.HeaderSigVerifierStub{},
+		NodesCoordinator:  &testsCommon.NodesCoordinatorStub{},
+		Marshaller:        testMarshaller,
+		Hasher:            testHasher,
+		Checkpoint:        chain.checkpoint(checkpointEpoch),
+		GenesisNodes:      createGenesisNodes(),
+	}
+}
+
+func TestNewLightClient(t *testing.T) {
+	t.Parallel()
+
+	chain := createTestChain(t, 2)
+	t.Run("nil header handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.HeaderHandler = nil
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.Equal(t, headerCheck.ErrNilEpochStartHeaderHandler, err)
+	})
+	t.Run("nil header sig verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.HeaderSigVerifier = nil
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.Equal(t, headerCheck.ErrNilHeaderSigVerifier, err)
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.NodesCoordinator = nil
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.Equal(t, headerCheck.ErrNilNodesCoordinator, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.Marshaller = nil
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.Equal(t, headerCheck.ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.Hasher = nil
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.Equal(t, headerCheck.ErrNilHasher, err)
+	})
+	t.Run("invalid checkpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.Checkpoint.MetaBlockHash = "not hex"
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidCheckpoint))
+
+		args.Checkpoint.MetaBlockHash = ""
+		lc, err = headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidCheckpoint))
+	})
+	t.Run("genesis checkpoint without genesis nodes config hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.Checkpoint = headerCheck.TrustedCheckpoint{}
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidCheckpoint))
+	})
+	t.Run("genesis checkpoint with nil genesis nodes config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.Checkpoint = createGenesisCheckpoint(t)
+		args.GenesisNodes = nil
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.Equal(t, headerCheck.ErrNilGenesisNodes, err)
+	})
+	t.Run("genesis nodes config not matching the checkpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLightClient(chain, 2)
+		args.Checkpoint = createGenesisCheckpoint(t)
+		args.GenesisNodes = createGenesisNodes()
+		args.GenesisNodes.Eligible[0] = []string{"forged validator"}
+		lc, err := headerCheck.NewLightClient(args)
+		assert.True(t, check.IfNil(lc))
+		assert.True(t, errors.Is(err, headerCheck.ErrCheckpointMismatch))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lc, err := headerCheck.NewLightClient(createMockArgsLightClient(chain, 2))
+		assert.False(t, check.IfNil(lc))
+		assert.Nil(t, err)
+
+		args := createMockArgsLightClient(chain, 2)
+		args.Checkpoint = createGenesisCheckpoint(t)
+		lc, err = headerCheck.NewLightClient(args)
+		assert.False(t, check.IfNil(lc))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLightClient_SyncToEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("checkpoint mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		args := createMockArgsLightClient(chain, 2)
+		args.Checkpoint.MetaBlockHash = hex.EncodeToString(chain.epochs[3].hash)
+		lc, _ := headerCheck.NewLightClient(args)
+
+		err := lc.SyncToEpoch(context.Background(), 3)
+		assert.True(t, errors.Is(err, headerCheck.ErrCheckpointMismatch))
+	})
+	t.Run("epoch before checkpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		lc, _ := headerCheck.NewLightClient(createMockArgsLightClient(chain, 2))
+
+		err := lc.SyncToEpoch(context.Background(), 1)
+		assert.True(t, errors.Is(err, headerCheck.ErrEpochBeforeCheckpoint))
+	})
+	t.Run("not a start of epoch metablock should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		chain.epochs[3].metaBlock.EpochStart.LastFinalizedHeaders = nil
+		lc, _ := headerCheck.NewLightClient(createMockArgsLightClient(chain, 2))
+
+		err := lc.SyncToEpoch(context.Background(), 3)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidEpochStartBlock))
+		assert.Equal(t, uint32(2), lc.LatestVerifiedEpoch())
+	})
+	t.Run("altered validators info should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 4)
+		chain.epochs[3].validatorsInfo[1] = &state.ShardValidatorInfo{PublicKey: []byte("injected validator")}
+		lc, _ := headerCheck.NewLightClient(createMockArgsLightClient(chain, 2))
+
+		err := lc.SyncToEpoch(context.Background(), 4)
+		assert.True(t, errors.Is(err, headerCheck.ErrValidatorsInfoMismatch))
+		assert.Equal(t, uint32(2), lc.LatestVerifiedEpoch())
+	})
+	t.Run("missing validators info should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 2)
+		chain.epochs[2].validatorsInfo = chain.epochs[2].validatorsInfo[1:]
+		lc, _ := headerCheck.NewLightClient(createMockArgsLightClient(chain, 2))
+
+		err := lc.SyncToEpoch(context.Background(), 2)
+		assert.True(t, errors.Is(err, headerCheck.ErrValidatorsInfoMismatch))
+	})
+	t.Run("altered miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		chain.epochs[3].miniBlock.TxHashes[0] = []byte("altered")
+		lc, _ := headerCheck.NewLightClient(createMockArgsLightClient(chain, 2))
+
+		err := lc.SyncToEpoch(context.Background(), 3)
+		assert.True(t, errors.Is(err, headerCheck.ErrMiniBlockHashMismatch))
+	})
+	t.Run("broken epoch chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		chain.epochs[3].metaBlock.EpochStart.Economics.PrevEpochStartHash = []byte("other hash")
+		lc, _ := headerCheck.NewLightClient(createMockArgsLightClient(chain, 2))
+
+		err := lc.SyncToEpoch(context.Background(), 3)
+		assert.True(t, errors.Is(err, headerCheck.ErrBrokenEpochChain))
+	})
+	t.Run("invalid start of epoch signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 4)
+		expectedErr := errors.New("expected error")
+		args := createMockArgsLightClient(chain, 2)
+		args.HeaderSigVerifier = &testsCommon.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				if header.GetEpoch() == 4 {
+					return expectedErr
+				}
+				return nil
+			},
+		}
+		lc, _ := headerCheck.NewLightClient(args)
+
+		err := lc.SyncToEpoch(context.Background(), 4)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidEpochStartSignature))
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Equal(t, uint32(3), lc.LatestVerifiedEpoch())
+	})
+	t.Run("should verify each epoch transition from the checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 5)
+		args := createMockArgsLightClient(chain, 2)
+		verifiedSignatures := make([]uint32, 0)
+		args.HeaderSigVerifier = &testsCommon.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				verifiedSignatures = append(verifiedSignatures, header.GetEpoch())
+				return nil
+			},
+		}
+		configuredEpochs := make(map[uint32][]*state.ShardValidatorInfo)
+		args.NodesCoordinator = &testsCommon.NodesCoordinatorStub{
+			SetNodesConfigFromValidatorsInfoCalled: func(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) error {
+				assert.Equal(t, chain.epochs[epoch].metaBlock.PrevRandSeed, randomness)
+				configuredEpochs[epoch] = validatorsInfo
+				return nil
+			},
+			IsEpochInConfigCalled: func(epoch uint32) bool {
+				_, found := configuredEpochs[epoch]
+				return found
+			},
+		}
+		lc, _ := headerCheck.NewLightClient(args)
+
+		err := lc.SyncToEpoch(context.Background(), 5)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(5), lc.LatestVerifiedEpoch())
+		assert.Equal(t, []uint32{3, 4, 5}, verifiedSignatures)
+		assert.Equal(t, 4, len(configuredEpochs))
+		assert.Equal(t, chain.epochs[4].validatorsInfo, configuredEpochs[4])
+
+		err = lc.SyncToEpoch(context.Background(), 4)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{3, 4, 5}, verifiedSignatures)
+	})
+	t.Run("should work from genesis", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 2)
+		args := createMockArgsLightClient(chain, 1)
+		args.Checkpoint = createGenesisCheckpoint(t)
+		args.NodesCoordinator = &testsCommon.NodesCoordinatorStub{
+			IsEpochInConfigCalled: func(epoch uint32) bool {
+				return true
+			},
+		}
+		lc, _ := headerCheck.NewLightClient(args)
+
+		err := lc.SyncToEpoch(context.Background(), 2)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), lc.LatestVerifiedEpoch())
+	})
+}
+
+func TestLightClient_VerifyHeaderSignatureByHash(t *testing.T) {
+	t.Parallel()
+
+	shardHeader := &block.Header{
+		Nonce:    1234,
+		Epoch:    3,
+		ShardID:  1,
+		RandSeed: []byte("rand seed"),
+	}
+
+	t.Run("header hash mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		args := createMockArgsLightClient(chain, 2)
+		headerHandler := chain.createHeaderHandler()
+		headerHandler.GetShardBlockByHashCalled = func(shardId uint32, hash string) (data.HeaderHandler, error) {
+			return shardHeader, nil
+		}
+		args.HeaderHandler = headerHandler
+		lc, _ := headerCheck.NewLightClient(args)
+
+		ok, err := lc.VerifyHeaderSignatureByHash(context.Background(), 1, hex.EncodeToString([]byte("other hash")))
+		assert.False(t, ok)
+		assert.True(t, errors.Is(err, headerCheck.ErrHeaderHashMismatch))
+		assert.Equal(t, uint32(0), lc.LatestVerifiedEpoch())
+	})
+	t.Run("should sync, restore the evicted config and verify", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t, 3)
+		args := createMockArgsLightClient(chain, 2)
+		headerHandler := chain.createHeaderHandler()
+		headerHandler.GetShardBlockByHashCalled = func(shardId uint32, hash string) (data.HeaderHandler, error) {
+			return shardHeader, nil
+		}
+		headerHandler.GetMetaBlockByHashCalled = func(hash string) (data.MetaHeaderHandler, error) {
+			return chain.epochs[3].metaBlock, nil
+		}
+		args.HeaderHandler = headerHandler
+
+		configuredEpochs := make(map[uint32]int)
+		evictedEpoch := uint32(2)
+		args.NodesCoordinator = &testsCommon.NodesCoordinatorStub{
+			SetNodesConfigFromValidatorsInfoCalled: func(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) error {
+				configuredEpochs[epoch]++
+				return nil
+			},
+			IsEpochInConfigCalled: func(epoch uint32) bool {
+				return epoch != evictedEpoch
+			},
+		}
+		verifiedHeaders := make([]data.HeaderHandler, 0)
+		args.HeaderSigVerifier = &testsCommon.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				verifiedHeaders = append(verifiedHeaders, header)
+				return nil
+			},
+		}
+		lc, _ := headerCheck.NewLightClient(args)
+
+		ok, err := lc.VerifyHeaderSignatureByHash(context.Background(), 1, hex.EncodeToString(calculateHash(t, shardHeader)))
+		assert.True(t, ok)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(3), lc.LatestVerifiedEpoch())
+		assert.Equal(t, []data.HeaderHandler{chain.epochs[3].metaBlock, shardHeader}, verifiedHeaders)
+		assert.Equal(t, 2, configuredEpochs[2])
+
+		ok, err = lc.VerifyHeaderSignatureByHash(context.Background(), core.MetachainShardId, hex.EncodeToString(chain.epochs[3].hash))
+		assert.True(t, ok)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, configuredEpochs[2])
+	})
+}
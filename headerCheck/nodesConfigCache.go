@@ -0,0 +1,252 @@
+package headerCheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	nodesConfigFilePattern = "nodesConfig_epoch_%d.json"
+	nodesConfigFileMode    = 0644
+	nodesConfigDirMode     = 0755
+)
+
+// ArgsPersistentNodesConfigCache holds the arguments needed to create a new persistent nodes config cache
+type ArgsPersistentNodesConfigCache struct {
+	Directory string
+	// EvictionWindow is the number of epochs kept in the cache, the least recently used ones being evicted first
+	EvictionWindow uint32
+}
+
+// persistentNodesConfigCache keeps the nodes config of the most recently used epochs in memory and on disk, one file
+// per epoch, so that a restarted verifier does not need to fetch the epoch start data for the known epochs again.
+// Epochs are evicted by least recent use and not by epoch number, so the old epochs verified by an archival verifier
+// are kept as well
+type persistentNodesConfigCache struct {
+	directory      string
+	evictionWindow uint32
+
+	mut        sync.Mutex
+	configs    map[uint32]*data.EpochNodesConfig
+	lastUses   map[uint32]uint64
+	useCounter uint64
+}
+
+// NewPersistentNodesConfigCache creates a new persistent nodes config cache, reloading the configs already stored
+// in the provided directory
+func NewPersistentNodesConfigCache(args ArgsPersistentNodesConfigCache) (*persistentNodesConfigCache, error) {
+	if len(args.Directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if args.EvictionWindow == 0 {
+		return nil, ErrInvalidEvictionWindow
+	}
+
+	err := os.MkdirAll(args.Directory, nodesConfigDirMode)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &persistentNodesConfigCache{
+		directory:      args.Directory,
+		evictionWindow: args.EvictionWindow,
+		configs:        make(map[uint32]*data.EpochNodesConfig),
+		lastUses:       make(map[uint32]uint64),
+	}
+
+	err = cache.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+// reload loads the stored configs, ordering their use by the modification time of the files
+func (cache *persistentNodesConfigCache) reload() error {
+	filenames, err := filepath.Glob(filepath.Join(cache.directory, "nodesConfig_epoch_*.json"))
+	if err != nil {
+		return err
+	}
+
+	modTimes := make(map[uint32]time.Time)
+	for _, filename := range filenames {
+		var epoch uint32
+		_, err = fmt.Sscanf(filepath.Base(filename), nodesConfigFilePattern, &epoch)
+		if err != nil {
+			log.Warn("ignoring nodes config file", "file", filename, "error", err)
+			continue
+		}
+
+		config, errLoad := loadNodesConfig(filename)
+		if errLoad != nil || config.Epoch != epoch {
+			log.Warn("ignoring invalid nodes config file", "file", filename, "error", errLoad)
+			continue
+		}
+
+		info, errStat := os.Stat(filename)
+		if errStat != nil {
+			log.Warn("ignoring nodes config file", "file", filename, "error", errStat)
+			continue
+		}
+
+		cache.configs[epoch] = config
+		modTimes[epoch] = info.ModTime()
+	}
+
+	epochs := cache.sortedEpochs()
+	sort.SliceStable(epochs, func(i, j int) bool {
+		return modTimes[epochs[i]].Before(modTimes[epochs[j]])
+	})
+	for _, epoch := range epochs {
+		cache.markUsed(epoch)
+	}
+
+	cache.evictLeastRecentlyUsedEpochs()
+	log.Debug("reloaded nodes config cache", "directory", cache.directory, "num epochs", len(cache.configs))
+
+	return nil
+}
+
+func loadNodesConfig(filename string) (*data.EpochNodesConfig, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &data.EpochNodesConfig{}
+	err = json.Unmarshal(buff, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// Get returns the nodes config of the provided epoch, if cached
+func (cache *persistentNodesConfigCache) Get(epoch uint32) (*data.EpochNodesConfig, bool) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	config, found := cache.configs[epoch]
+	if found {
+		cache.markUsed(epoch)
+		cache.touchFile(epoch)
+	}
+
+	return config, found
+}
+
+// Put stores the nodes config of an epoch and evicts the least recently used epochs that are out of the eviction window
+func (cache *persistentNodesConfigCache) Put(config *data.EpochNodesConfig) error {
+	if config == nil {
+		return ErrNilEpochNodesConfig
+	}
+
+	buff, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	err = writeFileAtomically(cache.filename(config.Epoch), buff)
+	if err != nil {
+		return err
+	}
+
+	cache.configs[config.Epoch] = config
+	cache.markUsed(config.Epoch)
+	cache.evictLeastRecentlyUsedEpochs()
+
+	return nil
+}
+
+// Epochs returns the cached epochs, sorted ascending
+func (cache *persistentNodesConfigCache) Epochs() []uint32 {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	return cache.sortedEpochs()
+}
+
+func (cache *persistentNodesConfigCache) sortedEpochs() []uint32 {
+	epochs := make([]uint32, 0, len(cache.configs))
+	for epoch := range cache.configs {
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs
+}
+
+func (cache *persistentNodesConfigCache) markUsed(epoch uint32) {
+	cache.useCounter++
+	cache.lastUses[epoch] = cache.useCounter
+}
+
+// touchFile refreshes the modification time of the epoch file, so the use order is kept across restarts
+func (cache *persistentNodesConfigCache) touchFile(epoch uint32) {
+	now := time.Now()
+	err := os.Chtimes(cache.filename(epoch), now, now)
+	if err != nil {
+		log.Warn("could not refresh the nodes config file", "epoch", epoch, "error", err)
+	}
+}
+
+func (cache *persistentNodesConfigCache) evictLeastRecentlyUsedEpochs() {
+	epochs := cache.sortedEpochs()
+	if uint32(len(epochs)) <= cache.evictionWindow {
+		return
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return cache.lastUses[epochs[i]] < cache.lastUses[epochs[j]]
+	})
+	numEvicted := uint32(len(epochs)) - cache.evictionWindow
+	for _, epoch := range epochs[:numEvicted] {
+		delete(cache.configs, epoch)
+		delete(cache.lastUses, epoch)
+
+		err := os.Remove(cache.filename(epoch))
+		if err != nil && !os.IsNotExist(err) {
+			log.Warn("could not remove evicted nodes config file", "epoch", epoch, "error", err)
+		}
+	}
+}
+
+func (cache *persistentNodesConfigCache) filename(epoch uint32) string {
+	return filepath.Join(cache.directory, fmt.Sprintf(nodesConfigFilePattern, epoch))
+}
+
+func writeFileAtomically(filename string, buff []byte) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tempFilename := tempFile.Name()
+	_ = tempFile.Close()
+
+	err = os.WriteFile(tempFilename, buff, nodesConfigFileMode)
+	if err != nil {
+		_ = os.Remove(tempFilename)
+		return err
+	}
+
+	return os.Rename(tempFilename, filename)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *persistentNodesConfigCache) IsInterfaceNil() bool {
+	return cache == nil
+}
@@ -0,0 +1,204 @@
+package headerCheck_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/headerCheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEpochNodesConfig(epoch uint32) *data.EpochNodesConfig {
+	return &data.EpochNodesConfig{
+		Epoch:      epoch,
+		Randomness: []byte("randomness"),
+		ValidatorsInfo: []*state.ShardValidatorInfo{
+			{PublicKey: []byte("pk1"), ShardId: 0, List: "eligible", Index: 1, TempRating: 5000000},
+			{PublicKey: []byte("pk2"), ShardId: 1, List: "waiting", Index: 2, TempRating: 4000000},
+		},
+	}
+}
+
+func TestNewPersistentNodesConfigCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			EvictionWindow: 2,
+		})
+		assert.True(t, check.IfNil(cache))
+		assert.Equal(t, headerCheck.ErrEmptyDirectory, err)
+	})
+	t.Run("zero eviction window should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory: t.TempDir(),
+		})
+		assert.True(t, check.IfNil(cache))
+		assert.Equal(t, headerCheck.ErrInvalidEvictionWindow, err)
+	})
+	t.Run("should create the missing directory", func(t *testing.T) {
+		t.Parallel()
+
+		directory := filepath.Join(t.TempDir(), "nodesConfig")
+		cache, err := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      directory,
+			EvictionWindow: 2,
+		})
+		assert.False(t, check.IfNil(cache))
+		assert.Nil(t, err)
+		assert.DirExists(t, directory)
+	})
+}
+
+func TestPersistentNodesConfigCache_PutGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      t.TempDir(),
+			EvictionWindow: 2,
+		})
+
+		err := cache.Put(nil)
+		assert.Equal(t, headerCheck.ErrNilEpochNodesConfig, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      t.TempDir(),
+			EvictionWindow: 2,
+		})
+
+		config, found := cache.Get(3)
+		assert.Nil(t, config)
+		assert.False(t, found)
+
+		err := cache.Put(createEpochNodesConfig(3))
+		require.Nil(t, err)
+
+		config, found = cache.Get(3)
+		assert.True(t, found)
+		assert.Equal(t, createEpochNodesConfig(3), config)
+	})
+}
+
+func TestPersistentNodesConfigCache_Reload(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	args := headerCheck.ArgsPersistentNodesConfigCache{
+		Directory:      directory,
+		EvictionWindow: 3,
+	}
+	cache, _ := headerCheck.NewPersistentNodesConfigCache(args)
+	require.Nil(t, cache.Put(createEpochNodesConfig(7)))
+	require.Nil(t, cache.Put(createEpochNodesConfig(8)))
+
+	err := os.WriteFile(filepath.Join(directory, "nodesConfig_epoch_9.json"), []byte("not json"), 0644)
+	require.Nil(t, err)
+	err = os.WriteFile(filepath.Join(directory, "nodesConfig_epoch_10.json"), []byte(`{"epoch":11}`), 0644)
+	require.Nil(t, err)
+
+	reloadedCache, err := headerCheck.NewPersistentNodesConfigCache(args)
+	require.Nil(t, err)
+	assert.Equal(t, []uint32{7, 8}, reloadedCache.Epochs())
+
+	config, found := reloadedCache.Get(8)
+	assert.True(t, found)
+	assert.Equal(t, createEpochNodesConfig(8), config)
+}
+
+func TestPersistentNodesConfigCache_Eviction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("put should evict the least recently used epochs", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		cache, _ := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      directory,
+			EvictionWindow: 2,
+		})
+		for _, epoch := range []uint32{4, 2, 3, 5} {
+			require.Nil(t, cache.Put(createEpochNodesConfig(epoch)))
+		}
+
+		assert.Equal(t, []uint32{3, 5}, cache.Epochs())
+		_, found := cache.Get(4)
+		assert.False(t, found)
+		assert.NoFileExists(t, filepath.Join(directory, "nodesConfig_epoch_2.json"))
+		assert.NoFileExists(t, filepath.Join(directory, "nodesConfig_epoch_4.json"))
+		assert.FileExists(t, filepath.Join(directory, "nodesConfig_epoch_3.json"))
+		assert.FileExists(t, filepath.Join(directory, "nodesConfig_epoch_5.json"))
+	})
+	t.Run("get should refresh the use of an epoch", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      t.TempDir(),
+			EvictionWindow: 2,
+		})
+		require.Nil(t, cache.Put(createEpochNodesConfig(1)))
+		require.Nil(t, cache.Put(createEpochNodesConfig(2)))
+		_, found := cache.Get(1)
+		require.True(t, found)
+		require.Nil(t, cache.Put(createEpochNodesConfig(3)))
+
+		assert.Equal(t, []uint32{1, 3}, cache.Epochs())
+	})
+	t.Run("epoch older than the cached ones should be kept across restarts", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		args := headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      directory,
+			EvictionWindow: 2,
+		}
+		cache, _ := headerCheck.NewPersistentNodesConfigCache(args)
+		for _, epoch := range []uint32{10, 11, 5} {
+			require.Nil(t, cache.Put(createEpochNodesConfig(epoch)))
+		}
+
+		assert.Equal(t, []uint32{5, 11}, cache.Epochs())
+		assert.FileExists(t, filepath.Join(directory, "nodesConfig_epoch_5.json"))
+		assert.NoFileExists(t, filepath.Join(directory, "nodesConfig_epoch_10.json"))
+
+		reloadedCache, err := headerCheck.NewPersistentNodesConfigCache(args)
+		require.Nil(t, err)
+		config, found := reloadedCache.Get(5)
+		assert.True(t, found)
+		assert.Equal(t, createEpochNodesConfig(5), config)
+	})
+	t.Run("reload with a smaller window should evict the oldest epochs", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		cache, _ := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      directory,
+			EvictionWindow: 5,
+		})
+		for epoch := uint32(1); epoch <= 4; epoch++ {
+			require.Nil(t, cache.Put(createEpochNodesConfig(epoch)))
+		}
+
+		reloadedCache, err := headerCheck.NewPersistentNodesConfigCache(headerCheck.ArgsPersistentNodesConfigCache{
+			Directory:      directory,
+			EvictionWindow: 1,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []uint32{4}, reloadedCache.Epochs())
+		assert.NoFileExists(t, filepath.Join(directory, "nodesConfig_epoch_3.json"))
+	})
+}
@@ -2,6 +2,7 @@ package headerCheck
 
 import (
 	"context"
+	"encoding/hex"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -99,6 +100,29 @@ func (rh *rawHeaderHandler) GetValidatorsInfoPerEpoch(ctx context.Context, epoch
 	return validatorsInfoPerEpoch, randomness, nil
 }
 
+// GetMiniBlockByHash will return the miniblock based on the raw marshalized
+// data from proxy
+func (rh *rawHeaderHandler) GetMiniBlockByHash(ctx context.Context, shardId uint32, hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	miniBlockBytes, err := rh.proxy.GetRawMiniBlockByHash(ctx, shardId, hex.EncodeToString(hash), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = rh.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+// GetValidatorsInfoByEpoch will return the validators info for a specific
+// epoch, without any other check
+func (rh *rawHeaderHandler) GetValidatorsInfoByEpoch(ctx context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error) {
+	return rh.proxy.GetValidatorsInfoByEpoch(ctx, epoch)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rh *rawHeaderHandler) IsInterfaceNil() bool {
 	return rh == nil
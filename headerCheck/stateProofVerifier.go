@@ -0,0 +1,311 @@
+package headerCheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/trie"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// leafNodeType is the suffix of an encoded trie leaf node
+const leafNodeType = byte(1)
+
+// ArgsStateProofVerifier holds all dependencies required by stateProofVerifier in order to create a new instance
+type ArgsStateProofVerifier struct {
+	Proxy          StateProofProvider
+	HeaderHandler  RawHeaderHandler
+	HeaderVerifier HeaderVerifier
+	Marshaller     marshal.Marshalizer
+	Hasher         hashing.Hasher
+}
+
+type merkleProofVerifier interface {
+	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+}
+
+// stateProofVerifier verifies accounts and data trie values against the state root hash of a header whose
+// signature is verified, using the Merkle proofs provided by the proxy
+type stateProofVerifier struct {
+	proxy         StateProofProvider
+	headerFetcher *verifiedHeaderFetcher
+	proofVerifier merkleProofVerifier
+	marshaller    marshal.Marshalizer
+}
+
+// NewStateProofVerifier creates a new instance of type stateProofVerifier
+func NewStateProofVerifier(args ArgsStateProofVerifier) (*stateProofVerifier, error) {
+	err := checkStateProofVerifierArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	proofVerifier, err := trie.NewMerkleProofVerifier(args.Marshaller, args.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateProofVerifier{
+		proxy:         args.Proxy,
+		headerFetcher: newVerifiedHeaderFetcher(args.HeaderHandler, args.HeaderVerifier, args.Marshaller, args.Hasher),
+		proofVerifier: proofVerifier,
+		marshaller:    args.Marshaller,
+	}, nil
+}
+
+func checkStateProofVerifierArgs(args ArgsStateProofVerifier) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilStateProofProvider
+	}
+	if check.IfNil(args.HeaderHandler) {
+		return ErrNilRawHeaderHandler
+	}
+	if check.IfNil(args.HeaderVerifier) {
+		return ErrNilHeaderVerifier
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	return nil
+}
+
+// VerifyAccount returns the account state proven against the root hash of the provided header
+func (spv *stateProofVerifier) VerifyAccount(
+	ctx context.Context,
+	shardId uint32,
+	headerHash string,
+	address sdkCore.AddressHandler,
+) (*data.Account, error) {
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+
+	rootHash, err := spv.getVerifiedRootHash(ctx, shardId, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := spv.proxy.GetProof(ctx, rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	accountData, err := spv.verifyAccountProof(rootHash, address.AddressBytes(), proof.Proof, proof.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("verified account state", "address", address.AddressBytes(), "header", headerHash)
+
+	return accountDataToAccount(accountData)
+}
+
+// VerifyStorageValue returns the value of the provided key from the account's data trie, proven against the root
+// hash of the provided header
+func (spv *stateProofVerifier) VerifyStorageValue(
+	ctx context.Context,
+	shardId uint32,
+	headerHash string,
+	address sdkCore.AddressHandler,
+	key []byte,
+) ([]byte, error) {
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+	if len(key) == 0 {
+		return nil, ErrEmptyKey
+	}
+
+	rootHash, err := spv.getVerifiedRootHash(ctx, shardId, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := spv.proxy.GetProofDataTrie(ctx, rootHash, address, key)
+	if err != nil {
+		return nil, err
+	}
+
+	accountData, err := spv.verifyAccountProof(rootHash, address.AddressBytes(), proof.Proofs.MainProof, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(accountData.RootHash) == 0 {
+		return nil, fmt.Errorf("%w: the account has an empty data trie", ErrInvalidProof)
+	}
+
+	leafValue, leafVersion, err := spv.verifyMerkleProof(accountData.RootHash, key, proof.Proofs.DataTrieProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w in data trie", err)
+	}
+
+	value, err := spv.parseDataTrieLeaf(leafValue, leafVersion, key, address.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+	if proof.Value != hex.EncodeToString(value) {
+		return nil, fmt.Errorf("%w: reported %s, proven %s", ErrProofValueMismatch, proof.Value, hex.EncodeToString(value))
+	}
+
+	return value, nil
+}
+
+func (spv *stateProofVerifier) getVerifiedRootHash(ctx context.Context, shardId uint32, headerHash string) ([]byte, error) {
+	header, err := spv.headerFetcher.fetchVerifiedHeader(ctx, shardId, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRootHash(), nil
+}
+
+// verifyAccountProof verifies the account proof from the state trie and decodes the proven account. The value
+// reported by the proxy, if provided, should match the proven one
+func (spv *stateProofVerifier) verifyAccountProof(
+	rootHash []byte,
+	address []byte,
+	hexProof []string,
+	reportedValue string,
+) (*accounts.UserAccountData, error) {
+	leafValue, _, err := spv.verifyMerkleProof(rootHash, address, hexProof)
+	if err != nil {
+		return nil, err
+	}
+	if len(reportedValue) > 0 && reportedValue != hex.EncodeToString(leafValue) {
+		return nil, fmt.Errorf("%w for account %s", ErrProofValueMismatch, hex.EncodeToString(address))
+	}
+
+	accountData := &accounts.UserAccountData{}
+	err = spv.marshaller.Unmarshal(accountData, leafValue)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(accountData.Address, address) {
+		return nil, fmt.Errorf("%w: proven account %s, requested %s", ErrInvalidProof,
+			hex.EncodeToString(accountData.Address), hex.EncodeToString(address))
+	}
+
+	return accountData, nil
+}
+
+// verifyMerkleProof verifies the proof and returns the value and the version of the proven leaf. The proof should
+// end with the leaf, so that the proven leaf is the last node of the proof
+func (spv *stateProofVerifier) verifyMerkleProof(rootHash []byte, key []byte, hexProof []string) ([]byte, core.TrieNodeVersion, error) {
+	if len(hexProof) == 0 {
+		return nil, 0, fmt.Errorf("%w: empty proof", ErrInvalidProof)
+	}
+
+	proof := make([][]byte, 0, len(hexProof))
+	for i, hexNode := range hexProof {
+		encodedNode, err := hex.DecodeString(hexNode)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(encodedNode) == 0 {
+			return nil, 0, fmt.Errorf("%w: empty node", ErrInvalidProof)
+		}
+
+		isLeaf := encodedNode[len(encodedNode)-1] == leafNodeType
+		isLastNode := i == len(hexProof)-1
+		if isLeaf != isLastNode {
+			return nil, 0, fmt.Errorf("%w: the proof should end with the leaf node", ErrInvalidProof)
+		}
+
+		proof = append(proof, encodedNode)
+	}
+
+	ok, err := spv.proofVerifier.VerifyProof(rootHash, key, proof)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrInvalidProof, err.Error())
+	}
+	if !ok {
+		return nil, 0, fmt.Errorf("%w for key %s", ErrInvalidProof, hex.EncodeToString(key))
+	}
+
+	leafNode := proof[len(proof)-1]
+	leaf := &trie.CollapsedLn{}
+	err = spv.marshaller.Unmarshal(leaf, leafNode[:len(leafNode)-1])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return leaf.Value, core.TrieNodeVersion(leaf.Version), nil
+}
+
+// parseDataTrieLeaf extracts the value from a data trie leaf. Auto balanced leaves hold the serialized value, key
+// and address, while the older leaves hold the value followed by the key and the address
+func (spv *stateProofVerifier) parseDataTrieLeaf(leafValue []byte, version core.TrieNodeVersion, key []byte, address []byte) ([]byte, error) {
+	if version == core.AutoBalanceEnabled {
+		leafData := &dataTrieValue.TrieLeafData{}
+		err := spv.marshaller.Unmarshal(leafData, leafValue)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(leafData.Key, key) || !bytes.Equal(leafData.Address, address) {
+			return nil, fmt.Errorf("%w: data trie leaf does not belong to the requested key", ErrInvalidProof)
+		}
+
+		return leafData.Value, nil
+	}
+
+	suffix := append(append(make([]byte, 0, len(key)+len(address)), key...), address...)
+	if !bytes.HasSuffix(leafValue, suffix) {
+		return nil, fmt.Errorf("%w: data trie leaf does not belong to the requested key", ErrInvalidProof)
+	}
+
+	return leafValue[:len(leafValue)-len(suffix)], nil
+}
+
+func accountDataToAccount(accountData *accounts.UserAccountData) (*data.Account, error) {
+	bech32Address, err := data.NewAddressFromBytes(accountData.Address).AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddress := ""
+	if len(accountData.OwnerAddress) > 0 {
+		ownerAddress, err = data.NewAddressFromBytes(accountData.OwnerAddress).AddressAsBech32String()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data.Account{
+		Address:         bech32Address,
+		Nonce:           accountData.Nonce,
+		Balance:         bigIntToString(accountData.Balance),
+		CodeHash:        accountData.CodeHash,
+		RootHash:        accountData.RootHash,
+		CodeMetadata:    accountData.CodeMetadata,
+		Username:        string(accountData.UserName),
+		DeveloperReward: bigIntToString(accountData.DeveloperReward),
+		OwnerAddress:    ownerAddress,
+	}, nil
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (spv *stateProofVerifier) IsInterfaceNil() bool {
+	return spv == nil
+}
@@ -0,0 +1,509 @@
+package headerCheck_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/trie"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/headerCheck"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testProofAddress = "erd1lta2vgd0tkeqqadkvgef73y0efs6n3xe5ss589ufhvmt6tcur8kq34qkwr"
+	testOwnerAddress = "erd1p5jgz605m47fq5mlqklpcjth9hdl3au53dg8a5tlkgegfnep3d7stdk09x"
+
+	leafNodeType   = byte(1)
+	branchNodeType = byte(2)
+	hexTerminator  = byte(16)
+)
+
+var (
+	testAutoBalancedKey = []byte("auto balanced key")
+	testLegacyKey       = []byte("key2")
+)
+
+// keyBytesToHex transforms the key into the reversed hex nibbles used by the trie
+func keyBytesToHex(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2+1)
+	for i := len(key) - 1; i >= 0; i-- {
+		nibbles = append(nibbles, key[i]&0x0f, key[i]>>4)
+	}
+
+	return append(nibbles, hexTerminator)
+}
+
+func encodeNode(tb testing.TB, node interface{}, nodeType byte) []byte {
+	encodedNode, err := testMarshaller.Marshal(node)
+	require.Nil(tb, err)
+
+	return append(encodedNode, nodeType)
+}
+
+type testTrie struct {
+	rootHash []byte
+	proofs   map[string][]string
+}
+
+// createTestTrie creates a trie having a branch node as root, each leaf being a child of the root. The keys
+// should start with different nibbles
+func createTestTrie(tb testing.TB, leaves map[string]*trie.CollapsedLn) *testTrie {
+	branch := &trie.CollapsedBn{
+		EncodedChildren: make([][]byte, 17),
+	}
+	encodedLeaves := make(map[string][]byte)
+	for key, leaf := range leaves {
+		hexKey := keyBytesToHex([]byte(key))
+		require.Nil(tb, branch.EncodedChildren[hexKey[0]], "keys should start with different nibbles")
+
+		leafCopy := *leaf
+		leafCopy.Key = hexKey[1:]
+		encodedLeaf := encodeNode(tb, &leafCopy, leafNodeType)
+		branch.EncodedChildren[hexKey[0]] = testHasher.Compute(string(encodedLeaf))
+		encodedLeaves[key] = encodedLeaf
+	}
+
+	encodedBranch := encodeNode(tb, branch, branchNodeType)
+	result := &testTrie{
+		rootHash: testHasher.Compute(string(encodedBranch)),
+		proofs:   make(map[string][]string),
+	}
+	for key, encodedLeaf := range encodedLeaves {
+		result.proofs[key] = []string{hex.EncodeToString(encodedBranch), hex.EncodeToString(encodedLeaf)}
+	}
+
+	return result
+}
+
+type stateFixture struct {
+	address       sdkCore.AddressHandler
+	headers       map[string]*block.Header
+	headerHash    string
+	stateTrie     *testTrie
+	accountBytes  []byte
+	dataTrie      *testTrie
+	storageValues map[string][]byte
+}
+
+func createStateFixture(tb testing.TB) *stateFixture {
+	address, err := data.NewAddressFromBech32String(testProofAddress)
+	require.Nil(tb, err)
+	owner, err := data.NewAddressFromBech32String(testOwnerAddress)
+	require.Nil(tb, err)
+
+	fixture := &stateFixture{
+		address: address,
+		headers: make(map[string]*block.Header),
+		storageValues: map[string][]byte{
+			string(testAutoBalancedKey): []byte("auto balanced value"),
+			string(testLegacyKey):       []byte("legacy value"),
+		},
+	}
+
+	autoBalancedLeafData := &dataTrieValue.TrieLeafData{
+		Value:   fixture.storageValues[string(testAutoBalancedKey)],
+		Key:     testAutoBalancedKey,
+		Address: address.AddressBytes(),
+	}
+	autoBalancedLeafValue, err := testMarshaller.Marshal(autoBalancedLeafData)
+	require.Nil(tb, err)
+	legacyLeafValue := append(append([]byte{}, fixture.storageValues[string(testLegacyKey)]...), testLegacyKey...)
+	legacyLeafValue = append(legacyLeafValue, address.AddressBytes()...)
+	fixture.dataTrie = createTestTrie(tb, map[string]*trie.CollapsedLn{
+		string(testHasher.Compute(string(testAutoBalancedKey))): {
+			Value:   autoBalancedLeafValue,
+			Version: uint32(core.AutoBalanceEnabled),
+		},
+		string(testLegacyKey): {
+			Value: legacyLeafValue,
+		},
+	})
+
+	accountData := &accounts.UserAccountData{
+		Nonce:           37,
+		Balance:         big.NewInt(1000),
+		CodeHash:        []byte("code hash"),
+		RootHash:        fixture.dataTrie.rootHash,
+		Address:         address.AddressBytes(),
+		DeveloperReward: big.NewInt(10),
+		OwnerAddress:    owner.AddressBytes(),
+		UserName:        []byte("alice.elrond"),
+		CodeMetadata:    []byte{5, 0},
+	}
+	fixture.accountBytes, err = testMarshaller.Marshal(accountData)
+	require.Nil(tb, err)
+
+	otherAccountBytes, err := testMarshaller.Marshal(&accounts.UserAccountData{Address: owner.AddressBytes()})
+	require.Nil(tb, err)
+
+	fixture.stateTrie = createTestTrie(tb, map[string]*trie.CollapsedLn{
+		string(testHasher.Compute(string(address.AddressBytes()))): {Value: fixture.accountBytes},
+		string(testHasher.Compute(string(owner.AddressBytes()))):   {Value: otherAccountBytes},
+	})
+
+	header := &block.Header{
+		Nonce:    100,
+		ShardID:  1,
+		RootHash: fixture.stateTrie.rootHash,
+	}
+	fixture.headerHash = hex.EncodeToString(calculateHash(tb, header))
+	fixture.headers[fixture.headerHash] = header
+
+	return fixture
+}
+
+func (fixture *stateFixture) accountKey(address sdkCore.AddressHandler) string {
+	return string(testHasher.Compute(string(address.AddressBytes())))
+}
+
+func (fixture *stateFixture) dataTrieKey(key []byte) string {
+	if bytes.Equal(key, testAutoBalancedKey) {
+		return string(testHasher.Compute(string(key)))
+	}
+
+	return string(key)
+}
+
+func (fixture *stateFixture) createProxy() *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetProofCalled: func(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler) (*data.AccountProof, error) {
+			if !bytes.Equal(rootHash, fixture.stateTrie.rootHash) {
+				return nil, errors.New("unknown root hash")
+			}
+
+			return &data.AccountProof{
+				Proof: fixture.stateTrie.proofs[fixture.accountKey(address)],
+				Value: hex.EncodeToString(fixture.accountBytes),
+			}, nil
+		},
+		GetProofDataTrieCalled: func(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler, key []byte) (*data.DataTrieProof, error) {
+			if !bytes.Equal(rootHash, fixture.stateTrie.rootHash) {
+				return nil, errors.New("unknown root hash")
+			}
+
+			proof := &data.DataTrieProof{
+				Value:            hex.EncodeToString(fixture.storageValues[string(key)]),
+				DataTrieRootHash: hex.EncodeToString(fixture.dataTrie.rootHash),
+			}
+			proof.Proofs.MainProof = fixture.stateTrie.proofs[fixture.accountKey(address)]
+			proof.Proofs.DataTrieProof = fixture.dataTrie.proofs[fixture.dataTrieKey(key)]
+
+			return proof, nil
+		},
+	}
+}
+
+func (fixture *stateFixture) createHeaderHandler() *testsCommon.RawHeaderHandlerStub {
+	return &testsCommon.RawHeaderHandlerStub{
+		GetShardBlockByHashCalled: func(shardId uint32, hash string) (coreData.HeaderHandler, error) {
+			header, found := fixture.headers[hash]
+			if !found {
+				return nil, errors.New("header not found")
+			}
+
+			return header, nil
+		},
+	}
+}
+
+func createMockArgsStateProofVerifier(fixture *stateFixture) headerCheck.ArgsStateProofVerifier {
+	return headerCheck.ArgsStateProofVerifier{
+		Proxy:          fixture.createProxy(),
+		HeaderHandler:  fixture.createHeaderHandler(),
+		HeaderVerifier: &testsCommon.HeaderVerifierStub{},
+		Marshaller:     testMarshaller,
+		Hasher:         testHasher,
+	}
+}
+
+func TestNewStateProofVerifier(t *testing.T) {
+	t.Parallel()
+
+	fixture := createStateFixture(t)
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateProofVerifier(fixture)
+		args.Proxy = nil
+		spv, err := headerCheck.NewStateProofVerifier(args)
+		assert.True(t, check.IfNil(spv))
+		assert.Equal(t, headerCheck.ErrNilStateProofProvider, err)
+	})
+	t.Run("nil header handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateProofVerifier(fixture)
+		args.HeaderHandler = nil
+		spv, err := headerCheck.NewStateProofVerifier(args)
+		assert.True(t, check.IfNil(spv))
+		assert.Equal(t, headerCheck.ErrNilRawHeaderHandler, err)
+	})
+	t.Run("nil header verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateProofVerifier(fixture)
+		args.HeaderVerifier = nil
+		spv, err := headerCheck.NewStateProofVerifier(args)
+		assert.True(t, check.IfNil(spv))
+		assert.Equal(t, headerCheck.ErrNilHeaderVerifier, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateProofVerifier(fixture)
+		args.Marshaller = nil
+		spv, err := headerCheck.NewStateProofVerifier(args)
+		assert.True(t, check.IfNil(spv))
+		assert.Equal(t, headerCheck.ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateProofVerifier(fixture)
+		args.Hasher = nil
+		spv, err := headerCheck.NewStateProofVerifier(args)
+		assert.True(t, check.IfNil(spv))
+		assert.Equal(t, headerCheck.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		spv, err := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+		assert.False(t, check.IfNil(spv))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStateProofVerifier_VerifyAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, nil)
+		assert.Nil(t, account)
+		assert.Equal(t, headerCheck.ErrNilAddress, err)
+	})
+	t.Run("tampered header should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		fixture.headers[fixture.headerHash].RootHash = []byte("another root hash")
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, headerCheck.ErrHeaderHashMismatch))
+	})
+	t.Run("invalid header signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		args := createMockArgsStateProofVerifier(fixture)
+		args.HeaderVerifier = &testsCommon.HeaderVerifierStub{
+			VerifyHeaderCalled: func(ctx context.Context, header coreData.HeaderHandler) (bool, error) {
+				return false, nil
+			},
+		}
+		spv, _ := headerCheck.NewStateProofVerifier(args)
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidHeaderSignature))
+	})
+	t.Run("proxy error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		fixture := createStateFixture(t)
+		args := createMockArgsStateProofVerifier(fixture)
+		args.Proxy = &testsCommon.ProxyStub{
+			GetProofCalled: func(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler) (*data.AccountProof, error) {
+				return nil, expectedErr
+			},
+		}
+		spv, _ := headerCheck.NewStateProofVerifier(args)
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		assert.Nil(t, account)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("tampered leaf should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		accountData := &accounts.UserAccountData{}
+		_ = testMarshaller.Unmarshal(accountData, fixture.accountBytes)
+		accountData.Balance = big.NewInt(1000000)
+		fixture.accountBytes, _ = testMarshaller.Marshal(accountData)
+		key := fixture.accountKey(fixture.address)
+		hexKey := keyBytesToHex([]byte(key))
+		tamperedLeaf := encodeNode(t, &trie.CollapsedLn{Key: hexKey[1:], Value: fixture.accountBytes}, leafNodeType)
+		fixture.stateTrie.proofs[key][1] = hex.EncodeToString(tamperedLeaf)
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidProof))
+	})
+	t.Run("proof of another account should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		owner, _ := data.NewAddressFromBech32String(testOwnerAddress)
+		fixture.stateTrie.proofs[fixture.accountKey(fixture.address)] = fixture.stateTrie.proofs[fixture.accountKey(owner)]
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidProof))
+	})
+	t.Run("proof not ending with the leaf should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		key := fixture.accountKey(fixture.address)
+		extraLeaf := hex.EncodeToString(encodeNode(t, &trie.CollapsedLn{Key: []byte{hexTerminator}, Value: []byte("value")}, leafNodeType))
+		fixture.stateTrie.proofs[key] = append(fixture.stateTrie.proofs[key], extraLeaf)
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidProof))
+	})
+	t.Run("reported value mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		args := createMockArgsStateProofVerifier(fixture)
+		args.Proxy = &testsCommon.ProxyStub{
+			GetProofCalled: func(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler) (*data.AccountProof, error) {
+				return &data.AccountProof{
+					Proof: fixture.stateTrie.proofs[fixture.accountKey(address)],
+					Value: hex.EncodeToString([]byte("another account")),
+				}, nil
+			},
+		}
+		spv, _ := headerCheck.NewStateProofVerifier(args)
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		assert.Nil(t, account)
+		assert.True(t, errors.Is(err, headerCheck.ErrProofValueMismatch))
+	})
+	t.Run("should verify the signature of the hashed header", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		args := createMockArgsStateProofVerifier(fixture)
+		verifiedHeaders := make([]string, 0)
+		args.HeaderVerifier = &testsCommon.HeaderVerifierStub{
+			VerifyHeaderSignatureByHashCalled: func(ctx context.Context, shardId uint32, hash string) (bool, error) {
+				assert.Fail(t, "should have not fetched the header again")
+				return false, nil
+			},
+			VerifyHeaderCalled: func(ctx context.Context, header coreData.HeaderHandler) (bool, error) {
+				verifiedHeaders = append(verifiedHeaders, hex.EncodeToString(calculateHash(t, header)))
+				return true, nil
+			},
+		}
+		spv, _ := headerCheck.NewStateProofVerifier(args)
+
+		_, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		require.Nil(t, err)
+		assert.Equal(t, []string{fixture.headerHash}, verifiedHeaders)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		account, err := spv.VerifyAccount(context.Background(), 1, fixture.headerHash, fixture.address)
+		require.Nil(t, err)
+		assert.Equal(t, &data.Account{
+			Address:         testProofAddress,
+			Nonce:           37,
+			Balance:         "1000",
+			CodeHash:        []byte("code hash"),
+			RootHash:        fixture.dataTrie.rootHash,
+			CodeMetadata:    []byte{5, 0},
+			Username:        "alice.elrond",
+			DeveloperReward: "10",
+			OwnerAddress:    testOwnerAddress,
+		}, account)
+	})
+}
+
+func TestStateProofVerifier_VerifyStorageValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		value, err := spv.VerifyStorageValue(context.Background(), 1, fixture.headerHash, fixture.address, nil)
+		assert.Nil(t, value)
+		assert.Equal(t, headerCheck.ErrEmptyKey, err)
+	})
+	t.Run("proof of another key should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		fixture.dataTrie.proofs[fixture.dataTrieKey(testLegacyKey)] = fixture.dataTrie.proofs[fixture.dataTrieKey(testAutoBalancedKey)]
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		value, err := spv.VerifyStorageValue(context.Background(), 1, fixture.headerHash, fixture.address, testLegacyKey)
+		assert.Nil(t, value)
+		assert.True(t, errors.Is(err, headerCheck.ErrInvalidProof))
+	})
+	t.Run("reported value mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		fixture.storageValues[string(testLegacyKey)] = []byte("another value")
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		value, err := spv.VerifyStorageValue(context.Background(), 1, fixture.headerHash, fixture.address, testLegacyKey)
+		assert.Nil(t, value)
+		assert.True(t, errors.Is(err, headerCheck.ErrProofValueMismatch))
+	})
+	t.Run("should work for auto balanced data trie leaves", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		value, err := spv.VerifyStorageValue(context.Background(), 1, fixture.headerHash, fixture.address, testAutoBalancedKey)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("auto balanced value"), value)
+	})
+	t.Run("should work for legacy data trie leaves", func(t *testing.T) {
+		t.Parallel()
+
+		fixture := createStateFixture(t)
+		spv, _ := headerCheck.NewStateProofVerifier(createMockArgsStateProofVerifier(fixture))
+
+		value, err := spv.VerifyStorageValue(context.Background(), 1, fixture.headerHash, fixture.address, testLegacyKey)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("legacy value"), value)
+	})
+}
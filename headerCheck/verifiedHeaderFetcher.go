@@ -0,0 +1,68 @@
+package headerCheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// verifiedHeaderFetcher fetches headers from proxy, checking that they match the requested hash and that their
+// signature is valid
+type verifiedHeaderFetcher struct {
+	headerHandler  RawHeaderHandler
+	headerVerifier HeaderVerifier
+	marshaller     marshal.Marshalizer
+	hasher         hashing.Hasher
+}
+
+func newVerifiedHeaderFetcher(
+	headerHandler RawHeaderHandler,
+	headerVerifier HeaderVerifier,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) *verifiedHeaderFetcher {
+	return &verifiedHeaderFetcher{
+		headerHandler:  headerHandler,
+		headerVerifier: headerVerifier,
+		marshaller:     marshaller,
+		hasher:         hasher,
+	}
+}
+
+func (fetcher *verifiedHeaderFetcher) fetchVerifiedHeader(ctx context.Context, shardId uint32, hash string) (coreData.HeaderHandler, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := fetchHeaderByHashAndShard(ctx, fetcher.headerHandler, shardId, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	computedHash, err := core.CalculateHash(fetcher.marshaller, fetcher.hasher, header)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hashBytes, computedHash) {
+		return nil, fmt.Errorf("%w: requested %s, computed %s", ErrHeaderHashMismatch, hash, hex.EncodeToString(computedHash))
+	}
+
+	// the signature is checked on the same header instance that was hashed, so that the proxy cannot provide a
+	// different header for each check
+	ok, err := fetcher.headerVerifier.VerifyHeader(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: shard %d, header %s", ErrInvalidHeaderSignature, shardId, hash)
+	}
+
+	return header, nil
+}
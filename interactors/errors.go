@@ -37,3 +37,45 @@ var ErrGapNonce = errors.New("gap nonce detected")
 
 // ErrWorkerClosed signals that the worker is closed
 var ErrWorkerClosed = errors.New("worker closed")
+
+// ErrInvalidMnemonicWordsCount signals that the mnemonic has an invalid number of words
+var ErrInvalidMnemonicWordsCount = errors.New("invalid mnemonic words count")
+
+// ErrUnknownMnemonicWord signals that the mnemonic contains a word not found in the wordlist
+var ErrUnknownMnemonicWord = errors.New("unknown mnemonic word")
+
+// ErrInvalidMnemonicChecksum signals that the mnemonic checksum is invalid
+var ErrInvalidMnemonicChecksum = errors.New("invalid mnemonic checksum")
+
+// ErrInvalidDerivationPath signals that an invalid derivation path was provided
+var ErrInvalidDerivationPath = errors.New("invalid derivation path")
+
+// ErrNonHardenedDerivationIndex signals that a non-hardened index was provided in the derivation path
+var ErrNonHardenedDerivationIndex = errors.New("non-hardened derivation index")
+
+// ErrInvalidGapLimit signals that an invalid gap limit was provided
+var ErrInvalidGapLimit = errors.New("invalid gap limit")
+
+// ErrInvalidScryptParams signals that invalid scrypt parameters were provided
+var ErrInvalidScryptParams = errors.New("invalid scrypt params")
+
+// ErrInvalidKeystoreKind signals that the keystore has an unexpected kind
+var ErrInvalidKeystoreKind = errors.New("invalid keystore kind")
+
+// ErrAddressIndexNotSupported signals that an address index was provided for a keystore that does not hold a mnemonic
+var ErrAddressIndexNotSupported = errors.New("address index not supported")
+
+// ErrUnknownPemKeyType signals that the key type of a PEM block could not be determined
+var ErrUnknownPemKeyType = errors.New("unknown PEM key type")
+
+// ErrPemKeyMismatch signals that the PEM block key does not match its label or public key
+var ErrPemKeyMismatch = errors.New("PEM key mismatch")
+
+// ErrInvalidPrivateKeyLength signals that the private key has an invalid length
+var ErrInvalidPrivateKeyLength = errors.New("invalid private key length")
+
+// ErrInvalidPublicKeyLength signals that the public key has an invalid length
+var ErrInvalidPublicKeyLength = errors.New("invalid public key length")
+
+// ErrNilPemKey signals that a nil PEM key was provided
+var ErrNilPemKey = errors.New("nil PEM key")
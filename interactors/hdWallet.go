@@ -0,0 +1,197 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	derivationPathMaster    = "m"
+	derivationPathSeparator = "/"
+	hardenedMarkers         = "'hH"
+	egldDerivationPathFmt   = "m/44'/508'/%d'/0'/%d'"
+)
+
+var validMnemonicWordsCounts = map[int]struct{}{
+	12: {},
+	15: {},
+	18: {},
+	21: {},
+	24: {},
+}
+
+// ArgsAccountDiscovery is the argument DTO for the DiscoverAccounts method
+type ArgsAccountDiscovery struct {
+	Mnemonic   data.Mnemonic
+	Passphrase string
+	Account    uint32
+	StartIndex uint32
+	// GapLimit is the number of consecutive unused addresses after which the discovery stops
+	GapLimit uint32
+}
+
+// DiscoveredAccount holds the data of an address that was found used on the network
+type DiscoveredAccount struct {
+	AddressIndex uint32
+	Address      core.AddressHandler
+	PrivateKey   []byte
+	Account      *data.Account
+}
+
+// FormatEgldDerivationPath returns the default derivation path used by the MultiversX wallets
+// for the provided account and address index
+func FormatEgldDerivationPath(account, addressIndex uint32) string {
+	return fmt.Sprintf(egldDerivationPathFmt, account, addressIndex)
+}
+
+// ParseDerivationPath parses a derivation path like m/44'/508'/0'/0'/0'. The hardened indexes can be marked with
+// ', h or H. Since the ed25519 derivation only supports hardened indexes, any non-hardened index will produce an error
+func ParseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(path), derivationPathSeparator)
+	if segments[0] != derivationPathMaster {
+		return nil, fmt.Errorf("%w: %q should start with %q", ErrInvalidDerivationPath, path, derivationPathMaster)
+	}
+
+	indexes := make([]uint32, 0, len(segments)-1)
+	for position, segment := range segments[1:] {
+		trimmed := strings.TrimRight(segment, hardenedMarkers)
+		if len(segment)-len(trimmed) != 1 {
+			return nil, fmt.Errorf("%w: %q at position %d in path %q",
+				ErrNonHardenedDerivationIndex, segment, position+1, path)
+		}
+
+		index, err := strconv.ParseUint(trimmed, 10, 32)
+		if err != nil || uint32(index) >= hardened {
+			return nil, fmt.Errorf("%w: invalid index %q at position %d in path %q",
+				ErrInvalidDerivationPath, segment, position+1, path)
+		}
+
+		indexes = append(indexes, uint32(index)|hardened)
+	}
+
+	return indexes, nil
+}
+
+// ValidateMnemonic checks the mnemonic words count, that each word is found in the wordlist and the mnemonic checksum
+func (w *wallet) ValidateMnemonic(mnemonic data.Mnemonic) error {
+	words := strings.Fields(string(mnemonic))
+	_, isValidCount := validMnemonicWordsCounts[len(words)]
+	if !isValidCount {
+		return fmt.Errorf("%w: %d words provided, expected 12, 15, 18, 21 or 24", ErrInvalidMnemonicWordsCount, len(words))
+	}
+
+	for position, word := range words {
+		_, found := bip39.GetWordIndex(word)
+		if !found {
+			return fmt.Errorf("%w: %q at position %d", ErrUnknownMnemonicWord, word, position+1)
+		}
+	}
+
+	_, err := bip39.EntropyFromMnemonic(strings.Join(words, " "))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidMnemonicChecksum, err.Error())
+	}
+
+	return nil
+}
+
+// CreateSeedFromMnemonicWithPassphrase validates the mnemonic and creates the seed using the provided BIP39 passphrase
+func (w *wallet) CreateSeedFromMnemonicWithPassphrase(mnemonic data.Mnemonic, passphrase string) ([]byte, error) {
+	err := w.ValidateMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedMnemonic := strings.Join(strings.Fields(string(mnemonic)), " ")
+
+	return bip39.NewSeed(normalizedMnemonic, passphrase), nil
+}
+
+// GetPrivateKeyFromSeedWithPath generates a private key based on seed and the provided derivation path
+func (w *wallet) GetPrivateKeyFromSeedWithPath(seed []byte, path string) ([]byte, error) {
+	indexes, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return derivePrivateKey(seed, indexes).Key, nil
+}
+
+// GetPrivateKeyFromMnemonicWithPath validates the mnemonic and generates a private key based on mnemonic,
+// BIP39 passphrase and the provided derivation path
+func (w *wallet) GetPrivateKeyFromMnemonicWithPath(mnemonic data.Mnemonic, passphrase string, path string) ([]byte, error) {
+	seed, err := w.CreateSeedFromMnemonicWithPassphrase(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.GetPrivateKeyFromSeedWithPath(seed, path)
+}
+
+// DiscoverAccounts scans the address indexes of the provided account, starting with the StartIndex, and returns the
+// used addresses. An address is considered used if it has a non-zero nonce or balance. The scan stops after
+// GapLimit consecutive unused addresses are found
+func (w *wallet) DiscoverAccounts(ctx context.Context, accountGetter AccountGetter, args ArgsAccountDiscovery) ([]*DiscoveredAccount, error) {
+	if check.IfNil(accountGetter) {
+		return nil, ErrNilProxy
+	}
+	if args.GapLimit == 0 {
+		return nil, ErrInvalidGapLimit
+	}
+
+	seed, err := w.CreateSeedFromMnemonicWithPassphrase(args.Mnemonic, args.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	discovered := make([]*DiscoveredAccount, 0)
+	numUnused := uint32(0)
+	for index := args.StartIndex; index < hardened && numUnused < args.GapLimit; index++ {
+		privateKey := w.GetPrivateKeyFromSeed(seed, args.Account, index)
+		address, errGet := w.GetAddressFromPrivateKey(privateKey)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		account, errGet := accountGetter.GetAccount(ctx, address)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w while fetching the account for address index %d", errGet, index)
+		}
+
+		if !isAccountUsed(account) {
+			numUnused++
+			continue
+		}
+
+		numUnused = 0
+		discovered = append(discovered, &DiscoveredAccount{
+			AddressIndex: index,
+			Address:      address,
+			PrivateKey:   privateKey,
+			Account:      account,
+		})
+	}
+
+	return discovered, nil
+}
+
+func isAccountUsed(account *data.Account) bool {
+	if account == nil {
+		return false
+	}
+	if account.Nonce > 0 {
+		return true
+	}
+
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+
+	return ok && balance.Sign() > 0
+}
@@ -0,0 +1,209 @@
+package interactors
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = data.Mnemonic("acid twice post genre topic observe valid viable gesture fortune funny dawn around blood enemy page update reduce decline van bundle zebra rookie real")
+
+func TestParseDerivationPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		indexes, err := ParseDerivationPath("m/44'/508'/1h/0H/7'")
+		assert.Nil(t, err)
+		expected := []uint32{44 | hardened, 508 | hardened, 1 | hardened, hardened, 7 | hardened}
+		assert.Equal(t, expected, indexes)
+
+		indexes, err = ParseDerivationPath("m")
+		assert.Nil(t, err)
+		assert.Empty(t, indexes)
+	})
+	t.Run("missing master should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseDerivationPath("44'/508'")
+		assert.True(t, errors.Is(err, ErrInvalidDerivationPath))
+	})
+	t.Run("non-hardened index should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseDerivationPath("m/44'/508'/0")
+		assert.True(t, errors.Is(err, ErrNonHardenedDerivationIndex))
+
+		_, err = ParseDerivationPath("m/44''")
+		assert.True(t, errors.Is(err, ErrNonHardenedDerivationIndex))
+	})
+	t.Run("invalid index should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseDerivationPath("m/44'/abc'")
+		assert.True(t, errors.Is(err, ErrInvalidDerivationPath))
+
+		_, err = ParseDerivationPath("m/2147483648'")
+		assert.True(t, errors.Is(err, ErrInvalidDerivationPath))
+
+		_, err = ParseDerivationPath("m//0'")
+		assert.True(t, errors.Is(err, ErrNonHardenedDerivationIndex))
+	})
+}
+
+func TestWallet_ValidateMnemonic(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	t.Run("valid mnemonic should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, w.ValidateMnemonic(testMnemonic))
+		assert.Nil(t, w.ValidateMnemonic(" "+testMnemonic+"  "))
+	})
+	t.Run("invalid words count should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic("acid twice post")
+		assert.True(t, errors.Is(err, ErrInvalidMnemonicWordsCount))
+	})
+	t.Run("unknown word should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic("acid twice post genre topic observe valid viable gesture fortune funny dawn around blood enemy page update reduce decline van bundle zebra rookie multiversx")
+		assert.True(t, errors.Is(err, ErrUnknownMnemonicWord))
+		assert.Contains(t, err.Error(), "position 24")
+	})
+	t.Run("invalid checksum should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic("acid twice post genre topic observe valid viable gesture fortune funny dawn around blood enemy page update reduce decline van bundle zebra rookie acid")
+		assert.True(t, errors.Is(err, ErrInvalidMnemonicChecksum))
+	})
+}
+
+func TestWallet_GetPrivateKeyFromMnemonicWithPath(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	t.Run("default path and empty passphrase should match the legacy derivation", func(t *testing.T) {
+		t.Parallel()
+
+		privKey, err := w.GetPrivateKeyFromMnemonicWithPath(testMnemonic, "", FormatEgldDerivationPath(0, 1))
+		assert.Nil(t, err)
+		assert.Equal(t, "1648ad209d6b157a289884933e3bb30f161ec7113221ec16f87c3578b05830b0", hex.EncodeToString(privKey))
+	})
+	t.Run("passphrase should change the derived key", func(t *testing.T) {
+		t.Parallel()
+
+		privKey, err := w.GetPrivateKeyFromMnemonicWithPath(testMnemonic, "passphrase", FormatEgldDerivationPath(0, 1))
+		assert.Nil(t, err)
+		assert.NotEqual(t, "1648ad209d6b157a289884933e3bb30f161ec7113221ec16f87c3578b05830b0", hex.EncodeToString(privKey))
+
+		seed := w.CreateSeedFromMnemonic(testMnemonic)
+		seedWithPassphrase, err := w.CreateSeedFromMnemonicWithPassphrase(testMnemonic, "passphrase")
+		assert.Nil(t, err)
+		assert.NotEqual(t, seed, seedWithPassphrase)
+	})
+	t.Run("custom path should work", func(t *testing.T) {
+		t.Parallel()
+
+		seed := w.CreateSeedFromMnemonic(testMnemonic)
+		privKey, err := w.GetPrivateKeyFromSeedWithPath(seed, "m/44'/508'/0'")
+		assert.Nil(t, err)
+		assert.Equal(t, derivePrivateKey(seed, bip32Path{44 | hardened, 508 | hardened, hardened}).Key, privKey)
+	})
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		privKey, err := w.GetPrivateKeyFromMnemonicWithPath("acid", "", FormatEgldDerivationPath(0, 0))
+		assert.Nil(t, privKey)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonicWordsCount))
+	})
+	t.Run("invalid path should error", func(t *testing.T) {
+		t.Parallel()
+
+		privKey, err := w.GetPrivateKeyFromMnemonicWithPath(testMnemonic, "", "m/44/508")
+		assert.Nil(t, privKey)
+		assert.True(t, errors.Is(err, ErrNonHardenedDerivationIndex))
+	})
+}
+
+func TestWallet_DiscoverAccounts(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	addressOfIndex := func(index uint32) string {
+		address, err := w.GetAddressFromPrivateKey(w.GetPrivateKeyFromMnemonic(testMnemonic, 0, index))
+		require.Nil(t, err)
+
+		bech32, _ := address.AddressAsBech32String()
+		return bech32
+	}
+
+	t.Run("nil account getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		accounts, err := w.DiscoverAccounts(context.Background(), nil, ArgsAccountDiscovery{Mnemonic: testMnemonic, GapLimit: 1})
+		assert.Nil(t, accounts)
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("zero gap limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		accounts, err := w.DiscoverAccounts(context.Background(), &testsCommon.ProxyStub{}, ArgsAccountDiscovery{Mnemonic: testMnemonic})
+		assert.Nil(t, accounts)
+		assert.Equal(t, ErrInvalidGapLimit, err)
+	})
+	t.Run("get account error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		proxy := &testsCommon.ProxyStub{
+			GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}
+		accounts, err := w.DiscoverAccounts(context.Background(), proxy, ArgsAccountDiscovery{Mnemonic: testMnemonic, GapLimit: 1})
+		assert.Nil(t, accounts)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should stop after gap limit consecutive unused addresses", func(t *testing.T) {
+		t.Parallel()
+
+		usedAccounts := map[string]*data.Account{
+			addressOfIndex(0): {Nonce: 3, Balance: "0"},
+			addressOfIndex(2): {Balance: "100"},
+			addressOfIndex(6): {Balance: "100"},
+		}
+		numQueried := 0
+		proxy := &testsCommon.ProxyStub{
+			GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+				numQueried++
+				bech32, _ := address.AddressAsBech32String()
+				account, found := usedAccounts[bech32]
+				if !found {
+					return &data.Account{Address: bech32, Balance: "0"}, nil
+				}
+
+				return account, nil
+			},
+		}
+
+		accounts, err := w.DiscoverAccounts(context.Background(), proxy, ArgsAccountDiscovery{Mnemonic: testMnemonic, GapLimit: 3})
+		assert.Nil(t, err)
+		require.Equal(t, 2, len(accounts))
+		assert.Equal(t, uint32(0), accounts[0].AddressIndex)
+		assert.Equal(t, uint32(2), accounts[1].AddressIndex)
+		assert.Equal(t, w.GetPrivateKeyFromMnemonic(testMnemonic, 0, 2), accounts[1].PrivateKey)
+		assert.Equal(t, 6, numQueried)
+	})
+}
@@ -19,6 +19,12 @@ type Proxy interface {
 	IsInterfaceNil() bool
 }
 
+// AccountGetter defines the component able to fetch an account from the network
+type AccountGetter interface {
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
+
 // TxBuilder defines the component able to build & sign a transaction
 type TxBuilder interface {
 	ApplyUserSignature(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
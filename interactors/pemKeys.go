@@ -0,0 +1,200 @@
+package interactors
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// KeyType defines the type of a key found in a PEM file
+type KeyType string
+
+const (
+	// KeyTypeEd25519 is the type of the wallet keys
+	KeyTypeEd25519 KeyType = "ed25519"
+	// KeyTypeBLS is the type of the validator keys
+	KeyTypeBLS KeyType = "bls"
+)
+
+const (
+	pemLabelPrefix     = "PRIVATE KEY for "
+	privateKeyLength   = 32
+	blsPublicKeyLength = 96
+	pemFileMode        = 0600
+)
+
+// PemKey holds a private key loaded from, or to be saved in, a PEM file. The label is the PEM block type
+type PemKey struct {
+	Label      string
+	KeyType    KeyType
+	PrivateKey []byte
+	PublicKey  []byte
+}
+
+// LoadAllPrivateKeysFromPemFile loads all the keys from a .pem file, like the walletKey.pem or validatorKey.pem files
+func (w *wallet) LoadAllPrivateKeysFromPemFile(filename string) ([]*PemKey, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.LoadAllPrivateKeysFromPemData(buff)
+}
+
+// LoadAllPrivateKeysFromPemData returns all the keys from the pem data. The Ed25519 keys are checked against the
+// address from their label. The BLS public keys are taken from the labels as they can not be derived here, use the
+// bls package to check them
+func (w *wallet) LoadAllPrivateKeysFromPemData(buff []byte) ([]*PemKey, error) {
+	keys := make([]*PemKey, 0)
+	for index := 0; ; index++ {
+		var blk *pem.Block
+		blk, buff = pem.Decode(buff)
+		if blk == nil {
+			break
+		}
+
+		key, err := w.pemBlockToKey(blk)
+		if err != nil {
+			return nil, fmt.Errorf("%w for PEM block %d with label %q", err, index, blk.Type)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 || len(bytes.TrimSpace(buff)) > 0 {
+		return nil, ErrInvalidPemFile
+	}
+
+	return keys, nil
+}
+
+func (w *wallet) pemBlockToKey(blk *pem.Block) (*PemKey, error) {
+	keyBytes, err := hex.DecodeString(string(blk.Bytes))
+	if err != nil {
+		return nil, err
+	}
+
+	identifier := strings.TrimPrefix(blk.Type, pemLabelPrefix)
+	_, errAddress := data.NewAddressFromBech32String(identifier)
+	blsPublicKey, errBLS := hex.DecodeString(identifier)
+	isBLSLabel := errBLS == nil && len(blsPublicKey) == blsPublicKeyLength
+
+	switch {
+	case errAddress == nil || len(keyBytes) == 2*privateKeyLength:
+		return w.createEd25519PemKey(blk.Type, keyBytes, identifier, errAddress == nil)
+	case isBLSLabel:
+		if len(keyBytes) != privateKeyLength {
+			return nil, fmt.Errorf("%w: %d bytes", ErrInvalidPrivateKeyLength, len(keyBytes))
+		}
+
+		return &PemKey{
+			Label:      blk.Type,
+			KeyType:    KeyTypeBLS,
+			PrivateKey: keyBytes,
+			PublicKey:  blsPublicKey,
+		}, nil
+	default:
+		return nil, ErrUnknownPemKeyType
+	}
+}
+
+func (w *wallet) createEd25519PemKey(label string, keyBytes []byte, bech32Address string, checkAddress bool) (*PemKey, error) {
+	if len(keyBytes) != privateKeyLength && len(keyBytes) != 2*privateKeyLength {
+		return nil, fmt.Errorf("%w: %d bytes", ErrInvalidPrivateKeyLength, len(keyBytes))
+	}
+
+	privateKey := keyBytes[:privateKeyLength]
+	address, err := w.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := address.AddressBytes()
+	if len(keyBytes) == 2*privateKeyLength && !bytes.Equal(keyBytes[privateKeyLength:], publicKey) {
+		return nil, fmt.Errorf("%w: the public key does not match the private key", ErrPemKeyMismatch)
+	}
+	if checkAddress {
+		addressAsBech32, errConvert := address.AddressAsBech32String()
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		if addressAsBech32 != bech32Address {
+			return nil, fmt.Errorf("%w: the label address does not match the private key", ErrPemKeyMismatch)
+		}
+	}
+
+	return &PemKey{
+		Label:      label,
+		KeyType:    KeyTypeEd25519,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+	}, nil
+}
+
+// SavePrivateKeysToPemFile saves all the provided keys in a .pem file. The labels are generated from the public keys,
+// the Ed25519 public keys being derived while the BLS public keys have to be provided
+func (w *wallet) SavePrivateKeysToPemFile(keys []*PemKey, filename string) error {
+	buff, err := w.EncodePrivateKeysToPemData(keys)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buff, pemFileMode)
+}
+
+// EncodePrivateKeysToPemData encodes all the provided keys as pem data
+func (w *wallet) EncodePrivateKeysToPemData(keys []*PemKey) ([]byte, error) {
+	buff := make([]byte, 0)
+	for index, key := range keys {
+		blk, err := w.keyToPemBlock(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %d", err, index)
+		}
+
+		buff = append(buff, pem.EncodeToMemory(blk)...)
+	}
+
+	return buff, nil
+}
+
+func (w *wallet) keyToPemBlock(key *PemKey) (*pem.Block, error) {
+	if key == nil {
+		return nil, ErrNilPemKey
+	}
+	if len(key.PrivateKey) != privateKeyLength {
+		return nil, fmt.Errorf("%w: %d bytes", ErrInvalidPrivateKeyLength, len(key.PrivateKey))
+	}
+
+	switch key.KeyType {
+	case KeyTypeEd25519:
+		address, err := w.GetAddressFromPrivateKey(key.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		addressAsBech32, err := address.AddressAsBech32String()
+		if err != nil {
+			return nil, err
+		}
+
+		keyBytes := append(append(make([]byte, 0, 2*privateKeyLength), key.PrivateKey...), address.AddressBytes()...)
+		return &pem.Block{
+			Type:  pemLabelPrefix + addressAsBech32,
+			Bytes: []byte(hex.EncodeToString(keyBytes)),
+		}, nil
+	case KeyTypeBLS:
+		if len(key.PublicKey) != blsPublicKeyLength {
+			return nil, fmt.Errorf("%w: %d bytes", ErrInvalidPublicKeyLength, len(key.PublicKey))
+		}
+
+		return &pem.Block{
+			Type:  pemLabelPrefix + hex.EncodeToString(key.PublicKey),
+			Bytes: []byte(hex.EncodeToString(key.PrivateKey)),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPemKeyType, key.KeyType)
+	}
+}
@@ -0,0 +1,139 @@
+package interactors
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBLSPrivateKey = "7cff99bd671502db7d15bc8abc0c9a804fb925406fbdd50f1e4c17a4cd774247"
+	testBLSPublicKey  = "e7beaa95b3877f47348df4dd1cb578a4f7cabf7a20bfeefe5cdd263878ff132b765e04fef6f40c93512b666c47ed7719b8902f6c922c04247989b7137e837cc81a62e54712471c97a2ddab75aa9c2f58f813ed4c0fa722bde0ab718bff382208"
+	testPemAddress    = "erd1zptg3eu7uw0qvzhnu009lwxupcn6ntjxptj5gaxt8curhxjqr9tsqpsnht"
+	testPemPrivateKey = "349df9580e205de4408b889976930115eed06ef89d97b4fc9c0440a46301a9ab"
+)
+
+func decodeTestHex(tb testing.TB, hexString string) []byte {
+	buff, err := hex.DecodeString(hexString)
+	require.Nil(tb, err)
+
+	return buff
+}
+
+func TestWallet_LoadAllPrivateKeysFromPemData(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	walletPem, err := os.ReadFile("testdata/test.pem")
+	require.Nil(t, err)
+
+	t.Run("empty data should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, errLoad := w.LoadAllPrivateKeysFromPemData([]byte("not a pem"))
+		assert.Nil(t, keys)
+		assert.Equal(t, ErrInvalidPemFile, errLoad)
+	})
+	t.Run("trailing data should error", func(t *testing.T) {
+		t.Parallel()
+
+		keys, errLoad := w.LoadAllPrivateKeysFromPemData(append(append([]byte{}, walletPem...), []byte("\ngarbage")...))
+		assert.Nil(t, keys)
+		assert.Equal(t, ErrInvalidPemFile, errLoad)
+	})
+	t.Run("wallet key should work", func(t *testing.T) {
+		t.Parallel()
+
+		keys, errLoad := w.LoadAllPrivateKeysFromPemData(walletPem)
+		require.Nil(t, errLoad)
+		require.Equal(t, 1, len(keys))
+		assert.Equal(t, "PRIVATE KEY for "+testPemAddress, keys[0].Label)
+		assert.Equal(t, KeyTypeEd25519, keys[0].KeyType)
+		assert.Equal(t, testPemPrivateKey, hex.EncodeToString(keys[0].PrivateKey))
+
+		address, _ := w.GetAddressFromPrivateKey(keys[0].PrivateKey)
+		assert.Equal(t, address.AddressBytes(), keys[0].PublicKey)
+	})
+	t.Run("address mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		altered := strings.ReplaceAll(string(walletPem), testPemAddress, "erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw")
+		keys, errLoad := w.LoadAllPrivateKeysFromPemData([]byte(altered))
+		assert.Nil(t, keys)
+		assert.True(t, errors.Is(errLoad, ErrPemKeyMismatch))
+	})
+	t.Run("unknown key type should error", func(t *testing.T) {
+		t.Parallel()
+
+		data, _ := w.EncodePrivateKeysToPemData([]*PemKey{{
+			KeyType:    KeyTypeBLS,
+			PrivateKey: decodeTestHex(t, testBLSPrivateKey),
+			PublicKey:  decodeTestHex(t, testBLSPublicKey),
+		}})
+		altered := strings.ReplaceAll(string(data), testBLSPublicKey, "node")
+		keys, errLoad := w.LoadAllPrivateKeysFromPemData([]byte(altered))
+		assert.Nil(t, keys)
+		assert.True(t, errors.Is(errLoad, ErrUnknownPemKeyType))
+	})
+}
+
+func TestWallet_SaveAndLoadAllPrivateKeys(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	t.Run("invalid keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := w.EncodePrivateKeysToPemData([]*PemKey{nil})
+		assert.True(t, errors.Is(err, ErrNilPemKey))
+
+		_, err = w.EncodePrivateKeysToPemData([]*PemKey{{KeyType: KeyTypeEd25519, PrivateKey: []byte("short")}})
+		assert.True(t, errors.Is(err, ErrInvalidPrivateKeyLength))
+
+		_, err = w.EncodePrivateKeysToPemData([]*PemKey{{KeyType: KeyTypeBLS, PrivateKey: decodeTestHex(t, testBLSPrivateKey)}})
+		assert.True(t, errors.Is(err, ErrInvalidPublicKeyLength))
+
+		_, err = w.EncodePrivateKeysToPemData([]*PemKey{{KeyType: "secp256k1", PrivateKey: decodeTestHex(t, testBLSPrivateKey)}})
+		assert.True(t, errors.Is(err, ErrUnknownPemKeyType))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		secondPrivateKey := w.GetPrivateKeyFromMnemonic(testMnemonic, 0, 0)
+		keys := []*PemKey{
+			{KeyType: KeyTypeEd25519, PrivateKey: decodeTestHex(t, testPemPrivateKey)},
+			{KeyType: KeyTypeBLS, PrivateKey: decodeTestHex(t, testBLSPrivateKey), PublicKey: decodeTestHex(t, testBLSPublicKey)},
+			{KeyType: KeyTypeEd25519, PrivateKey: secondPrivateKey},
+		}
+
+		filename := path.Join(t.TempDir(), "keys.pem")
+		err := w.SavePrivateKeysToPemFile(keys, filename)
+		require.Nil(t, err)
+
+		loaded, err := w.LoadAllPrivateKeysFromPemFile(filename)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(loaded))
+
+		assert.Equal(t, "PRIVATE KEY for "+testPemAddress, loaded[0].Label)
+		assert.Equal(t, KeyTypeEd25519, loaded[0].KeyType)
+		assert.Equal(t, keys[0].PrivateKey, loaded[0].PrivateKey)
+
+		assert.Equal(t, "PRIVATE KEY for "+testBLSPublicKey, loaded[1].Label)
+		assert.Equal(t, KeyTypeBLS, loaded[1].KeyType)
+		assert.Equal(t, keys[1].PrivateKey, loaded[1].PrivateKey)
+		assert.Equal(t, keys[1].PublicKey, loaded[1].PublicKey)
+
+		assert.Equal(t, KeyTypeEd25519, loaded[2].KeyType)
+		assert.Equal(t, secondPrivateKey, loaded[2].PrivateKey)
+
+		firstKey, err := w.LoadPrivateKeyFromPemFile(filename)
+		assert.Nil(t, err)
+		assert.Equal(t, keys[0].PrivateKey, firstKey)
+	})
+}
@@ -12,8 +12,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
@@ -36,8 +38,41 @@ const (
 	scryptDKLen     = 32
 	addressLen      = 32
 	mnemonicKind    = "mnemonic"
+	secretKeyKind   = "secretKey"
+	keystoreCipher  = "aes-128-ctr"
 )
 
+// ScryptParams holds the scrypt key derivation parameters used when encrypting a keystore
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+// DefaultScryptParams returns the scrypt parameters used by the MultiversX wallets
+func DefaultScryptParams() ScryptParams {
+	return ScryptParams{
+		N: scryptN,
+		R: scryptR,
+		P: scryptP,
+	}
+}
+
+func (params ScryptParams) check() error {
+	isPowerOfTwo := params.N > 1 && params.N&(params.N-1) == 0
+	if !isPowerOfTwo {
+		return fmt.Errorf("%w: N should be a power of 2 greater than 1, provided %d", ErrInvalidScryptParams, params.N)
+	}
+	if params.R < 1 {
+		return fmt.Errorf("%w: R should be positive, provided %d", ErrInvalidScryptParams, params.R)
+	}
+	if params.P < 1 {
+		return fmt.Errorf("%w: P should be positive, provided %d", ErrInvalidScryptParams, params.P)
+	}
+
+	return nil
+}
+
 type bip32Path []uint32
 
 type bip32 struct {
@@ -49,9 +84,9 @@ var suite = ed25519.NewEd25519()
 var keyGenerator = signing.NewKeyGenerator(suite)
 
 type encryptedKeyJSONV4 struct {
-	Address string `json:"address"`
-	Bech32  string `json:"bech32"`
-	Kind    string `json:"kind"`
+	Address string `json:"address,omitempty"`
+	Bech32  string `json:"bech32,omitempty"`
+	Kind    string `json:"kind,omitempty"`
 	Crypto  struct {
 		Cipher       string `json:"cipher"`
 		CipherText   string `json:"ciphertext"`
@@ -165,19 +200,66 @@ func (w *wallet) GetAddressFromPrivateKey(privateKeyBytes []byte) (core.AddressH
 	return data.NewAddressFromBytes(publicKeyBytes), nil
 }
 
-// LoadPrivateKeyFromJsonFile loads a password encrypted private key from a .json file
+// LoadPrivateKeyFromJsonFile loads a password encrypted private key from a .json file. For the mnemonic kind
+// keystores, the private key of the first address is returned
 func (w *wallet) LoadPrivateKeyFromJsonFile(filename string, password string) ([]byte, error) {
-	buff, err := os.ReadFile(filename)
+	return w.LoadPrivateKeyFromJsonFileWithAddressIndex(filename, password, 0)
+}
+
+// LoadPrivateKeyFromJsonFileWithAddressIndex loads a password encrypted private key from a .json file. For the
+// mnemonic kind keystores, the private key is derived for the provided address index, otherwise the address index
+// should be 0
+func (w *wallet) LoadPrivateKeyFromJsonFileWithAddressIndex(filename string, password string, addressIndex uint32) ([]byte, error) {
+	key, decryptedData, err := w.decryptJsonFile(filename, password)
 	if err != nil {
 		return nil, err
 	}
 
+	if key.Kind != mnemonicKind { // wallets with the old JSON format or with the secret key kind
+		if addressIndex != 0 {
+			return nil, fmt.Errorf("%w for a keystore of kind %q", ErrAddressIndexNotSupported, key.Kind)
+		}
+
+		return w.secretKeyAfterChecks(key, decryptedData)
+	}
+
+	return w.secretKeyFromMnemonic(decryptedData, addressIndex), nil
+}
+
+// LoadMnemonicFromJsonFile loads a password encrypted mnemonic from a .json file of mnemonic kind
+func (w *wallet) LoadMnemonicFromJsonFile(filename string, password string) (data.Mnemonic, error) {
+	key, decryptedData, err := w.decryptJsonFile(filename, password)
+	if err != nil {
+		return "", err
+	}
+	if key.Kind != mnemonicKind {
+		return "", fmt.Errorf("%w: expected %q, got %q", ErrInvalidKeystoreKind, mnemonicKind, key.Kind)
+	}
+
+	return data.Mnemonic(decryptedData), nil
+}
+
+func (w *wallet) decryptJsonFile(filename string, password string) (*encryptedKeyJSONV4, []byte, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	key := &encryptedKeyJSONV4{}
 	err = json.Unmarshal(buff, key)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	decryptedData, err := decryptKeystore(key, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, decryptedData, nil
+}
+
+func decryptKeystore(key *encryptedKeyJSONV4, password string) ([]byte, error) {
 	mac, err := hex.DecodeString(key.Crypto.MAC)
 	if err != nil {
 		return nil, err
@@ -227,11 +309,7 @@ func (w *wallet) LoadPrivateKeyFromJsonFile(filename string, password string) ([
 	decryptedData := make([]byte, len(cipherText))
 	stream.XORKeyStream(decryptedData, cipherText)
 
-	if key.Kind != mnemonicKind { // wallets with the old JSON format
-		return w.secretKeyAfterChecks(key, decryptedData)
-	} else {
-		return w.secretKeyFromMnemonic(decryptedData), nil
-	}
+	return decryptedData, nil
 }
 
 func (w *wallet) secretKeyAfterChecks(key *encryptedKeyJSONV4, secretKey []byte) ([]byte, error) {
@@ -257,74 +335,169 @@ func (w *wallet) secretKeyAfterChecks(key *encryptedKeyJSONV4, secretKey []byte)
 	return secretKey, nil
 }
 
-func (w *wallet) secretKeyFromMnemonic(mnemonic []byte) []byte {
-	return w.GetPrivateKeyFromMnemonic(data.Mnemonic(mnemonic), 0, 0)
+func (w *wallet) secretKeyFromMnemonic(mnemonic []byte, addressIndex uint32) []byte {
+	return w.GetPrivateKeyFromMnemonic(data.Mnemonic(mnemonic), 0, addressIndex)
 }
 
 // SavePrivateKeyToJsonFile saves a password encrypted private key to a .json file
 func (w *wallet) SavePrivateKeyToJsonFile(privateKey []byte, password string, filename string) error {
-	salt := make([]byte, 32)
-	_, err := io.ReadFull(rand.Reader, salt)
+	return w.SavePrivateKeyToJsonFileWithParams(privateKey, password, filename, DefaultScryptParams())
+}
+
+// SavePrivateKeyToJsonFileWithParams saves a password encrypted private key to a .json file of secret key kind,
+// using the provided scrypt parameters
+func (w *wallet) SavePrivateKeyToJsonFileWithParams(privateKey []byte, password string, filename string, params ScryptParams) error {
+	keystoreJson, err := w.createSecretKeyKeystore(privateKey, password, params)
 	if err != nil {
 		return err
 	}
 
-	derivedKey, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	return writeKeystore(keystoreJson, filename)
+}
+
+func (w *wallet) createSecretKeyKeystore(privateKey []byte, password string, params ScryptParams) (*encryptedKeyJSONV4, error) {
+	address, err := w.GetAddressFromPrivateKey(privateKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	encryptKey := derivedKey[:16]
-	iv := make([]byte, aes.BlockSize) // 16
-	_, err = io.ReadFull(rand.Reader, iv)
+	addressAsBech32String, err := address.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	keystoreJson, err := encryptKeystore(privateKey, password, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keystoreJson.Kind = secretKeyKind
+	keystoreJson.Bech32 = addressAsBech32String
+	keystoreJson.Address = hex.EncodeToString(address.AddressBytes())
+
+	return keystoreJson, nil
+}
+
+// SaveMnemonicToJsonFile saves a password encrypted mnemonic to a .json file of mnemonic kind, compatible with
+// the MultiversX web wallet, using the provided scrypt parameters
+func (w *wallet) SaveMnemonicToJsonFile(mnemonic data.Mnemonic, password string, filename string, params ScryptParams) error {
+	err := w.ValidateMnemonic(mnemonic)
 	if err != nil {
 		return err
 	}
 
-	aesBlock, err := aes.NewCipher(encryptKey)
+	keystoreJson, err := encryptKeystore([]byte(mnemonic), password, params)
 	if err != nil {
 		return err
 	}
+	keystoreJson.Kind = mnemonicKind
 
-	stream := cipher.NewCTR(aesBlock, iv)
-	cipherText := make([]byte, len(privateKey))
-	stream.XORKeyStream(cipherText, privateKey)
+	return writeKeystore(keystoreJson, filename)
+}
 
-	hash := hmac.New(sha256.New, derivedKey[16:32])
-	_, err = hash.Write(cipherText)
+// ChangeJsonFilePassword re-encrypts the content of a .json keystore file with the new password and the provided
+// scrypt parameters. The keystore kind is preserved and the file is replaced only after the new content was written
+func (w *wallet) ChangeJsonFilePassword(filename string, oldPassword string, newPassword string, params ScryptParams) error {
+	key, decryptedData, err := w.decryptJsonFile(filename, oldPassword)
 	if err != nil {
 		return err
 	}
 
-	mac := hash.Sum(nil)
+	var keystoreJson *encryptedKeyJSONV4
+	if key.Kind == mnemonicKind {
+		keystoreJson, err = encryptKeystore(decryptedData, newPassword, params)
+		if err != nil {
+			return err
+		}
+		keystoreJson.Kind = mnemonicKind
+	} else {
+		secretKey, errCheck := w.secretKeyAfterChecks(key, decryptedData)
+		if errCheck != nil {
+			return errCheck
+		}
 
-	address, err := w.GetAddressFromPrivateKey(privateKey)
+		keystoreJson, err = w.createSecretKeyKeystore(secretKey, newPassword, params)
+		if err != nil {
+			return err
+		}
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
 	if err != nil {
 		return err
 	}
+	tempFilename := tempFile.Name()
+	_ = tempFile.Close()
 
-	addressAsBech32String, err := address.AddressAsBech32String()
+	err = writeKeystore(keystoreJson, tempFilename)
 	if err != nil {
+		_ = os.Remove(tempFilename)
 		return err
 	}
 
+	return os.Rename(tempFilename, filename)
+}
+
+func encryptKeystore(plainText []byte, password string, params ScryptParams) (*encryptedKeyJSONV4, error) {
+	err := params.check()
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 32)
+	_, err = io.ReadFull(rand.Reader, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key([]byte(password), salt, params.N, params.R, params.P, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptKey := derivedKey[:16]
+	iv := make([]byte, aes.BlockSize) // 16
+	_, err = io.ReadFull(rand.Reader, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	aesBlock, err := aes.NewCipher(encryptKey)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := cipher.NewCTR(aesBlock, iv)
+	cipherText := make([]byte, len(plainText))
+	stream.XORKeyStream(cipherText, plainText)
+
+	hash := hmac.New(sha256.New, derivedKey[16:32])
+	_, err = hash.Write(cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hash.Sum(nil)
+
 	keystoreJson := &encryptedKeyJSONV4{
-		Bech32:  addressAsBech32String,
-		Address: hex.EncodeToString(address.AddressBytes()),
 		Version: keystoreVersion,
 		Id:      uuid.New(),
 	}
 	keystoreJson.Crypto.CipherParams.IV = hex.EncodeToString(iv)
-	keystoreJson.Crypto.Cipher = "aes-128-ctr"
+	keystoreJson.Crypto.Cipher = keystoreCipher
 	keystoreJson.Crypto.CipherText = hex.EncodeToString(cipherText)
 	keystoreJson.Crypto.KDF = keyHeaderKDF
 	keystoreJson.Crypto.MAC = hex.EncodeToString(mac)
-	keystoreJson.Crypto.KDFParams.N = scryptN
-	keystoreJson.Crypto.KDFParams.R = scryptR
-	keystoreJson.Crypto.KDFParams.P = scryptP
+	keystoreJson.Crypto.KDFParams.N = params.N
+	keystoreJson.Crypto.KDFParams.R = params.R
+	keystoreJson.Crypto.KDFParams.P = params.P
 	keystoreJson.Crypto.KDFParams.DkLen = scryptDKLen
 	keystoreJson.Crypto.KDFParams.Salt = hex.EncodeToString(salt)
 
+	return keystoreJson, nil
+}
+
+func writeKeystore(keystoreJson *encryptedKeyJSONV4, filename string) error {
 	buff, err := json.Marshal(keystoreJson)
 	if err != nil {
 		return err
@@ -2,6 +2,8 @@ package interactors
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -146,6 +148,142 @@ func TestWallet_SavePrivateKeyToJsonFile(t *testing.T) {
 	assert.Equal(t, privKey, recoveredSk)
 }
 
+func TestWallet_LoadPrivateKeyFromJsonFileWithAddressIndex(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	t.Run("mnemonic kind should derive the address index", func(t *testing.T) {
+		t.Parallel()
+
+		privkey, err := w.LoadPrivateKeyFromJsonFileWithAddressIndex("testdata/testWithKind.json", "password", 1)
+		require.Nil(t, err)
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile("testdata/testWithKind.json", "password")
+		require.Nil(t, err)
+		assert.Equal(t, w.GetPrivateKeyFromMnemonic(mnemonic, 0, 1), privkey)
+	})
+	t.Run("secret key kind with non-zero address index should error", func(t *testing.T) {
+		t.Parallel()
+
+		privkey, err := w.LoadPrivateKeyFromJsonFileWithAddressIndex("testdata/test.json", "pAssword1~", 1)
+		assert.Nil(t, privkey)
+		assert.True(t, errors.Is(err, ErrAddressIndexNotSupported))
+	})
+	t.Run("loading the mnemonic from a secret key keystore should error", func(t *testing.T) {
+		t.Parallel()
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile("testdata/test.json", "pAssword1~")
+		assert.Empty(t, mnemonic)
+		assert.True(t, errors.Is(err, ErrInvalidKeystoreKind))
+	})
+}
+
+func TestWallet_SaveMnemonicToJsonFile(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		fileName := path.Join(t.TempDir(), "mnemonic.json")
+		err := w.SaveMnemonicToJsonFile("invalid mnemonic", "password", fileName, DefaultScryptParams())
+		assert.True(t, errors.Is(err, ErrInvalidMnemonicWordsCount))
+	})
+	t.Run("invalid scrypt params should error", func(t *testing.T) {
+		t.Parallel()
+
+		fileName := path.Join(t.TempDir(), "mnemonic.json")
+		err := w.SaveMnemonicToJsonFile(testMnemonic, "password", fileName, ScryptParams{N: 1000, R: 8, P: 1})
+		assert.True(t, errors.Is(err, ErrInvalidScryptParams))
+
+		err = w.SaveMnemonicToJsonFile(testMnemonic, "password", fileName, ScryptParams{N: 1024, R: 0, P: 1})
+		assert.True(t, errors.Is(err, ErrInvalidScryptParams))
+
+		err = w.SaveMnemonicToJsonFile(testMnemonic, "password", fileName, ScryptParams{N: 1024, R: 8, P: 0})
+		assert.True(t, errors.Is(err, ErrInvalidScryptParams))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fileName := path.Join(t.TempDir(), "mnemonic.json")
+		params := ScryptParams{N: 1024, R: 8, P: 2}
+		err := w.SaveMnemonicToJsonFile(testMnemonic, "password", fileName, params)
+		require.Nil(t, err)
+
+		buff, err := os.ReadFile(fileName)
+		require.Nil(t, err)
+		keystoreJson := make(map[string]interface{})
+		err = json.Unmarshal(buff, &keystoreJson)
+		require.Nil(t, err)
+		assert.Equal(t, "mnemonic", keystoreJson["kind"])
+		assert.NotContains(t, keystoreJson, "address")
+		assert.NotContains(t, keystoreJson, "bech32")
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile(fileName, "password")
+		require.Nil(t, err)
+		assert.Equal(t, testMnemonic, mnemonic)
+
+		privkey, err := w.LoadPrivateKeyFromJsonFileWithAddressIndex(fileName, "password", 2)
+		require.Nil(t, err)
+		assert.Equal(t, w.GetPrivateKeyFromMnemonic(testMnemonic, 0, 2), privkey)
+	})
+}
+
+func TestWallet_ChangeJsonFilePassword(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	params := ScryptParams{N: 1024, R: 8, P: 1}
+	t.Run("wrong old password should error", func(t *testing.T) {
+		t.Parallel()
+
+		fileName := path.Join(t.TempDir(), "mnemonic.json")
+		err := w.SaveMnemonicToJsonFile(testMnemonic, "password", fileName, params)
+		require.Nil(t, err)
+
+		err = w.ChangeJsonFilePassword(fileName, "wrong", "new password", params)
+		assert.Equal(t, ErrWrongPassword, err)
+
+		_, err = w.LoadMnemonicFromJsonFile(fileName, "password")
+		assert.Nil(t, err)
+	})
+	t.Run("mnemonic kind should work", func(t *testing.T) {
+		t.Parallel()
+
+		fileName := path.Join(t.TempDir(), "mnemonic.json")
+		err := w.SaveMnemonicToJsonFile(testMnemonic, "password", fileName, params)
+		require.Nil(t, err)
+
+		err = w.ChangeJsonFilePassword(fileName, "password", "new password", DefaultScryptParams())
+		require.Nil(t, err)
+
+		_, err = w.LoadMnemonicFromJsonFile(fileName, "password")
+		assert.Equal(t, ErrWrongPassword, err)
+		mnemonic, err := w.LoadMnemonicFromJsonFile(fileName, "new password")
+		assert.Nil(t, err)
+		assert.Equal(t, testMnemonic, mnemonic)
+
+		entries, err := os.ReadDir(path.Dir(fileName))
+		require.Nil(t, err)
+		assert.Equal(t, 1, len(entries))
+	})
+	t.Run("secret key kind should work", func(t *testing.T) {
+		t.Parallel()
+
+		privKey, _ := hex.DecodeString("15cfe2140ee9821f706423036ba58d1e6ec13dbc4ebf206732ad40b5236af403")
+		fileName := path.Join(t.TempDir(), "secretKey.json")
+		err := w.SavePrivateKeyToJsonFileWithParams(privKey, "password", fileName, params)
+		require.Nil(t, err)
+
+		err = w.ChangeJsonFilePassword(fileName, "password", "new password", params)
+		require.Nil(t, err)
+
+		recoveredSk, err := w.LoadPrivateKeyFromJsonFile(fileName, "new password")
+		assert.Nil(t, err)
+		assert.Equal(t, privKey, recoveredSk)
+	})
+}
+
 func TestWallet_LoadPrivateKeyFromPemFile(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,36 @@
+package vault
+
+import "errors"
+
+// ErrEmptyFilename signals that an empty filename was provided
+var ErrEmptyFilename = errors.New("empty filename")
+
+// ErrEmptyPassword signals that an empty password was provided
+var ErrEmptyPassword = errors.New("empty password")
+
+// ErrWrongPassword signals that a wrong password was provided or the vault file was altered
+var ErrWrongPassword = errors.New("wrong password or corrupted vault")
+
+// ErrVaultAlreadyExists signals that the vault file already exists
+var ErrVaultAlreadyExists = errors.New("vault already exists")
+
+// ErrUnsupportedVersion signals that the vault file version is not supported
+var ErrUnsupportedVersion = errors.New("unsupported vault version")
+
+// ErrUnsupportedKDF signals that the key derivation function is not supported
+var ErrUnsupportedKDF = errors.New("unsupported key derivation function")
+
+// ErrInvalidKDFParams signals that invalid key derivation function parameters were provided
+var ErrInvalidKDFParams = errors.New("invalid key derivation function params")
+
+// ErrInvalidSecretKey signals that an invalid secret key was provided
+var ErrInvalidSecretKey = errors.New("invalid secret key")
+
+// ErrKeyNotFound signals that the key was not found in the vault
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrKeyAlreadyExists signals that the key already exists in the vault
+var ErrKeyAlreadyExists = errors.New("key already exists")
+
+// ErrLabelAlreadyUsed signals that the label is already used by another key
+var ErrLabelAlreadyUsed = errors.New("label already used")
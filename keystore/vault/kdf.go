@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KDFType defines the key derivation function used to derive the master key from the password
+type KDFType string
+
+const (
+	// Argon2id is the argon2id key derivation function
+	Argon2id KDFType = "argon2id"
+	// Scrypt is the scrypt key derivation function
+	Scrypt KDFType = "scrypt"
+)
+
+const (
+	masterKeyLength = 32
+	saltLength      = 32
+
+	defaultArgon2Time    = 3
+	defaultArgon2Memory  = 64 * 1024
+	defaultArgon2Threads = 4
+	defaultScryptN       = 1 << 15
+	defaultScryptR       = 8
+	defaultScryptP       = 1
+)
+
+// KDFParams holds the key derivation function parameters
+type KDFParams struct {
+	Type KDFType `json:"type"`
+	// Time is the number of argon2id passes
+	Time uint32 `json:"time,omitempty"`
+	// Memory is the argon2id memory, in KiB
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+}
+
+// DefaultArgon2idParams returns the default argon2id parameters
+func DefaultArgon2idParams() KDFParams {
+	return KDFParams{
+		Type:    Argon2id,
+		Time:    defaultArgon2Time,
+		Memory:  defaultArgon2Memory,
+		Threads: defaultArgon2Threads,
+	}
+}
+
+// DefaultScryptParams returns the default scrypt parameters
+func DefaultScryptParams() KDFParams {
+	return KDFParams{
+		Type: Scrypt,
+		N:    defaultScryptN,
+		R:    defaultScryptR,
+		P:    defaultScryptP,
+	}
+}
+
+func (params KDFParams) check() error {
+	switch params.Type {
+	case Argon2id:
+		if params.Time < 1 || params.Threads < 1 {
+			return fmt.Errorf("%w: time and threads should be positive", ErrInvalidKDFParams)
+		}
+		if params.Memory < 8*uint32(params.Threads) {
+			return fmt.Errorf("%w: memory should be at least 8 KiB per thread", ErrInvalidKDFParams)
+		}
+	case Scrypt:
+		isPowerOfTwo := params.N > 1 && params.N&(params.N-1) == 0
+		if !isPowerOfTwo || params.R < 1 || params.P < 1 {
+			return fmt.Errorf("%w: N should be a power of 2 greater than 1, R and P should be positive", ErrInvalidKDFParams)
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedKDF, params.Type)
+	}
+
+	return nil
+}
+
+func (params KDFParams) deriveKey(password string, salt []byte) ([]byte, error) {
+	err := params.check()
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Type == Argon2id {
+		return argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, masterKeyLength), nil
+	}
+
+	return scrypt.Key([]byte(password), salt, params.N, params.R, params.P, masterKeyLength)
+}
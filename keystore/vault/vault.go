@@ -0,0 +1,585 @@
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	vaultVersion    = 1
+	vaultCipher     = "aes-256-gcm"
+	secretKeyLength = 32
+	vaultFileMode   = 0600
+)
+
+var (
+	log    = logger.GetOrCreate("mx-sdk-go/keystore/vault")
+	keyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+)
+
+// KeyInfo holds the public information of a key stored in the vault
+type KeyInfo struct {
+	Address string
+	Label   string
+}
+
+// KeyEntry holds a secret key with its label, used when adding keys to the vault
+type KeyEntry struct {
+	Label     string
+	SecretKey []byte
+}
+
+type vaultHeader struct {
+	Version int       `json:"version"`
+	KDF     KDFParams `json:"kdf"`
+	Salt    string    `json:"salt"`
+	Cipher  string    `json:"cipher"`
+}
+
+type vaultFile struct {
+	vaultHeader
+	Nonce      string `json:"nonce"`
+	CipherText string `json:"ciphertext"`
+}
+
+type storedKey struct {
+	Address   string `json:"address"`
+	Label     string `json:"label,omitempty"`
+	SecretKey string `json:"secretKey"`
+}
+
+type keyData struct {
+	label     string
+	secretKey []byte
+}
+
+// vault stores many secret keys in a single file, encrypted with AES-GCM using a master key derived from a password.
+// All the changes are persisted right away
+type vault struct {
+	mut       sync.RWMutex
+	filename  string
+	header    vaultHeader
+	masterKey []byte
+	keys      map[string]*keyData
+	labels    map[string]string
+}
+
+// CreateVault creates a new empty vault file protected by the provided password
+func CreateVault(filename string, password string, params KDFParams) (*vault, error) {
+	if len(filename) == 0 {
+		return nil, ErrEmptyFilename
+	}
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	_, err := os.Stat(filename)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrVaultAlreadyExists, filename)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	v := &vault{
+		filename: filename,
+		keys:     make(map[string]*keyData),
+		labels:   make(map[string]string),
+	}
+	err = v.setPassword(password, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.save()
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// OpenVault opens an existing vault file and decrypts its keys
+func OpenVault(filename string, password string) (*vault, error) {
+	if len(filename) == 0 {
+		return nil, ErrEmptyFilename
+	}
+
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &vaultFile{}
+	err = json.Unmarshal(buff, file)
+	if err != nil {
+		return nil, err
+	}
+	if file.Version != vaultVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, file.Version)
+	}
+	if file.Cipher != vaultCipher {
+		return nil, fmt.Errorf("%w: cipher %q", ErrUnsupportedVersion, file.Cipher)
+	}
+
+	salt, err := hex.DecodeString(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+	masterKey, err := file.KDF.deriveKey(password, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := decrypt(masterKey, file)
+	if err != nil {
+		return nil, err
+	}
+
+	storedKeys := make([]*storedKey, 0)
+	err = json.Unmarshal(plainText, &storedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &vault{
+		filename:  filename,
+		header:    file.vaultHeader,
+		masterKey: masterKey,
+		keys:      make(map[string]*keyData, len(storedKeys)),
+		labels:    make(map[string]string),
+	}
+	for _, key := range storedKeys {
+		secretKey, errDecode := hex.DecodeString(key.SecretKey)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for address %s", errDecode, key.Address)
+		}
+
+		v.keys[key.Address] = &keyData{
+			label:     key.Label,
+			secretKey: secretKey,
+		}
+		if len(key.Label) > 0 {
+			v.labels[key.Label] = key.Address
+		}
+	}
+
+	log.Debug("opened vault", "filename", filename, "num keys", len(v.keys))
+
+	return v, nil
+}
+
+// AddKey adds the secret key with the provided label, that can be empty, and returns its bech32 address
+func (v *vault) AddKey(label string, secretKey []byte) (string, error) {
+	addresses, err := v.AddKeys([]KeyEntry{{Label: label, SecretKey: secretKey}})
+	if err != nil {
+		return "", err
+	}
+
+	return addresses[0], nil
+}
+
+// AddKeys adds all the provided keys with a single vault file write and returns their bech32 addresses. Either all
+// keys are added or none
+func (v *vault) AddKeys(entries []KeyEntry) ([]string, error) {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	addresses := make([]string, 0, len(entries))
+	newKeys := make(map[string]*keyData, len(entries))
+	newLabels := make(map[string]string)
+	for index, entry := range entries {
+		secretKey, address, err := checkSecretKey(entry.SecretKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w at index %d", err, index)
+		}
+
+		_, exists := v.keys[address]
+		_, isNew := newKeys[address]
+		if exists || isNew {
+			return nil, fmt.Errorf("%w: %s", ErrKeyAlreadyExists, address)
+		}
+
+		if len(entry.Label) > 0 {
+			_, labelExists := v.labels[entry.Label]
+			_, isNewLabel := newLabels[entry.Label]
+			if labelExists || isNewLabel {
+				return nil, fmt.Errorf("%w: %s", ErrLabelAlreadyUsed, entry.Label)
+			}
+			newLabels[entry.Label] = address
+		}
+
+		newKeys[address] = &keyData{
+			label:     entry.Label,
+			secretKey: secretKey,
+		}
+		addresses = append(addresses, address)
+	}
+
+	for address, key := range newKeys {
+		v.keys[address] = key
+	}
+	for label, address := range newLabels {
+		v.labels[label] = address
+	}
+
+	err := v.save()
+	if err != nil {
+		for address, key := range newKeys {
+			delete(v.keys, address)
+			delete(v.labels, key.label)
+		}
+
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// RemoveKey removes the key of the provided bech32 address
+func (v *vault) RemoveKey(address string) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	key, found := v.keys[address]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, address)
+	}
+
+	delete(v.keys, address)
+	delete(v.labels, key.label)
+
+	err := v.save()
+	if err != nil {
+		v.keys[address] = key
+		if len(key.label) > 0 {
+			v.labels[key.label] = address
+		}
+	}
+
+	return err
+}
+
+// SetLabel changes the label of the key of the provided bech32 address. An empty label removes the current one
+func (v *vault) SetLabel(address string, label string) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	key, found := v.keys[address]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, address)
+	}
+	if key.label == label {
+		return nil
+	}
+	if len(label) > 0 {
+		_, labelExists := v.labels[label]
+		if labelExists {
+			return fmt.Errorf("%w: %s", ErrLabelAlreadyUsed, label)
+		}
+	}
+
+	oldLabel := key.label
+	v.setLabelUnprotected(address, key, label)
+
+	err := v.save()
+	if err != nil {
+		v.setLabelUnprotected(address, key, oldLabel)
+	}
+
+	return err
+}
+
+func (v *vault) setLabelUnprotected(address string, key *keyData, label string) {
+	delete(v.labels, key.label)
+	key.label = label
+	if len(label) > 0 {
+		v.labels[label] = address
+	}
+}
+
+// GetSecretKey returns the secret key of the provided bech32 address
+func (v *vault) GetSecretKey(address string) ([]byte, error) {
+	v.mut.RLock()
+	defer v.mut.RUnlock()
+
+	key, found := v.keys[address]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, address)
+	}
+
+	return copyBytes(key.secretKey), nil
+}
+
+// GetKeyByLabel returns the bech32 address and the secret key with the provided label
+func (v *vault) GetKeyByLabel(label string) (string, []byte, error) {
+	v.mut.RLock()
+	defer v.mut.RUnlock()
+
+	address, found := v.labels[label]
+	if !found {
+		return "", nil, fmt.Errorf("%w for label %s", ErrKeyNotFound, label)
+	}
+
+	return address, copyBytes(v.keys[address].secretKey), nil
+}
+
+// Keys returns the addresses and labels of all keys, sorted by address
+func (v *vault) Keys() []KeyInfo {
+	v.mut.RLock()
+	defer v.mut.RUnlock()
+
+	keys := make([]KeyInfo, 0, len(v.keys))
+	for address, key := range v.keys {
+		keys = append(keys, KeyInfo{
+			Address: address,
+			Label:   key.label,
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Address < keys[j].Address
+	})
+
+	return keys
+}
+
+// Len returns the number of keys stored in the vault
+func (v *vault) Len() int {
+	v.mut.RLock()
+	defer v.mut.RUnlock()
+
+	return len(v.keys)
+}
+
+// ChangePassword re-encrypts the vault with a master key derived from the new password, using a new salt and the
+// provided key derivation function parameters
+func (v *vault) ChangePassword(newPassword string, params KDFParams) error {
+	if len(newPassword) == 0 {
+		return ErrEmptyPassword
+	}
+
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	oldHeader, oldMasterKey := v.header, v.masterKey
+	err := v.setPassword(newPassword, params)
+	if err != nil {
+		return err
+	}
+
+	err = v.save()
+	if err != nil {
+		v.header, v.masterKey = oldHeader, oldMasterKey
+	}
+
+	return err
+}
+
+// IsTrackableAddresses returns true if the vault holds the key of the provided bech32 address
+func (v *vault) IsTrackableAddresses(addressAsBech32 string) bool {
+	v.mut.RLock()
+	defer v.mut.RUnlock()
+
+	_, found := v.keys[addressAsBech32]
+
+	return found
+}
+
+// PrivateKeyOfBech32Address returns the secret key of the provided bech32 address or nil if it is not found
+func (v *vault) PrivateKeyOfBech32Address(addressAsBech32 string) []byte {
+	secretKey, err := v.GetSecretKey(addressAsBech32)
+	if err != nil {
+		return nil
+	}
+
+	return secretKey
+}
+
+func (v *vault) setPassword(password string, params KDFParams) error {
+	salt := make([]byte, saltLength)
+	_, err := io.ReadFull(rand.Reader, salt)
+	if err != nil {
+		return err
+	}
+
+	masterKey, err := params.deriveKey(password, salt)
+	if err != nil {
+		return err
+	}
+
+	v.header = vaultHeader{
+		Version: vaultVersion,
+		KDF:     params,
+		Salt:    hex.EncodeToString(salt),
+		Cipher:  vaultCipher,
+	}
+	v.masterKey = masterKey
+
+	return nil
+}
+
+// save writes the vault in a temporary file that replaces the vault file, so a failed write does not corrupt it
+func (v *vault) save() error {
+	storedKeys := make([]*storedKey, 0, len(v.keys))
+	for address, key := range v.keys {
+		storedKeys = append(storedKeys, &storedKey{
+			Address:   address,
+			Label:     key.label,
+			SecretKey: hex.EncodeToString(key.secretKey),
+		})
+	}
+	sort.Slice(storedKeys, func(i, j int) bool {
+		return storedKeys[i].Address < storedKeys[j].Address
+	})
+
+	plainText, err := json.Marshal(storedKeys)
+	if err != nil {
+		return err
+	}
+
+	file, err := encrypt(v.masterKey, v.header, plainText)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(v.filename), filepath.Base(v.filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tempFilename := tempFile.Name()
+
+	_, err = tempFile.Write(buff)
+	errClose := tempFile.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = os.Chmod(tempFilename, vaultFileMode)
+	}
+	if err != nil {
+		_ = os.Remove(tempFilename)
+		return err
+	}
+
+	return os.Rename(tempFilename, v.filename)
+}
+
+func encrypt(masterKey []byte, header vaultHeader, plainText []byte) (*vaultFile, error) {
+	aead, err := createAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalData, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vaultFile{
+		vaultHeader: header,
+		Nonce:       hex.EncodeToString(nonce),
+		CipherText:  hex.EncodeToString(aead.Seal(nil, nonce, plainText, additionalData)),
+	}, nil
+}
+
+func decrypt(masterKey []byte, file *vaultFile) ([]byte, error) {
+	aead, err := createAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalData, err := json.Marshal(file.vaultHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := hex.DecodeString(file.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrWrongPassword
+	}
+
+	cipherText, err := hex.DecodeString(file.CipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := aead.Open(nil, nonce, cipherText, additionalData)
+	if err != nil {
+		return nil, ErrWrongPassword
+	}
+
+	return plainText, nil
+}
+
+func createAEAD(masterKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// checkSecretKey accepts the 32 bytes secret keys and the 64 bytes secret keys that have the public key appended,
+// as found in the PEM files
+func checkSecretKey(secretKey []byte) ([]byte, string, error) {
+	if len(secretKey) != secretKeyLength && len(secretKey) != 2*secretKeyLength {
+		return nil, "", fmt.Errorf("%w: length %d", ErrInvalidSecretKey, len(secretKey))
+	}
+
+	secretKey = copyBytes(secretKey[:secretKeyLength])
+	privateKey, err := keyGen.PrivateKeyFromByteArray(secretKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %s", ErrInvalidSecretKey, err.Error())
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, "", err
+	}
+
+	address, err := data.NewAddressFromBytes(publicKeyBytes).AddressAsBech32String()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return secretKey, address, nil
+}
+
+func copyBytes(buff []byte) []byte {
+	return append(make([]byte, 0, len(buff)), buff...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (v *vault) IsInterfaceNil() bool {
+	return v == nil
+}
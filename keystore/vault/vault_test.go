@@ -0,0 +1,323 @@
+package vault
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	firstSecretKey  = "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68"
+	firstAddress    = "erd1lta2vgd0tkeqqadkvgef73y0efs6n3xe5ss589ufhvmt6tcur8kq34qkwr"
+	secondSecretKey = "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c40d248169f4dd7c90537f05be1c49772ddbf8f7948b507ed17fb23284cf218b7d"
+	secondAddress   = "erd1p5jgz605m47fq5mlqklpcjth9hdl3au53dg8a5tlkgegfnep3d7stdk09x"
+	testPassword    = "password"
+)
+
+var _ workflows.TrackableAddressesProvider = (*vault)(nil)
+
+func fastArgon2idParams() KDFParams {
+	return KDFParams{
+		Type:    Argon2id,
+		Time:    1,
+		Memory:  64,
+		Threads: 1,
+	}
+}
+
+func fastScryptParams() KDFParams {
+	return KDFParams{
+		Type: Scrypt,
+		N:    16,
+		R:    1,
+		P:    1,
+	}
+}
+
+func decodeHex(tb testing.TB, hexString string) []byte {
+	buff, err := hex.DecodeString(hexString)
+	require.Nil(tb, err)
+
+	return buff
+}
+
+func createTestVault(tb testing.TB) (*vault, string) {
+	filename := path.Join(tb.TempDir(), "vault.json")
+	v, err := CreateVault(filename, testPassword, fastArgon2idParams())
+	require.Nil(tb, err)
+
+	return v, filename
+}
+
+func TestCreateVault(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty filename should error", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := CreateVault("", testPassword, fastArgon2idParams())
+		assert.Nil(t, v)
+		assert.Equal(t, ErrEmptyFilename, err)
+	})
+	t.Run("empty password should error", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := CreateVault(path.Join(t.TempDir(), "vault.json"), "", fastArgon2idParams())
+		assert.Nil(t, v)
+		assert.Equal(t, ErrEmptyPassword, err)
+	})
+	t.Run("invalid KDF params should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "vault.json")
+		v, err := CreateVault(filename, testPassword, KDFParams{Type: "pbkdf2"})
+		assert.Nil(t, v)
+		assert.True(t, errors.Is(err, ErrUnsupportedKDF))
+
+		v, err = CreateVault(filename, testPassword, KDFParams{Type: Argon2id, Time: 1, Memory: 4, Threads: 1})
+		assert.Nil(t, v)
+		assert.True(t, errors.Is(err, ErrInvalidKDFParams))
+
+		v, err = CreateVault(filename, testPassword, KDFParams{Type: Scrypt, N: 15, R: 1, P: 1})
+		assert.Nil(t, v)
+		assert.True(t, errors.Is(err, ErrInvalidKDFParams))
+	})
+	t.Run("existing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, filename := createTestVault(t)
+		v, err := CreateVault(filename, testPassword, fastArgon2idParams())
+		assert.Nil(t, v)
+		assert.True(t, errors.Is(err, ErrVaultAlreadyExists))
+	})
+	t.Run("should work with both KDFs", func(t *testing.T) {
+		t.Parallel()
+
+		for _, params := range []KDFParams{fastArgon2idParams(), fastScryptParams()} {
+			filename := path.Join(t.TempDir(), "vault.json")
+			v, err := CreateVault(filename, testPassword, params)
+			require.Nil(t, err)
+			assert.False(t, v.IsInterfaceNil())
+			assert.Equal(t, 0, v.Len())
+
+			info, err := os.Stat(filename)
+			require.Nil(t, err)
+			assert.Equal(t, os.FileMode(vaultFileMode), info.Mode().Perm())
+
+			_, err = v.AddKey("first", decodeHex(t, firstSecretKey))
+			require.Nil(t, err)
+
+			reopened, err := OpenVault(filename, testPassword)
+			require.Nil(t, err)
+			assert.Equal(t, []KeyInfo{{Address: firstAddress, Label: "first"}}, reopened.Keys())
+		}
+	})
+}
+
+func TestOpenVault(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty filename should error", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := OpenVault("", testPassword)
+		assert.Nil(t, v)
+		assert.Equal(t, ErrEmptyFilename, err)
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := OpenVault(path.Join(t.TempDir(), "missing.json"), testPassword)
+		assert.Nil(t, v)
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, filename := createTestVault(t)
+		v, err := OpenVault(filename, "wrong")
+		assert.Nil(t, v)
+		assert.Equal(t, ErrWrongPassword, err)
+	})
+	t.Run("altered header should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, filename := createTestVault(t)
+		buff, _ := os.ReadFile(filename)
+		file := &vaultFile{}
+		_ = json.Unmarshal(buff, file)
+		file.KDF.Time++
+		buff, _ = json.Marshal(file)
+		_ = os.WriteFile(filename, buff, vaultFileMode)
+
+		v, err := OpenVault(filename, testPassword)
+		assert.Nil(t, v)
+		assert.Equal(t, ErrWrongPassword, err)
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, filename := createTestVault(t)
+		buff, _ := os.ReadFile(filename)
+		file := &vaultFile{}
+		_ = json.Unmarshal(buff, file)
+		file.Version = 2
+		buff, _ = json.Marshal(file)
+		_ = os.WriteFile(filename, buff, vaultFileMode)
+
+		v, err := OpenVault(filename, testPassword)
+		assert.Nil(t, v)
+		assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+	})
+}
+
+func TestVault_AddKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid secret key should error", func(t *testing.T) {
+		t.Parallel()
+
+		v, _ := createTestVault(t)
+		address, err := v.AddKey("", []byte("short"))
+		assert.Empty(t, address)
+		assert.True(t, errors.Is(err, ErrInvalidSecretKey))
+	})
+	t.Run("duplicate key should error", func(t *testing.T) {
+		t.Parallel()
+
+		v, _ := createTestVault(t)
+		_, err := v.AddKey("", decodeHex(t, firstSecretKey))
+		require.Nil(t, err)
+
+		_, err = v.AddKey("", decodeHex(t, firstSecretKey))
+		assert.True(t, errors.Is(err, ErrKeyAlreadyExists))
+
+		_, err = v.AddKeys([]KeyEntry{{SecretKey: decodeHex(t, secondSecretKey)}, {SecretKey: decodeHex(t, secondSecretKey)}})
+		assert.True(t, errors.Is(err, ErrKeyAlreadyExists))
+		assert.Equal(t, 1, v.Len())
+	})
+	t.Run("duplicate label should error", func(t *testing.T) {
+		t.Parallel()
+
+		v, _ := createTestVault(t)
+		_, err := v.AddKeys([]KeyEntry{
+			{Label: "label", SecretKey: decodeHex(t, firstSecretKey)},
+			{Label: "label", SecretKey: decodeHex(t, secondSecretKey)},
+		})
+		assert.True(t, errors.Is(err, ErrLabelAlreadyUsed))
+		assert.Equal(t, 0, v.Len())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		v, filename := createTestVault(t)
+		addresses, err := v.AddKeys([]KeyEntry{
+			{Label: "deposit 1", SecretKey: decodeHex(t, firstSecretKey)},
+			{SecretKey: decodeHex(t, secondSecretKey)},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{firstAddress, secondAddress}, addresses)
+
+		reopened, err := OpenVault(filename, testPassword)
+		require.Nil(t, err)
+		assert.Equal(t, []KeyInfo{{Address: firstAddress, Label: "deposit 1"}, {Address: secondAddress}}, reopened.Keys())
+
+		secretKey, err := reopened.GetSecretKey(secondAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, decodeHex(t, secondSecretKey)[:secretKeyLength], secretKey)
+
+		address, secretKey, err := reopened.GetKeyByLabel("deposit 1")
+		assert.Nil(t, err)
+		assert.Equal(t, firstAddress, address)
+		assert.Equal(t, decodeHex(t, firstSecretKey), secretKey)
+	})
+}
+
+func TestVault_RemoveKeyAndSetLabel(t *testing.T) {
+	t.Parallel()
+
+	v, filename := createTestVault(t)
+	_, err := v.AddKeys([]KeyEntry{
+		{Label: "first", SecretKey: decodeHex(t, firstSecretKey)},
+		{Label: "second", SecretKey: decodeHex(t, secondSecretKey)},
+	})
+	require.Nil(t, err)
+
+	err = v.SetLabel("erd1unknown", "label")
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+
+	err = v.SetLabel(firstAddress, "second")
+	assert.True(t, errors.Is(err, ErrLabelAlreadyUsed))
+
+	err = v.SetLabel(firstAddress, "renamed")
+	assert.Nil(t, err)
+	_, _, err = v.GetKeyByLabel("first")
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+
+	err = v.RemoveKey("erd1unknown")
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+
+	err = v.RemoveKey(secondAddress)
+	assert.Nil(t, err)
+	_, _, err = v.GetKeyByLabel("second")
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+
+	reopened, err := OpenVault(filename, testPassword)
+	require.Nil(t, err)
+	assert.Equal(t, []KeyInfo{{Address: firstAddress, Label: "renamed"}}, reopened.Keys())
+}
+
+func TestVault_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	v, filename := createTestVault(t)
+	_, err := v.AddKey("first", decodeHex(t, firstSecretKey))
+	require.Nil(t, err)
+
+	err = v.ChangePassword("", fastScryptParams())
+	assert.Equal(t, ErrEmptyPassword, err)
+
+	err = v.ChangePassword("new password", KDFParams{Type: Scrypt})
+	assert.True(t, errors.Is(err, ErrInvalidKDFParams))
+
+	err = v.ChangePassword("new password", fastScryptParams())
+	require.Nil(t, err)
+
+	_, err = OpenVault(filename, testPassword)
+	assert.Equal(t, ErrWrongPassword, err)
+
+	reopened, err := OpenVault(filename, "new password")
+	require.Nil(t, err)
+	assert.Equal(t, Scrypt, reopened.header.KDF.Type)
+	assert.Equal(t, v.Keys(), reopened.Keys())
+
+	_, err = v.AddKey("second", decodeHex(t, secondSecretKey))
+	require.Nil(t, err)
+	reopened, err = OpenVault(filename, "new password")
+	require.Nil(t, err)
+	assert.Equal(t, 2, reopened.Len())
+
+	entries, err := os.ReadDir(path.Dir(filename))
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+}
+
+func TestVault_TrackableAddressesProvider(t *testing.T) {
+	t.Parallel()
+
+	v, _ := createTestVault(t)
+	_, err := v.AddKey("", decodeHex(t, firstSecretKey))
+	require.Nil(t, err)
+
+	assert.True(t, v.IsTrackableAddresses(firstAddress))
+	assert.False(t, v.IsTrackableAddresses(secondAddress))
+	assert.Equal(t, decodeHex(t, firstSecretKey), v.PrivateKeyOfBech32Address(firstAddress))
+	assert.Nil(t, v.PrivateKeyOfBech32Address(secondAddress))
+}
@@ -4,19 +4,13 @@ import (
 	"encoding/hex"
 	"log"
 
-	"github.com/multiversx/mx-chain-crypto-go/signing"
-	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
-	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider/bls"
 )
 
-var (
-	keyGenerator = signing.NewKeyGenerator(mcl.NewSuiteBLS12())
-	blsSigner    = singlesig.BlsSingleSigner{}
-)
+var blsSigner = bls.NewSigner()
 
 func doGeneratePrivateKeyAsHex() string {
-	privateKey, _ := keyGenerator.GeneratePair()
-	privateKeyBytes, err := privateKey.ToByteArray()
+	privateKeyBytes, err := blsSigner.GeneratePrivateKey()
 	if err != nil {
 		log.Println("doGeneratePrivateKey(): error when decoding the private key", err)
 		return ""
@@ -31,16 +25,9 @@ func doGeneratePublicKeyAsHex(privateKeyHex string) string {
 		return ""
 	}
 
-	privateKey, err := keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	publicKeyBytes, err := blsSigner.GeneratePublicKey(privateKeyBytes)
 	if err != nil {
-		log.Println("doGeneratePublicKeyAsHex(): error when creating the private key", err)
-		return ""
-	}
-
-	publicKey := privateKey.GeneratePublic()
-	publicKeyBytes, err := publicKey.ToByteArray()
-	if err != nil {
-		log.Println("doGeneratePublicKeyAsHex(): error when decoding the public key", err)
+		log.Println("doGeneratePublicKeyAsHex(): error when generating the public key", err)
 		return ""
 	}
 
@@ -58,13 +45,7 @@ func doComputeMessageSignatureAsHex(messageHex string, privateKeyHex string) str
 		return ""
 	}
 
-	privateKey, err := keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
-	if err != nil {
-		log.Println("doComputeMessageSignatureAsHex(): error when creating the private key", err)
-		return ""
-	}
-
-	signature, err := blsSigner.Sign(privateKey, message)
+	signature, err := blsSigner.Sign(message, privateKeyBytes)
 	if err != nil {
 		log.Println("doComputeMessageSignatureAsHex(): error when signing the message", err)
 		return ""
@@ -89,13 +70,7 @@ func doVerifyMessageSignature(publicKeyHex string, messageHex string, signatureH
 		return false
 	}
 
-	publicKey, err := keyGenerator.PublicKeyFromByteArray(publicKeyBytes)
-	if err != nil {
-		log.Println("doVerifyMessageSignature(): error when creating the public key", err)
-		return false
-	}
-
-	err = blsSigner.Verify(publicKey, message, signature)
+	err := blsSigner.Verify(publicKeyBytes, message, signature)
 	return err == nil
 }
 
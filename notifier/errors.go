@@ -0,0 +1,15 @@
+package notifier
+
+import "errors"
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrClientClosed signals that the client was closed
+var ErrClientClosed = errors.New("notifier client closed")
+
+// ErrUnknownEventType signals that an unknown event type was received
+var ErrUnknownEventType = errors.New("unknown event type")
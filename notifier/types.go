@@ -0,0 +1,65 @@
+package notifier
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// The event types pushed by the notifier service over WebSocket
+const (
+	BlockEventsType     = "block_events"
+	RevertEventsType    = "revert_events"
+	FinalizedEventsType = "finalized_events"
+)
+
+const subscribeDispatchType = "subscribe"
+
+// SubscriptionEntry defines a subscription sent to the notifier service
+type SubscriptionEntry struct {
+	EventType  string   `json:"eventType,omitempty"`
+	Address    string   `json:"address,omitempty"`
+	Identifier string   `json:"identifier,omitempty"`
+	Topics     []string `json:"topics,omitempty"`
+}
+
+type subscribeMessage struct {
+	DispatchType        string              `json:"dispatchType"`
+	SubscriptionEntries []SubscriptionEntry `json:"subscriptionEntries"`
+}
+
+type wsMessage struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type wsEvent struct {
+	Address        string   `json:"address"`
+	Identifier     string   `json:"identifier"`
+	Topics         [][]byte `json:"topics"`
+	Data           []byte   `json:"data"`
+	AdditionalData [][]byte `json:"additionalData"`
+	TxHash         string   `json:"txHash"`
+}
+
+type wsBlockEvents struct {
+	Hash      string     `json:"hash"`
+	ShardID   uint32     `json:"shardId"`
+	TimeStamp uint64     `json:"timestamp"`
+	Events    []*wsEvent `json:"events"`
+}
+
+// payloadBytes returns the JSON payload of the message. Some notifier versions send the payload as a base64
+// encoded byte slice, which is JSON encoded as a string
+func (message *wsMessage) payloadBytes() ([]byte, error) {
+	if len(message.Data) == 0 || message.Data[0] != '"' {
+		return message.Data, nil
+	}
+
+	var encoded string
+	err := json.Unmarshal(message.Data, &encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(encoded)
+}
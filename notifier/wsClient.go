@@ -0,0 +1,308 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const minimumRetryInterval = time.Millisecond
+
+var log = logger.GetOrCreate("mx-sdk-go/notifier")
+
+// ArgsWsClient is the DTO used in the NewWsClient constructor function
+type ArgsWsClient struct {
+	// URL is the WebSocket endpoint of the notifier service, e.g. ws://localhost:5000/hub/ws
+	URL string
+	// SubscriptionEntries are sent to the notifier after each connection. If empty, the client subscribes to the
+	// block, revert and finalized events
+	SubscriptionEntries []SubscriptionEntry
+	ChannelSize         int
+	RetryInterval       time.Duration
+}
+
+// wsClient is able to connect to a notifier service WebSocket endpoint and deliver the pushed save block,
+// revert block and finalized block events on dedicated channels. The client reconnects automatically after
+// connection errors. Slow readers will delay the reading of the next messages
+type wsClient struct {
+	url                 string
+	subscriptionEntries []SubscriptionEntry
+	retryInterval       time.Duration
+
+	chSaveBlocks      chan *data.SaveBlock
+	chRevertBlocks    chan *data.RevertBlock
+	chFinalizedBlocks chan *data.FinalizedBlock
+
+	mutState sync.Mutex
+	conn     *websocket.Conn
+	started  bool
+	closed   bool
+
+	ctx       context.Context
+	cancel    func()
+	loopGroup sync.WaitGroup
+}
+
+// NewWsClient creates a new instance of the wsClient type
+func NewWsClient(args ArgsWsClient) (*wsClient, error) {
+	err := checkArgsWsClient(args)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := args.SubscriptionEntries
+	if len(entries) == 0 {
+		entries = []SubscriptionEntry{
+			{EventType: BlockEventsType},
+			{EventType: RevertEventsType},
+			{EventType: FinalizedEventsType},
+		}
+	}
+
+	client := &wsClient{
+		url:                 args.URL,
+		subscriptionEntries: entries,
+		retryInterval:       args.RetryInterval,
+		chSaveBlocks:        make(chan *data.SaveBlock, args.ChannelSize),
+		chRevertBlocks:      make(chan *data.RevertBlock, args.ChannelSize),
+		chFinalizedBlocks:   make(chan *data.FinalizedBlock, args.ChannelSize),
+	}
+	client.ctx, client.cancel = context.WithCancel(context.Background())
+
+	return client, nil
+}
+
+func checkArgsWsClient(args ArgsWsClient) error {
+	if len(args.URL) == 0 {
+		return ErrEmptyURL
+	}
+	if args.ChannelSize < 0 {
+		return fmt.Errorf("%w for ChannelSize, provided: %d", ErrInvalidValue, args.ChannelSize)
+	}
+	if args.RetryInterval < minimumRetryInterval {
+		return fmt.Errorf("%w for RetryInterval", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// Start connects to the notifier service and starts delivering the pushed events. It can be called only once
+func (client *wsClient) Start() error {
+	client.mutState.Lock()
+	defer client.mutState.Unlock()
+
+	if client.closed {
+		return ErrClientClosed
+	}
+	if client.started {
+		return nil
+	}
+	client.started = true
+
+	client.loopGroup.Add(1)
+	go client.processLoop()
+
+	return nil
+}
+
+// SaveBlocks returns the channel on which the save block events are delivered
+func (client *wsClient) SaveBlocks() <-chan *data.SaveBlock {
+	return client.chSaveBlocks
+}
+
+// RevertBlocks returns the channel on which the revert block events are delivered
+func (client *wsClient) RevertBlocks() <-chan *data.RevertBlock {
+	return client.chRevertBlocks
+}
+
+// FinalizedBlocks returns the channel on which the finalized block events are delivered
+func (client *wsClient) FinalizedBlocks() <-chan *data.FinalizedBlock {
+	return client.chFinalizedBlocks
+}
+
+func (client *wsClient) processLoop() {
+	defer client.loopGroup.Done()
+
+	timer := time.NewTimer(client.retryInterval)
+	defer timer.Stop()
+
+	for {
+		err := client.connectAndListen()
+		if client.ctx.Err() != nil {
+			return
+		}
+		log.Debug("wsClient: connection error, reconnecting", "url", client.url, "error", err)
+
+		timer.Reset(client.retryInterval)
+		select {
+		case <-timer.C:
+		case <-client.ctx.Done():
+			return
+		}
+	}
+}
+
+func (client *wsClient) connectAndListen() error {
+	conn, _, err := websocket.DefaultDialer.DialContext(client.ctx, client.url, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	client.mutState.Lock()
+	if client.closed {
+		client.mutState.Unlock()
+		return ErrClientClosed
+	}
+	client.conn = conn
+	client.mutState.Unlock()
+
+	subscription, err := json.Marshal(&subscribeMessage{
+		DispatchType:        subscribeDispatchType,
+		SubscriptionEntries: client.subscriptionEntries,
+	})
+	if err != nil {
+		return err
+	}
+	err = conn.WriteMessage(websocket.TextMessage, subscription)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("wsClient: connected", "url", client.url)
+	for {
+		_, message, errRead := conn.ReadMessage()
+		if errRead != nil {
+			return errRead
+		}
+
+		errProcess := client.processMessage(message)
+		if errProcess != nil {
+			log.Debug("wsClient: error processing message", "error", errProcess)
+		}
+	}
+}
+
+func (client *wsClient) processMessage(buff []byte) error {
+	message := &wsMessage{}
+	err := json.Unmarshal(buff, message)
+	if err != nil {
+		return err
+	}
+
+	payload, err := message.payloadBytes()
+	if err != nil {
+		return err
+	}
+
+	switch message.Type {
+	case BlockEventsType:
+		return client.processBlockEvents(payload)
+	case RevertEventsType:
+		revertBlock := &data.RevertBlock{}
+		err = json.Unmarshal(payload, revertBlock)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case client.chRevertBlocks <- revertBlock:
+		case <-client.ctx.Done():
+		}
+		return nil
+	case FinalizedEventsType:
+		finalizedBlock := &data.FinalizedBlock{}
+		err = json.Unmarshal(payload, finalizedBlock)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case client.chFinalizedBlocks <- finalizedBlock:
+		case <-client.ctx.Done():
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownEventType, message.Type)
+	}
+}
+
+func (client *wsClient) processBlockEvents(payload []byte) error {
+	blockEvents := &wsBlockEvents{}
+	err := json.Unmarshal(payload, blockEvents)
+	if err != nil {
+		return err
+	}
+
+	saveBlock := &data.SaveBlock{
+		Hash:      blockEvents.Hash,
+		ShardID:   blockEvents.ShardID,
+		TimeStamp: blockEvents.TimeStamp,
+		Events:    make([]*data.LogEvent, 0, len(blockEvents.Events)),
+	}
+	eventIndexes := make(map[string]int)
+	for _, event := range blockEvents.Events {
+		if event == nil {
+			continue
+		}
+
+		index := eventIndexes[event.TxHash]
+		eventIndexes[event.TxHash] = index + 1
+		saveBlock.Events = append(saveBlock.Events, &data.LogEvent{
+			ShardID:    blockEvents.ShardID,
+			BlockHash:  blockEvents.Hash,
+			TxHash:     event.TxHash,
+			EventIndex: index,
+			Event: &transaction.Events{
+				Address:        event.Address,
+				Identifier:     event.Identifier,
+				Topics:         event.Topics,
+				Data:           event.Data,
+				AdditionalData: event.AdditionalData,
+			},
+		})
+	}
+
+	select {
+	case client.chSaveBlocks <- saveBlock:
+	case <-client.ctx.Done():
+	}
+
+	return nil
+}
+
+// Close closes the connection and the events channels
+func (client *wsClient) Close() error {
+	client.mutState.Lock()
+	if client.closed {
+		client.mutState.Unlock()
+		return nil
+	}
+	client.closed = true
+	client.cancel()
+	if client.conn != nil {
+		_ = client.conn.Close()
+	}
+	client.mutState.Unlock()
+
+	client.loopGroup.Wait()
+	close(client.chSaveBlocks)
+	close(client.chRevertBlocks)
+	close(client.chFinalizedBlocks)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *wsClient) IsInterfaceNil() bool {
+	return client == nil
+}
@@ -0,0 +1,273 @@
+package notifier
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = time.Second * 5
+
+func createMockArgsWsClient(url string) ArgsWsClient {
+	return ArgsWsClient{
+		URL:           url,
+		ChannelSize:   10,
+		RetryInterval: time.Millisecond * 10,
+	}
+}
+
+func waitSignal(tb testing.TB, ch <-chan struct{}) {
+	select {
+	case <-ch:
+	case <-time.After(testTimeout):
+		require.Fail(tb, "timeout waiting for the server signal")
+	}
+}
+
+func createMessage(tb testing.TB, messageType string, payload string) []byte {
+	buff, err := json.Marshal(map[string]interface{}{
+		"type": messageType,
+		"data": json.RawMessage(payload),
+	})
+	require.Nil(tb, err)
+
+	return buff
+}
+
+func TestNewWsClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewWsClient(createMockArgsWsClient(""))
+		assert.Nil(t, client)
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("invalid channel size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWsClient("ws://localhost")
+		args.ChannelSize = -1
+		client, err := NewWsClient(args)
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "ChannelSize")
+	})
+	t.Run("invalid retry interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWsClient("ws://localhost")
+		args.RetryInterval = 0
+		client, err := NewWsClient(args)
+		assert.Nil(t, client)
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "RetryInterval")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewWsClient(createMockArgsWsClient("ws://localhost"))
+		assert.Nil(t, err)
+		assert.False(t, client.IsInterfaceNil())
+		assert.Nil(t, client.Close())
+	})
+}
+
+func TestWsClient_SubscribesOnConnect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		serverMock := server.NewWebSocketServerMock()
+		defer serverMock.Close()
+
+		client, _ := NewWsClient(createMockArgsWsClient(serverMock.URL()))
+		defer func() {
+			_ = client.Close()
+		}()
+		require.Nil(t, client.Start())
+		waitSignal(t, serverMock.Received())
+
+		expected := `{"dispatchType":"subscribe","subscriptionEntries":[` +
+			`{"eventType":"block_events"},{"eventType":"revert_events"},{"eventType":"finalized_events"}]}`
+		assert.Equal(t, expected, string(serverMock.ReceivedMessages()[0]))
+	})
+	t.Run("custom subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		serverMock := server.NewWebSocketServerMock()
+		defer serverMock.Close()
+
+		args := createMockArgsWsClient(serverMock.URL())
+		args.SubscriptionEntries = []SubscriptionEntry{
+			{EventType: BlockEventsType, Address: "erd1", Identifier: "ESDTTransfer"},
+		}
+		client, _ := NewWsClient(args)
+		defer func() {
+			_ = client.Close()
+		}()
+		require.Nil(t, client.Start())
+		waitSignal(t, serverMock.Received())
+
+		expected := `{"dispatchType":"subscribe","subscriptionEntries":[` +
+			`{"eventType":"block_events","address":"erd1","identifier":"ESDTTransfer"}]}`
+		assert.Equal(t, expected, string(serverMock.ReceivedMessages()[0]))
+	})
+}
+
+func TestWsClient_DeliversEvents(t *testing.T) {
+	t.Parallel()
+
+	serverMock := server.NewWebSocketServerMock()
+	defer serverMock.Close()
+
+	client, _ := NewWsClient(createMockArgsWsClient(serverMock.URL()))
+	defer func() {
+		_ = client.Close()
+	}()
+	require.Nil(t, client.Start())
+	waitSignal(t, serverMock.Received())
+
+	blockEvents := `{"hash":"h1","shardId":1,"timestamp":1700000000,"events":[` +
+		`{"address":"erd1a","identifier":"ESDTTransfer","topics":["VEtOLWFiY2RlZg==","","Cg=="],"data":"RGlyZWN0Q2FsbA==","txHash":"tx1"},` +
+		`{"address":"erd1a","identifier":"writeLog","topics":[],"data":null,"txHash":"tx1"},` +
+		`{"address":"erd1b","identifier":"transferValueOnly","topics":[],"data":null,"txHash":"tx2"}]}`
+	serverMock.SendMessage([]byte(`{"unparsable`))
+	serverMock.SendMessage(createMessage(t, "unknown_events", `{}`))
+	serverMock.SendMessage(createMessage(t, BlockEventsType, blockEvents))
+	serverMock.SendMessage(createMessage(t, RevertEventsType, `{"hash":"h2","nonce":37,"round":38,"epoch":2,"shardId":1}`))
+	serverMock.SendMessage(createMessage(t, FinalizedEventsType, `{"hash":"h3"}`))
+
+	select {
+	case saveBlock := <-client.SaveBlocks():
+		assert.Equal(t, "h1", saveBlock.Hash)
+		assert.Equal(t, uint32(1), saveBlock.ShardID)
+		assert.Equal(t, uint64(1700000000), saveBlock.TimeStamp)
+		require.Equal(t, 3, len(saveBlock.Events))
+
+		first := saveBlock.Events[0]
+		assert.Equal(t, "tx1", first.TxHash)
+		assert.Equal(t, "h1", first.BlockHash)
+		assert.Equal(t, uint32(1), first.ShardID)
+		assert.Equal(t, 0, first.EventIndex)
+		assert.Equal(t, "erd1a", first.Event.Address)
+		assert.Equal(t, "ESDTTransfer", first.Event.Identifier)
+		assert.Equal(t, [][]byte{[]byte("TKN-abcdef"), {}, {10}}, first.Event.Topics)
+		assert.Equal(t, []byte("DirectCall"), first.Event.Data)
+		assert.Equal(t, 1, saveBlock.Events[1].EventIndex)
+		assert.Equal(t, "tx2", saveBlock.Events[2].TxHash)
+		assert.Equal(t, 0, saveBlock.Events[2].EventIndex)
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for the save block event")
+	}
+
+	select {
+	case revertBlock := <-client.RevertBlocks():
+		expected := &data.RevertBlock{Hash: "h2", Nonce: 37, Round: 38, Epoch: 2, ShardID: 1}
+		assert.Equal(t, expected, revertBlock)
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for the revert block event")
+	}
+
+	select {
+	case finalizedBlock := <-client.FinalizedBlocks():
+		assert.Equal(t, &data.FinalizedBlock{Hash: "h3"}, finalizedBlock)
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for the finalized block event")
+	}
+}
+
+func TestWsClient_Base64EncodedPayload(t *testing.T) {
+	t.Parallel()
+
+	serverMock := server.NewWebSocketServerMock()
+	defer serverMock.Close()
+
+	client, _ := NewWsClient(createMockArgsWsClient(serverMock.URL()))
+	defer func() {
+		_ = client.Close()
+	}()
+	require.Nil(t, client.Start())
+	waitSignal(t, serverMock.Received())
+
+	encodedPayload := base64.StdEncoding.EncodeToString([]byte(`{"hash":"h3"}`))
+	serverMock.SendMessage(createMessage(t, FinalizedEventsType, `"`+encodedPayload+`"`))
+
+	select {
+	case finalizedBlock := <-client.FinalizedBlocks():
+		assert.Equal(t, &data.FinalizedBlock{Hash: "h3"}, finalizedBlock)
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for the finalized block event")
+	}
+}
+
+func TestWsClient_Reconnects(t *testing.T) {
+	t.Parallel()
+
+	serverMock := server.NewWebSocketServerMock()
+	defer serverMock.Close()
+
+	client, _ := NewWsClient(createMockArgsWsClient(serverMock.URL()))
+	defer func() {
+		_ = client.Close()
+	}()
+	require.Nil(t, client.Start())
+	waitSignal(t, serverMock.Received())
+
+	serverMock.CloseConnections()
+	waitSignal(t, serverMock.Received())
+	assert.Equal(t, 2, serverMock.NumConnections())
+	assert.Equal(t, 2, len(serverMock.ReceivedMessages()))
+
+	serverMock.SendMessage(createMessage(t, FinalizedEventsType, `{"hash":"h4"}`))
+	select {
+	case finalizedBlock := <-client.FinalizedBlocks():
+		assert.Equal(t, "h4", finalizedBlock.Hash)
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for the finalized block event")
+	}
+}
+
+func TestWsClient_Close(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should close the channels while blocked on delivery", func(t *testing.T) {
+		t.Parallel()
+
+		serverMock := server.NewWebSocketServerMock()
+		defer serverMock.Close()
+
+		args := createMockArgsWsClient(serverMock.URL())
+		args.ChannelSize = 0
+		client, _ := NewWsClient(args)
+		require.Nil(t, client.Start())
+		waitSignal(t, serverMock.Received())
+
+		serverMock.SendMessage(createMessage(t, FinalizedEventsType, `{"hash":"h5"}`))
+		time.Sleep(time.Millisecond * 50)
+
+		require.Nil(t, client.Close())
+		require.Nil(t, client.Close())
+		_, ok := <-client.FinalizedBlocks()
+		assert.False(t, ok)
+		_, ok = <-client.SaveBlocks()
+		assert.False(t, ok)
+		_, ok = <-client.RevertBlocks()
+		assert.False(t, ok)
+	})
+	t.Run("start after close should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := NewWsClient(createMockArgsWsClient("ws://localhost"))
+		require.Nil(t, client.Close())
+		assert.Equal(t, ErrClientClosed, client.Start())
+	})
+}
@@ -0,0 +1,112 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// broadcaster is the online component that sends the transactions signed on an air-gapped machine
+type broadcaster struct {
+	proxy BroadcasterProxy
+}
+
+// NewBroadcaster creates a new instance of type broadcaster
+func NewBroadcaster(proxy BroadcasterProxy) (*broadcaster, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+
+	return &broadcaster{
+		proxy: proxy,
+	}, nil
+}
+
+// CheckBundle validates the signed bundle against the current network state: the chain ID should match the
+// network one, all the transactions should be signed and no transaction nonce should be lower than the
+// current nonce of its sender
+func (b *broadcaster) CheckBundle(ctx context.Context, bundle *Bundle) error {
+	if bundle == nil {
+		return ErrNilBundle
+	}
+
+	err := bundle.Validate()
+	if err != nil {
+		return err
+	}
+
+	networkConfig, err := b.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+	if networkConfig.ChainID != bundle.Network.ChainID {
+		return fmt.Errorf("%w: network chain ID %s, bundle chain ID %s", ErrChainIDMismatch, networkConfig.ChainID, bundle.Network.ChainID)
+	}
+
+	accountNonces := make(map[string]uint64)
+	for index, tx := range bundle.Transactions {
+		if len(tx.Signature) == 0 {
+			return fmt.Errorf("%w at index %d", ErrUnsignedTransaction, index)
+		}
+
+		accountNonce, found := accountNonces[tx.Sender]
+		if !found {
+			accountNonce, err = b.getAccountNonce(ctx, tx.Sender)
+			if err != nil {
+				return err
+			}
+			accountNonces[tx.Sender] = accountNonce
+		}
+
+		if tx.Nonce < accountNonce {
+			return fmt.Errorf("%w for transaction at index %d: transaction nonce %d, account nonce %d, sender %s",
+				ErrStaleNonce, index, tx.Nonce, accountNonce, tx.Sender)
+		}
+	}
+
+	return nil
+}
+
+// Broadcast checks the signed bundle and sends all its transactions, returning their hashes
+func (b *broadcaster) Broadcast(ctx context.Context, bundle *Bundle) ([]string, error) {
+	err := b.CheckBundle(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes, err := b.proxy.SendTransactions(ctx, bundle.Transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("broadcast signed transactions", "num transactions", len(hashes), "chain ID", bundle.Network.ChainID)
+
+	return hashes, nil
+}
+
+func (b *broadcaster) getAccountNonce(ctx context.Context, bech32Address string) (uint64, error) {
+	address, err := data.NewAddressFromBech32String(bech32Address)
+	if err != nil {
+		return 0, err
+	}
+
+	account, err := b.proxy.GetAccount(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	if account == nil {
+		return 0, ErrNilAccount
+	}
+
+	return account.Nonce, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *broadcaster) IsInterfaceNil() bool {
+	return b == nil
+}
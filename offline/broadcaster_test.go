@@ -0,0 +1,110 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSignedTestBundle(tb testing.TB) *Bundle {
+	bundle := createTestBundle()
+	err := SignBundle(context.Background(), bundle, createTxSigner(tb), testChainID)
+	require.Nil(tb, err)
+
+	return bundle
+}
+
+func TestNewBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	b, err := NewBroadcaster(nil)
+	assert.Nil(t, b)
+	assert.Equal(t, ErrNilProxy, err)
+
+	b, err = NewBroadcaster(createProxyStub(0))
+	assert.Nil(t, err)
+	assert.False(t, b.IsInterfaceNil())
+}
+
+func TestBroadcaster_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := NewBroadcaster(createProxyStub(5))
+		hashes, err := b.Broadcast(context.Background(), nil)
+		assert.Nil(t, hashes)
+		assert.Equal(t, ErrNilBundle, err)
+	})
+	t.Run("network chain ID mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy := createProxyStub(5)
+		proxy.GetNetworkConfigCalled = func() (*data.NetworkConfig, error) {
+			networkConfig := createNetworkConfig()
+			networkConfig.ChainID = "1"
+			return networkConfig, nil
+		}
+		b, _ := NewBroadcaster(proxy)
+		hashes, err := b.Broadcast(context.Background(), createSignedTestBundle(t))
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrChainIDMismatch))
+	})
+	t.Run("unsigned transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		b, _ := NewBroadcaster(createProxyStub(5))
+		hashes, err := b.Broadcast(context.Background(), createTestBundle())
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrUnsignedTransaction))
+	})
+	t.Run("stale nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy := createProxyStub(6)
+		proxy.SendTransactionsCalled = func(txs []*transaction.FrontendTransaction) ([]string, error) {
+			require.Fail(t, "should have not sent the transactions")
+			return nil, nil
+		}
+		b, _ := NewBroadcaster(proxy)
+		hashes, err := b.Broadcast(context.Background(), createSignedTestBundle(t))
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrStaleNonce))
+		assert.Contains(t, err.Error(), "index 0")
+	})
+	t.Run("should fetch each sender account once and send", func(t *testing.T) {
+		t.Parallel()
+
+		numGetAccount := 0
+		proxy := createProxyStub(5)
+		proxy.GetAccountCalled = func(address core.AddressHandler) (*data.Account, error) {
+			numGetAccount++
+			return &data.Account{Nonce: 5}, nil
+		}
+		var sentTxs []*transaction.FrontendTransaction
+		proxy.SendTransactionsCalled = func(txs []*transaction.FrontendTransaction) ([]string, error) {
+			sentTxs = txs
+			return []string{"hash1", "hash2"}, nil
+		}
+
+		filename := path.Join(t.TempDir(), "signed.json")
+		require.Nil(t, SaveBundleToFile(filename, createSignedTestBundle(t)))
+		bundle, err := LoadBundleFromFile(filename)
+		require.Nil(t, err)
+
+		b, _ := NewBroadcaster(proxy)
+		hashes, err := b.Broadcast(context.Background(), bundle)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash1", "hash2"}, hashes)
+		assert.Equal(t, bundle.Transactions, sentTxs)
+		assert.Equal(t, 1, numGetAccount)
+	})
+}
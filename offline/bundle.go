@@ -0,0 +1,127 @@
+package offline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+const (
+	// BundleVersion is the current version of the transactions bundle file format
+	BundleVersion = 1
+
+	bundleFileMode = 0644
+)
+
+// NetworkParams holds the network parameters needed to build and validate transactions without network access
+type NetworkParams struct {
+	ChainID               string `json:"chainID"`
+	MinGasPrice           uint64 `json:"minGasPrice"`
+	MinGasLimit           uint64 `json:"minGasLimit"`
+	GasPerDataByte        uint64 `json:"gasPerDataByte"`
+	MinTransactionVersion uint32 `json:"minTransactionVersion"`
+}
+
+// Bundle holds a set of transactions together with the network parameters they were created for. The same
+// structure is used for the unsigned transactions exported for signing and for the signed ones to be broadcast
+type Bundle struct {
+	Version      uint32                             `json:"version"`
+	Network      NetworkParams                      `json:"network"`
+	Transactions []*transaction.FrontendTransaction `json:"transactions"`
+}
+
+// SaveBundleToFile writes the bundle as JSON in the provided file
+func SaveBundleToFile(filename string, bundle *Bundle) error {
+	if len(filename) == 0 {
+		return ErrEmptyFilename
+	}
+	if bundle == nil {
+		return ErrNilBundle
+	}
+
+	buff, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buff, bundleFileMode)
+}
+
+// LoadBundleFromFile reads a bundle from the provided file
+func LoadBundleFromFile(filename string) (*Bundle, error) {
+	if len(filename) == 0 {
+		return nil, ErrEmptyFilename
+	}
+
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{}
+	err = json.Unmarshal(buff, bundle)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedBundleVersion, bundle.Version)
+	}
+
+	return bundle, nil
+}
+
+// Validate checks that all the transactions of the bundle comply with the bundle network parameters and that
+// no sender nonce is used twice
+func (bundle *Bundle) Validate() error {
+	if len(bundle.Network.ChainID) == 0 {
+		return ErrEmptyChainID
+	}
+	if len(bundle.Transactions) == 0 {
+		return ErrNoTransactions
+	}
+
+	usedNonces := make(map[string]map[uint64]struct{})
+	for index, tx := range bundle.Transactions {
+		err := bundle.validateTransaction(tx)
+		if err != nil {
+			return fmt.Errorf("%w for transaction at index %d", err, index)
+		}
+
+		senderNonces, found := usedNonces[tx.Sender]
+		if !found {
+			senderNonces = make(map[uint64]struct{})
+			usedNonces[tx.Sender] = senderNonces
+		}
+		_, isDuplicated := senderNonces[tx.Nonce]
+		if isDuplicated {
+			return fmt.Errorf("%w: nonce %d of sender %s", ErrDuplicatedNonce, tx.Nonce, tx.Sender)
+		}
+		senderNonces[tx.Nonce] = struct{}{}
+	}
+
+	return nil
+}
+
+func (bundle *Bundle) validateTransaction(tx *transaction.FrontendTransaction) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+	if tx.ChainID != bundle.Network.ChainID {
+		return fmt.Errorf("%w: bundle chain ID %s, transaction chain ID %s", ErrChainIDMismatch, bundle.Network.ChainID, tx.ChainID)
+	}
+	if tx.Version < bundle.Network.MinTransactionVersion {
+		return fmt.Errorf("%w: version %d is lower than %d", ErrInvalidTransaction, tx.Version, bundle.Network.MinTransactionVersion)
+	}
+	if tx.GasPrice < bundle.Network.MinGasPrice {
+		return fmt.Errorf("%w: gas price %d is lower than %d", ErrInvalidTransaction, tx.GasPrice, bundle.Network.MinGasPrice)
+	}
+
+	minGasLimit := bundle.Network.MinGasLimit + uint64(len(tx.Data))*bundle.Network.GasPerDataByte
+	if tx.GasLimit < minGasLimit {
+		return fmt.Errorf("%w: gas limit %d is lower than %d", ErrInvalidTransaction, tx.GasLimit, minGasLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package offline
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle_SaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ErrEmptyFilename, SaveBundleToFile("", createTestBundle()))
+		assert.Equal(t, ErrNilBundle, SaveBundleToFile(path.Join(t.TempDir(), "bundle.json"), nil))
+
+		bundle, err := LoadBundleFromFile("")
+		assert.Nil(t, bundle)
+		assert.Equal(t, ErrEmptyFilename, err)
+
+		bundle, err = LoadBundleFromFile(path.Join(t.TempDir(), "missing.json"))
+		assert.Nil(t, bundle)
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "bundle.json")
+		bundle := createTestBundle()
+		bundle.Version = BundleVersion + 1
+		require.Nil(t, SaveBundleToFile(filename, bundle))
+
+		loaded, err := LoadBundleFromFile(filename)
+		assert.Nil(t, loaded)
+		assert.True(t, errors.Is(err, ErrUnsupportedBundleVersion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "bundle.json")
+		bundle := createTestBundle()
+		require.Nil(t, SaveBundleToFile(filename, bundle))
+
+		loaded, err := LoadBundleFromFile(filename)
+		assert.Nil(t, err)
+		assert.Equal(t, bundle, loaded)
+	})
+}
+
+func TestBundle_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		bundle.Network.ChainID = ""
+		assert.Equal(t, ErrEmptyChainID, bundle.Validate())
+	})
+	t.Run("no transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		bundle.Transactions = nil
+		assert.Equal(t, ErrNoTransactions, bundle.Validate())
+	})
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		bundle.Transactions[1] = nil
+		err := bundle.Validate()
+		assert.True(t, errors.Is(err, ErrNilTransaction))
+		assert.Contains(t, err.Error(), "index 1")
+	})
+	t.Run("chain ID mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		bundle.Transactions[0].ChainID = "1"
+		assert.True(t, errors.Is(bundle.Validate(), ErrChainIDMismatch))
+	})
+	t.Run("transaction not complying with the network parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		bundle.Transactions[1].GasLimit = 55999
+		assert.True(t, errors.Is(bundle.Validate(), ErrInvalidTransaction))
+
+		bundle = createTestBundle()
+		bundle.Transactions[0].GasPrice = 1
+		assert.True(t, errors.Is(bundle.Validate(), ErrInvalidTransaction))
+
+		bundle = createTestBundle()
+		bundle.Transactions[0].Version = 0
+		assert.True(t, errors.Is(bundle.Validate(), ErrInvalidTransaction))
+	})
+	t.Run("duplicated nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		bundle.Transactions[1].Nonce = 5
+		assert.True(t, errors.Is(bundle.Validate(), ErrDuplicatedNonce))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, createTestBundle().Validate())
+	})
+}
@@ -0,0 +1,95 @@
+package offline
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/signers"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senderSecretKey = "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68"
+	senderAddress   = "erd1lta2vgd0tkeqqadkvgef73y0efs6n3xe5ss589ufhvmt6tcur8kq34qkwr"
+	receiverAddress = "erd1p5jgz605m47fq5mlqklpcjth9hdl3au53dg8a5tlkgegfnep3d7stdk09x"
+	testChainID     = "T"
+)
+
+var keyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+func createTxSigner(tb testing.TB) core.TransactionSigner {
+	sk, err := hex.DecodeString(senderSecretKey)
+	require.Nil(tb, err)
+
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(tb, err)
+
+	txSigner, err := signers.NewInMemoryTransactionSigner(holder, cryptoProvider.NewSigner())
+	require.Nil(tb, err)
+
+	return txSigner
+}
+
+func createNetworkConfig() *data.NetworkConfig {
+	return &data.NetworkConfig{
+		ChainID:               testChainID,
+		GasPerDataByte:        1500,
+		MinGasLimit:           50000,
+		MinGasPrice:           1000000000,
+		MinTransactionVersion: 1,
+	}
+}
+
+func createProxyStub(accountNonce uint64) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return createNetworkConfig(), nil
+		},
+		GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Nonce: accountNonce, Balance: "1000000000000000000"}, nil
+		},
+	}
+}
+
+func createTestBundle() *Bundle {
+	return &Bundle{
+		Version: BundleVersion,
+		Network: NetworkParams{
+			ChainID:               testChainID,
+			MinGasPrice:           1000000000,
+			MinGasLimit:           50000,
+			GasPerDataByte:        1500,
+			MinTransactionVersion: 1,
+		},
+		Transactions: []*transaction.FrontendTransaction{
+			{
+				Nonce:    5,
+				Value:    "1000",
+				Receiver: receiverAddress,
+				Sender:   senderAddress,
+				GasPrice: 1000000000,
+				GasLimit: 50000,
+				ChainID:  testChainID,
+				Version:  1,
+			},
+			{
+				Nonce:    6,
+				Value:    "0",
+				Receiver: receiverAddress,
+				Sender:   senderAddress,
+				GasPrice: 1000000000,
+				GasLimit: 56000,
+				Data:     []byte("test"),
+				ChainID:  testChainID,
+				Version:  1,
+			},
+		},
+	}
+}
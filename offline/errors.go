@@ -0,0 +1,54 @@
+package offline
+
+import "errors"
+
+// ErrNilProxy signals that a nil proxy was provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilAddress signals that a nil address was provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrNilBundle signals that a nil bundle was provided
+var ErrNilBundle = errors.New("nil bundle")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilTransactionSigner signals that a nil transaction signer was provided
+var ErrNilTransactionSigner = errors.New("nil transaction signer")
+
+// ErrNilNetworkConfig signals that a nil network config was received
+var ErrNilNetworkConfig = errors.New("nil network config")
+
+// ErrNilAccount signals that a nil account was received
+var ErrNilAccount = errors.New("nil account")
+
+// ErrNoTransactions signals that no transactions were provided
+var ErrNoTransactions = errors.New("no transactions")
+
+// ErrEmptyFilename signals that an empty filename was provided
+var ErrEmptyFilename = errors.New("empty filename")
+
+// ErrUnsupportedBundleVersion signals that the bundle version is not supported
+var ErrUnsupportedBundleVersion = errors.New("unsupported bundle version")
+
+// ErrEmptyChainID signals that an empty chain ID was provided
+var ErrEmptyChainID = errors.New("empty chain ID")
+
+// ErrChainIDMismatch signals that the chain ID does not match the expected one
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ErrSenderMismatch signals that the transaction sender does not match the signer address
+var ErrSenderMismatch = errors.New("sender mismatch")
+
+// ErrInvalidTransaction signals that the transaction does not comply with the network parameters of the bundle
+var ErrInvalidTransaction = errors.New("invalid transaction")
+
+// ErrDuplicatedNonce signals that the same sender nonce is used by more than one transaction
+var ErrDuplicatedNonce = errors.New("duplicated nonce")
+
+// ErrUnsignedTransaction signals that a transaction is not signed
+var ErrUnsignedTransaction = errors.New("unsigned transaction")
+
+// ErrStaleNonce signals that a transaction nonce is lower than the current account nonce
+var ErrStaleNonce = errors.New("stale nonce")
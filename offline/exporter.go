@@ -0,0 +1,127 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/offline")
+
+// TransactionRequest holds the data of a transaction to be exported. The nonce, gas price, chain ID and version
+// are filled in from the network and the sender account
+type TransactionRequest struct {
+	Receiver string
+	Value    string
+	Data     []byte
+	// GasLimit is optional, if zero, the minimum gas limit for the data field is used
+	GasLimit uint64
+}
+
+// exporter is the online component that prepares unsigned transactions to be signed on an air-gapped machine
+type exporter struct {
+	proxy ExporterProxy
+}
+
+// NewExporter creates a new instance of type exporter
+func NewExporter(proxy ExporterProxy) (*exporter, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+
+	return &exporter{
+		proxy: proxy,
+	}, nil
+}
+
+// ExportTransactions creates the bundle holding the unsigned transactions of the sender. The nonces are assigned
+// consecutively, starting with the current account nonce
+func (e *exporter) ExportTransactions(ctx context.Context, sender core.AddressHandler, requests []TransactionRequest) (*Bundle, error) {
+	if check.IfNil(sender) {
+		return nil, ErrNilAddress
+	}
+	if len(requests) == 0 {
+		return nil, ErrNoTransactions
+	}
+
+	senderBech32, err := sender.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig, err := e.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	account, err := e.proxy.GetAccount(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, ErrNilAccount
+	}
+
+	bundle := &Bundle{
+		Version: BundleVersion,
+		Network: NetworkParams{
+			ChainID:               networkConfig.ChainID,
+			MinGasPrice:           networkConfig.MinGasPrice,
+			MinGasLimit:           networkConfig.MinGasLimit,
+			GasPerDataByte:        networkConfig.GasPerDataByte,
+			MinTransactionVersion: networkConfig.MinTransactionVersion,
+		},
+		Transactions: make([]*transaction.FrontendTransaction, 0, len(requests)),
+	}
+
+	for index, request := range requests {
+		_, err = data.NewAddressFromBech32String(request.Receiver)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the receiver of the transaction at index %d", err, index)
+		}
+
+		value := request.Value
+		if len(value) == 0 {
+			value = "0"
+		}
+		gasLimit := request.GasLimit
+		if gasLimit == 0 {
+			gasLimit = networkConfig.MinGasLimit + uint64(len(request.Data))*networkConfig.GasPerDataByte
+		}
+
+		bundle.Transactions = append(bundle.Transactions, &transaction.FrontendTransaction{
+			Nonce:    account.Nonce + uint64(index),
+			Value:    value,
+			Receiver: request.Receiver,
+			Sender:   senderBech32,
+			GasPrice: networkConfig.MinGasPrice,
+			GasLimit: gasLimit,
+			Data:     request.Data,
+			ChainID:  networkConfig.ChainID,
+			Version:  networkConfig.MinTransactionVersion,
+		})
+	}
+
+	err = bundle.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("exported unsigned transactions", "sender", senderBech32, "num transactions", len(requests),
+		"start nonce", account.Nonce, "chain ID", networkConfig.ChainID)
+
+	return bundle, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
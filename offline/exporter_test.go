@@ -0,0 +1,76 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewExporter(nil)
+	assert.Nil(t, e)
+	assert.Equal(t, ErrNilProxy, err)
+
+	e, err = NewExporter(createProxyStub(0))
+	assert.Nil(t, err)
+	assert.False(t, e.IsInterfaceNil())
+}
+
+func TestExporter_ExportTransactions(t *testing.T) {
+	t.Parallel()
+
+	sender, _ := data.NewAddressFromBech32String(senderAddress)
+	requests := []TransactionRequest{
+		{Receiver: receiverAddress, Value: "1000"},
+		{Receiver: receiverAddress, Data: []byte("test")},
+	}
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _ := NewExporter(createProxyStub(5))
+		bundle, err := e.ExportTransactions(context.Background(), nil, requests)
+		assert.Nil(t, bundle)
+		assert.Equal(t, ErrNilAddress, err)
+
+		bundle, err = e.ExportTransactions(context.Background(), sender, nil)
+		assert.Nil(t, bundle)
+		assert.Equal(t, ErrNoTransactions, err)
+	})
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _ := NewExporter(createProxyStub(5))
+		bundle, err := e.ExportTransactions(context.Background(), sender, []TransactionRequest{{Receiver: "invalid"}})
+		assert.Nil(t, bundle)
+		assert.Contains(t, err.Error(), "index 0")
+	})
+	t.Run("get account error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		proxy := createProxyStub(5)
+		proxy.GetAccountCalled = func(address core.AddressHandler) (*data.Account, error) {
+			return nil, expectedErr
+		}
+		e, _ := NewExporter(proxy)
+		bundle, err := e.ExportTransactions(context.Background(), sender, requests)
+		assert.Nil(t, bundle)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		e, _ := NewExporter(createProxyStub(5))
+		bundle, err := e.ExportTransactions(context.Background(), sender, requests)
+		require.Nil(t, err)
+		assert.Equal(t, createTestBundle(), bundle)
+	})
+}
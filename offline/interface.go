@@ -0,0 +1,24 @@
+package offline
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ExporterProxy defines the proxy operations used when exporting unsigned transactions
+type ExporterProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
+
+// BroadcasterProxy defines the proxy operations used when broadcasting signed transactions
+type BroadcasterProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
+	IsInterfaceNil() bool
+}
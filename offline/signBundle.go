@@ -0,0 +1,52 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// SignBundle signs, without any network access, all the transactions of the bundle using the provided transaction
+// signer. The bundle is validated first and, if expectedChainID is not empty, its chain ID should match it, so a
+// bundle prepared for another network is never signed. All the transactions should have the signer as sender
+func SignBundle(ctx context.Context, bundle *Bundle, txSigner core.TransactionSigner, expectedChainID string) error {
+	if bundle == nil {
+		return ErrNilBundle
+	}
+	if check.IfNil(txSigner) {
+		return ErrNilTransactionSigner
+	}
+	if len(expectedChainID) > 0 && bundle.Network.ChainID != expectedChainID {
+		return fmt.Errorf("%w: expected %s, bundle chain ID %s", ErrChainIDMismatch, expectedChainID, bundle.Network.ChainID)
+	}
+
+	err := bundle.Validate()
+	if err != nil {
+		return err
+	}
+
+	signerAddress := txSigner.GetBech32()
+	for index, tx := range bundle.Transactions {
+		if tx.Sender != signerAddress {
+			return fmt.Errorf("%w for transaction at index %d: sender %s, signer %s", ErrSenderMismatch, index, tx.Sender, signerAddress)
+		}
+	}
+
+	txBuilder, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	if err != nil {
+		return err
+	}
+
+	for index, tx := range bundle.Transactions {
+		err = txBuilder.ApplyUserSignatureWithSigner(ctx, txSigner, tx)
+		if err != nil {
+			return fmt.Errorf("%w while signing the transaction at index %d", err, index)
+		}
+	}
+
+	return nil
+}
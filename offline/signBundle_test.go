@@ -0,0 +1,54 @@
+package offline
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignBundle(t *testing.T) {
+	t.Parallel()
+
+	txSigner := createTxSigner(t)
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ErrNilBundle, SignBundle(context.Background(), nil, txSigner, testChainID))
+		assert.Equal(t, ErrNilTransactionSigner, SignBundle(context.Background(), createTestBundle(), nil, testChainID))
+	})
+	t.Run("unexpected chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		err := SignBundle(context.Background(), bundle, txSigner, "1")
+		assert.True(t, errors.Is(err, ErrChainIDMismatch))
+		assert.Empty(t, bundle.Transactions[0].Signature)
+	})
+	t.Run("other sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		bundle.Transactions[1].Sender = receiverAddress
+		err := SignBundle(context.Background(), bundle, txSigner, testChainID)
+		assert.True(t, errors.Is(err, ErrSenderMismatch))
+		assert.Empty(t, bundle.Transactions[0].Signature)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := createTestBundle()
+		err := SignBundle(context.Background(), bundle, txSigner, "")
+		require.Nil(t, err)
+
+		for _, tx := range bundle.Transactions {
+			expectedSignature, errSign := txSigner.SignTransaction(context.Background(), builders.TransactionToUnsignedTx(tx))
+			require.Nil(t, errSign)
+			assert.Equal(t, hex.EncodeToString(expectedSignature), tx.Signature)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package signers
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecretKey = "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68"
+
+func createTestCryptoHolder(tb testing.TB) core.CryptoComponentsHolder {
+	sk, err := hex.DecodeString(testSecretKey)
+	require.Nil(tb, err)
+
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(tb, err)
+
+	return holder
+}
+
+func createTestTransaction(sender string) *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Nonce:    1,
+		Value:    "11500313000000000000",
+		Receiver: "erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw",
+		Sender:   sender,
+		GasPrice: 1000000000,
+		GasLimit: 60000,
+		Data:     []byte("a data field long enough to make the transaction need more than two ledger chunks when it is serialized"),
+		ChainID:  "T",
+		Version:  uint32(2),
+	}
+}
+
+func computeExpectedSignature(tb testing.TB, holder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) []byte {
+	txBuilder, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	require.Nil(tb, err)
+
+	txCopy := *tx
+	err = txBuilder.ApplyUserSignature(holder, &txCopy)
+	require.Nil(tb, err)
+
+	signature, err := hex.DecodeString(txCopy.Signature)
+	require.Nil(tb, err)
+
+	return signature
+}
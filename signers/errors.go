@@ -0,0 +1,42 @@
+package signers
+
+import "errors"
+
+// ErrNilCryptoComponentsHolder signals that a nil crypto components holder was provided
+var ErrNilCryptoComponentsHolder = errors.New("nil crypto components holder")
+
+// ErrNilSigner signals that a nil signer was provided
+var ErrNilSigner = errors.New("nil signer")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrRemoteSigner signals that the remote signer returned an error
+var ErrRemoteSigner = errors.New("remote signer error")
+
+// ErrInvalidSignature signals that an invalid signature was received
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrNoTransactionSigners signals that no transaction signers were provided
+var ErrNoTransactionSigners = errors.New("no transaction signers")
+
+// ErrUnknownAddress signals that no signer is available for the provided address
+var ErrUnknownAddress = errors.New("unknown address")
+
+// ErrAddressNotInTransaction signals that the signing address is neither the sender nor the guardian of the transaction
+var ErrAddressNotInTransaction = errors.New("address is neither the sender nor the guardian of the transaction")
+
+// ErrNilLedgerTransport signals that a nil ledger transport was provided
+var ErrNilLedgerTransport = errors.New("nil ledger transport")
+
+// ErrAPDUDataTooLong signals that the APDU data exceeds the maximum length
+var ErrAPDUDataTooLong = errors.New("APDU data too long")
+
+// ErrInvalidAPDUResponse signals that an invalid APDU response was received
+var ErrInvalidAPDUResponse = errors.New("invalid APDU response")
+
+// ErrLedgerStatus signals that the ledger device returned a status word different from OK
+var ErrLedgerStatus = errors.New("ledger status error")
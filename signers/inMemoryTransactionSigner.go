@@ -0,0 +1,60 @@
+package signers
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// inMemoryTransactionSigner is able to sign transactions using a private key held in the process memory
+type inMemoryTransactionSigner struct {
+	cryptoHolder core.CryptoComponentsHolder
+	signer       Signer
+}
+
+// NewInMemoryTransactionSigner creates a new instance of type inMemoryTransactionSigner
+func NewInMemoryTransactionSigner(cryptoHolder core.CryptoComponentsHolder, signer Signer) (*inMemoryTransactionSigner, error) {
+	if check.IfNil(cryptoHolder) {
+		return nil, ErrNilCryptoComponentsHolder
+	}
+	if check.IfNil(signer) {
+		return nil, ErrNilSigner
+	}
+
+	return &inMemoryTransactionSigner{
+		cryptoHolder: cryptoHolder,
+		signer:       signer,
+	}, nil
+}
+
+// SignTransaction returns the signature of the provided transaction
+func (imts *inMemoryTransactionSigner) SignTransaction(_ context.Context, tx *transaction.FrontendTransaction) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	message, err := builders.ComputeSigningMessage(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return imts.signer.SignByteSlice(message, imts.cryptoHolder.GetPrivateKey())
+}
+
+// GetBech32 returns the bech32 address of the signer
+func (imts *inMemoryTransactionSigner) GetBech32() string {
+	return imts.cryptoHolder.GetBech32()
+}
+
+// GetAddressHandler returns the address of the signer
+func (imts *inMemoryTransactionSigner) GetAddressHandler() core.AddressHandler {
+	return imts.cryptoHolder.GetAddressHandler()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (imts *inMemoryTransactionSigner) IsInterfaceNil() bool {
+	return imts == nil
+}
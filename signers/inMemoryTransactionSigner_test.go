@@ -0,0 +1,71 @@
+package signers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInMemoryTransactionSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil crypto holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		imts, err := NewInMemoryTransactionSigner(nil, cryptoProvider.NewSigner())
+		assert.Nil(t, imts)
+		assert.Equal(t, ErrNilCryptoComponentsHolder, err)
+	})
+	t.Run("nil signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		imts, err := NewInMemoryTransactionSigner(createTestCryptoHolder(t), nil)
+		assert.Nil(t, imts)
+		assert.Equal(t, ErrNilSigner, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createTestCryptoHolder(t)
+		imts, err := NewInMemoryTransactionSigner(holder, cryptoProvider.NewSigner())
+		assert.Nil(t, err)
+		assert.False(t, imts.IsInterfaceNil())
+		assert.Equal(t, holder.GetBech32(), imts.GetBech32())
+		assert.Equal(t, holder.GetAddressHandler(), imts.GetAddressHandler())
+	})
+}
+
+func TestInMemoryTransactionSigner_SignTransaction(t *testing.T) {
+	t.Parallel()
+
+	holder := createTestCryptoHolder(t)
+	imts, _ := NewInMemoryTransactionSigner(holder, cryptoProvider.NewSigner())
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		signature, err := imts.SignTransaction(context.Background(), nil)
+		assert.Nil(t, signature)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTestTransaction(holder.GetBech32())
+		signature, err := imts.SignTransaction(context.Background(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, computeExpectedSignature(t, holder, tx), signature)
+	})
+	t.Run("hash signing should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTestTransaction(holder.GetBech32())
+		tx.Options = transaction.MaskSignedWithHash
+		signature, err := imts.SignTransaction(context.Background(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, computeExpectedSignature(t, holder, tx), signature)
+	})
+}
@@ -0,0 +1,18 @@
+package signers
+
+import (
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// Signer defines the method used by a struct used to create valid signatures
+type Signer interface {
+	SignByteSlice(msg []byte, privateKey crypto.PrivateKey) ([]byte, error)
+	VerifyByteSlice(msg []byte, publicKey crypto.PublicKey, sig []byte) error
+	IsInterfaceNil() bool
+}
+
+// LedgerTransport defines the component able to exchange APDU commands with a ledger device
+type LedgerTransport interface {
+	Exchange(apdu []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
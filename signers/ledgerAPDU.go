@@ -0,0 +1,165 @@
+package signers
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+)
+
+// The constants of the MultiversX ledger application protocol
+const (
+	LedgerCLA                = byte(0xED)
+	LedgerInsGetAppVersion   = byte(0x02)
+	LedgerInsGetAddress      = byte(0x03)
+	LedgerInsSignTransaction = byte(0x04)
+	LedgerInsSetAddress      = byte(0x05)
+	LedgerInsSignTxHash      = byte(0x07)
+
+	LedgerP1First   = byte(0x00)
+	LedgerP1More    = byte(0x80)
+	LedgerP1Display = byte(0x01)
+	LedgerP2        = byte(0x00)
+
+	LedgerStatusOK = uint16(0x9000)
+
+	ledgerTransactionChunkSize = 150
+	maxAPDUDataLength          = 255
+	apduHeaderLength           = 5
+	apduStatusWordLength       = 2
+)
+
+var ledgerStatusMessages = map[uint16]string{
+	0x6985: "user denied the request",
+	0x6D00: "unknown instruction",
+	0x6E00: "wrong CLA",
+	0x6E10: "signature failed",
+	0x6E01: "invalid arguments",
+	0x6E02: "invalid message",
+	0x6E03: "invalid P1",
+	0x6E04: "message too long",
+	0x6E05: "receiver too long",
+	0x6E06: "amount too long",
+	0x6E07: "contract data disabled",
+	0x6E08: "message incomplete",
+	0x6E09: "wrong transaction version",
+	0x6E0A: "nonce too long",
+	0x6E0B: "invalid amount",
+	0x6E0C: "invalid fee",
+	0x6E0D: "pretty failed",
+	0x6E0E: "data too long",
+	0x6E0F: "wrong transaction options",
+}
+
+// EncodeAPDU encodes a command in the APDU format: CLA, INS, P1, P2, data length and data
+func EncodeAPDU(ins byte, p1 byte, p2 byte, data []byte) ([]byte, error) {
+	if len(data) > maxAPDUDataLength {
+		return nil, fmt.Errorf("%w: %d bytes, maximum %d", ErrAPDUDataTooLong, len(data), maxAPDUDataLength)
+	}
+
+	apdu := make([]byte, 0, apduHeaderLength+len(data))
+	apdu = append(apdu, LedgerCLA, ins, p1, p2, byte(len(data)))
+
+	return append(apdu, data...), nil
+}
+
+// EncodeGetAppVersionAPDU encodes the command that returns the version of the ledger application
+func EncodeGetAppVersionAPDU() ([]byte, error) {
+	return EncodeAPDU(LedgerInsGetAppVersion, LedgerP1First, LedgerP2, nil)
+}
+
+// EncodeSetAddressAPDU encodes the command that selects the account and address index used for signing
+func EncodeSetAddressAPDU(account uint32, addressIndex uint32) ([]byte, error) {
+	return EncodeAPDU(LedgerInsSetAddress, LedgerP1First, LedgerP2, encodeAccountAndIndex(account, addressIndex))
+}
+
+// EncodeGetAddressAPDU encodes the command that returns the address of the provided account and address index.
+// If display is set, the device will show the address for confirmation
+func EncodeGetAddressAPDU(account uint32, addressIndex uint32, display bool) ([]byte, error) {
+	p1 := LedgerP1First
+	if display {
+		p1 = LedgerP1Display
+	}
+
+	return EncodeAPDU(LedgerInsGetAddress, p1, LedgerP2, encodeAccountAndIndex(account, addressIndex))
+}
+
+// EncodeSignTransactionAPDUs encodes the commands that sign the provided transaction. The unsigned transaction JSON
+// is split in chunks, the first one being sent with the P1First and the following ones with P1More. The transactions
+// signed over their hash use a dedicated instruction, the device being the one that computes the hash
+func EncodeSignTransactionAPDUs(tx *transaction.FrontendTransaction) ([][]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	message, err := json.Marshal(builders.TransactionToUnsignedTx(tx))
+	if err != nil {
+		return nil, err
+	}
+
+	ins := LedgerInsSignTransaction
+	if builders.IsHashSigningTransaction(tx) {
+		ins = LedgerInsSignTxHash
+	}
+
+	apdus := make([][]byte, 0, len(message)/ledgerTransactionChunkSize+1)
+	for offset := 0; offset < len(message); offset += ledgerTransactionChunkSize {
+		end := offset + ledgerTransactionChunkSize
+		if end > len(message) {
+			end = len(message)
+		}
+
+		p1 := LedgerP1More
+		if offset == 0 {
+			p1 = LedgerP1First
+		}
+
+		apdu, errEncode := EncodeAPDU(ins, p1, LedgerP2, message[offset:end])
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		apdus = append(apdus, apdu)
+	}
+
+	return apdus, nil
+}
+
+// DecodeAPDUResponse checks the status word of the response and returns the response data
+func DecodeAPDUResponse(response []byte) ([]byte, error) {
+	if len(response) < apduStatusWordLength {
+		return nil, fmt.Errorf("%w: response too short", ErrInvalidAPDUResponse)
+	}
+
+	dataLength := len(response) - apduStatusWordLength
+	statusWord := binary.BigEndian.Uint16(response[dataLength:])
+	if statusWord != LedgerStatusOK {
+		return nil, fmt.Errorf("%w: 0x%04X %s", ErrLedgerStatus, statusWord, ledgerStatusMessages[statusWord])
+	}
+
+	return response[:dataLength], nil
+}
+
+// DecodeLengthPrefixedResponse decodes the response data prefixed with one length byte, as the address and the
+// signature responses are
+func DecodeLengthPrefixedResponse(responseData []byte) ([]byte, error) {
+	if len(responseData) == 0 {
+		return nil, fmt.Errorf("%w: empty response", ErrInvalidAPDUResponse)
+	}
+
+	length := int(responseData[0])
+	if len(responseData) < 1+length {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAPDUResponse, length, len(responseData)-1)
+	}
+
+	return responseData[1 : 1+length], nil
+}
+
+func encodeAccountAndIndex(account uint32, addressIndex uint32) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint32(buff[:4], account)
+	binary.BigEndian.PutUint32(buff[4:], addressIndex)
+
+	return buff
+}
@@ -0,0 +1,125 @@
+package signers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsLedgerTransactionSigner is the argument DTO for the NewLedgerTransactionSigner constructor function
+type ArgsLedgerTransactionSigner struct {
+	Transport    LedgerTransport
+	Account      uint32
+	AddressIndex uint32
+}
+
+// ledgerTransactionSigner is able to sign transactions with a ledger device running the MultiversX application
+type ledgerTransactionSigner struct {
+	mutTransport   sync.Mutex
+	transport      LedgerTransport
+	addressHandler core.AddressHandler
+	bech32Address  string
+}
+
+// NewLedgerTransactionSigner creates a new instance of type ledgerTransactionSigner. It selects the provided account
+// and address index on the device and fetches the corresponding address
+func NewLedgerTransactionSigner(args ArgsLedgerTransactionSigner) (*ledgerTransactionSigner, error) {
+	if check.IfNil(args.Transport) {
+		return nil, ErrNilLedgerTransport
+	}
+
+	lts := &ledgerTransactionSigner{
+		transport: args.Transport,
+	}
+
+	setAddressAPDU, err := EncodeSetAddressAPDU(args.Account, args.AddressIndex)
+	if err != nil {
+		return nil, err
+	}
+	_, err = lts.exchange(setAddressAPDU)
+	if err != nil {
+		return nil, err
+	}
+
+	getAddressAPDU, err := EncodeGetAddressAPDU(args.Account, args.AddressIndex, false)
+	if err != nil {
+		return nil, err
+	}
+	responseData, err := lts.exchange(getAddressAPDU)
+	if err != nil {
+		return nil, err
+	}
+	bech32Address, err := DecodeLengthPrefixedResponse(responseData)
+	if err != nil {
+		return nil, err
+	}
+
+	lts.addressHandler, err = data.NewAddressFromBech32String(string(bech32Address))
+	if err != nil {
+		return nil, err
+	}
+	lts.bech32Address = string(bech32Address)
+
+	return lts, nil
+}
+
+// SignTransaction sends the provided transaction to the ledger device and returns the signature
+func (lts *ledgerTransactionSigner) SignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error) {
+	apdus, err := EncodeSignTransactionAPDUs(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	lts.mutTransport.Lock()
+	defer lts.mutTransport.Unlock()
+
+	var responseData []byte
+	for _, apdu := range apdus {
+		err = ctx.Err()
+		if err != nil {
+			return nil, err
+		}
+
+		responseData, err = lts.exchangeUnprotected(apdu)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return DecodeLengthPrefixedResponse(responseData)
+}
+
+func (lts *ledgerTransactionSigner) exchange(apdu []byte) ([]byte, error) {
+	lts.mutTransport.Lock()
+	defer lts.mutTransport.Unlock()
+
+	return lts.exchangeUnprotected(apdu)
+}
+
+func (lts *ledgerTransactionSigner) exchangeUnprotected(apdu []byte) ([]byte, error) {
+	response, err := lts.transport.Exchange(apdu)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeAPDUResponse(response)
+}
+
+// GetBech32 returns the bech32 address of the signer
+func (lts *ledgerTransactionSigner) GetBech32() string {
+	return lts.bech32Address
+}
+
+// GetAddressHandler returns the address of the signer
+func (lts *ledgerTransactionSigner) GetAddressHandler() core.AddressHandler {
+	return lts.addressHandler
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lts *ledgerTransactionSigner) IsInterfaceNil() bool {
+	return lts == nil
+}
@@ -0,0 +1,280 @@
+package signers
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ledgerDeviceMock emulates the MultiversX ledger application using an in-memory key
+type ledgerDeviceMock struct {
+	mut            sync.Mutex
+	holder         core.CryptoComponentsHolder
+	apdus          [][]byte
+	pendingMessage []byte
+	statusWord     uint16
+}
+
+func newLedgerDeviceMock(holder core.CryptoComponentsHolder) *ledgerDeviceMock {
+	return &ledgerDeviceMock{
+		holder:     holder,
+		statusWord: LedgerStatusOK,
+	}
+}
+
+func (mock *ledgerDeviceMock) Exchange(apdu []byte) ([]byte, error) {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	mock.apdus = append(mock.apdus, apdu)
+	if mock.statusWord != LedgerStatusOK {
+		return mock.withStatusWord(nil, mock.statusWord), nil
+	}
+
+	ins, p1, data := apdu[1], apdu[2], apdu[apduHeaderLength:]
+	switch ins {
+	case LedgerInsSetAddress:
+		return mock.withStatusWord(nil, LedgerStatusOK), nil
+	case LedgerInsGetAddress:
+		address := []byte(mock.holder.GetBech32())
+		return mock.withStatusWord(append([]byte{byte(len(address))}, address...), LedgerStatusOK), nil
+	case LedgerInsSignTransaction, LedgerInsSignTxHash:
+		if p1 == LedgerP1First {
+			mock.pendingMessage = nil
+		}
+		mock.pendingMessage = append(mock.pendingMessage, data...)
+		if !json.Valid(mock.pendingMessage) {
+			return mock.withStatusWord(nil, LedgerStatusOK), nil
+		}
+
+		tx := &transaction.FrontendTransaction{}
+		err := json.Unmarshal(mock.pendingMessage, tx)
+		if err != nil {
+			return nil, err
+		}
+		imts, _ := NewInMemoryTransactionSigner(mock.holder, cryptoProvider.NewSigner())
+		signature, err := imts.SignTransaction(context.Background(), tx)
+		if err != nil {
+			return nil, err
+		}
+
+		return mock.withStatusWord(append([]byte{byte(len(signature))}, signature...), LedgerStatusOK), nil
+	default:
+		return mock.withStatusWord(nil, 0x6D00), nil
+	}
+}
+
+func (mock *ledgerDeviceMock) withStatusWord(data []byte, statusWord uint16) []byte {
+	return binary.BigEndian.AppendUint16(data, statusWord)
+}
+
+func (mock *ledgerDeviceMock) IsInterfaceNil() bool {
+	return mock == nil
+}
+
+func TestEncodeAPDU(t *testing.T) {
+	t.Parallel()
+
+	apdu, err := EncodeAPDU(LedgerInsGetAddress, LedgerP1Display, LedgerP2, []byte{1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xED, 0x03, 0x01, 0x00, 0x02, 1, 2}, apdu)
+
+	apdu, err = EncodeAPDU(LedgerInsGetAddress, LedgerP1First, LedgerP2, make([]byte, 256))
+	assert.Nil(t, apdu)
+	assert.True(t, errors.Is(err, ErrAPDUDataTooLong))
+
+	apdu, err = EncodeGetAppVersionAPDU()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xED, 0x02, 0x00, 0x00, 0x00}, apdu)
+
+	apdu, err = EncodeSetAddressAPDU(1, 258)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xED, 0x05, 0x00, 0x00, 0x08, 0, 0, 0, 1, 0, 0, 1, 2}, apdu)
+
+	apdu, err = EncodeGetAddressAPDU(0, 3, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xED, 0x03, 0x01, 0x00, 0x08, 0, 0, 0, 0, 0, 0, 0, 3}, apdu)
+}
+
+func TestEncodeSignTransactionAPDUs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		apdus, err := EncodeSignTransactionAPDUs(nil)
+		assert.Nil(t, apdus)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("should split the transaction in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTestTransaction(otherTestAddress)
+		tx.Signature = "should be ignored"
+		message, _ := json.Marshal(&transaction.FrontendTransaction{
+			Nonce:    tx.Nonce,
+			Value:    tx.Value,
+			Receiver: tx.Receiver,
+			Sender:   tx.Sender,
+			GasPrice: tx.GasPrice,
+			GasLimit: tx.GasLimit,
+			Data:     tx.Data,
+			ChainID:  tx.ChainID,
+			Version:  tx.Version,
+		})
+
+		apdus, err := EncodeSignTransactionAPDUs(tx)
+		assert.Nil(t, err)
+		require.Equal(t, (len(message)+ledgerTransactionChunkSize-1)/ledgerTransactionChunkSize, len(apdus))
+		require.True(t, len(apdus) > 2)
+
+		recomposed := make([]byte, 0, len(message))
+		for i, apdu := range apdus {
+			assert.Equal(t, LedgerCLA, apdu[0])
+			assert.Equal(t, LedgerInsSignTransaction, apdu[1])
+			expectedP1 := LedgerP1More
+			if i == 0 {
+				expectedP1 = LedgerP1First
+			}
+			assert.Equal(t, expectedP1, apdu[2])
+			assert.Equal(t, int(apdu[4]), len(apdu)-apduHeaderLength)
+			recomposed = append(recomposed, apdu[apduHeaderLength:]...)
+		}
+		assert.Equal(t, message, recomposed)
+	})
+	t.Run("hash signing transaction should use the sign hash instruction", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTestTransaction(otherTestAddress)
+		tx.Options = transaction.MaskSignedWithHash
+
+		apdus, err := EncodeSignTransactionAPDUs(tx)
+		assert.Nil(t, err)
+		for _, apdu := range apdus {
+			assert.Equal(t, LedgerInsSignTxHash, apdu[1])
+		}
+	})
+}
+
+func TestDecodeAPDUResponse(t *testing.T) {
+	t.Parallel()
+
+	data, err := DecodeAPDUResponse([]byte{0x90})
+	assert.Nil(t, data)
+	assert.True(t, errors.Is(err, ErrInvalidAPDUResponse))
+
+	data, err = DecodeAPDUResponse([]byte{1, 2, 0x69, 0x85})
+	assert.Nil(t, data)
+	assert.True(t, errors.Is(err, ErrLedgerStatus))
+	assert.Contains(t, err.Error(), "user denied the request")
+
+	data, err = DecodeAPDUResponse([]byte{1, 2, 0x90, 0x00})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, data)
+
+	data, err = DecodeLengthPrefixedResponse(nil)
+	assert.Nil(t, data)
+	assert.True(t, errors.Is(err, ErrInvalidAPDUResponse))
+
+	data, err = DecodeLengthPrefixedResponse([]byte{3, 1, 2})
+	assert.Nil(t, data)
+	assert.True(t, errors.Is(err, ErrInvalidAPDUResponse))
+
+	data, err = DecodeLengthPrefixedResponse([]byte{2, 1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, data)
+}
+
+func TestNewLedgerTransactionSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transport should error", func(t *testing.T) {
+		t.Parallel()
+
+		lts, err := NewLedgerTransactionSigner(ArgsLedgerTransactionSigner{})
+		assert.Nil(t, lts)
+		assert.Equal(t, ErrNilLedgerTransport, err)
+	})
+	t.Run("device error should error", func(t *testing.T) {
+		t.Parallel()
+
+		device := newLedgerDeviceMock(createTestCryptoHolder(t))
+		device.statusWord = 0x6E00
+		lts, err := NewLedgerTransactionSigner(ArgsLedgerTransactionSigner{Transport: device})
+		assert.Nil(t, lts)
+		assert.True(t, errors.Is(err, ErrLedgerStatus))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createTestCryptoHolder(t)
+		device := newLedgerDeviceMock(holder)
+		lts, err := NewLedgerTransactionSigner(ArgsLedgerTransactionSigner{
+			Transport:    device,
+			Account:      1,
+			AddressIndex: 2,
+		})
+		assert.Nil(t, err)
+		assert.False(t, lts.IsInterfaceNil())
+		assert.Equal(t, holder.GetBech32(), lts.GetBech32())
+		assert.Equal(t, holder.GetAddressHandler().AddressBytes(), lts.GetAddressHandler().AddressBytes())
+
+		expectedSetAddress, _ := EncodeSetAddressAPDU(1, 2)
+		expectedGetAddress, _ := EncodeGetAddressAPDU(1, 2, false)
+		assert.Equal(t, [][]byte{expectedSetAddress, expectedGetAddress}, device.apdus)
+	})
+}
+
+func TestLedgerTransactionSigner_SignTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createTestCryptoHolder(t)
+		lts, _ := NewLedgerTransactionSigner(ArgsLedgerTransactionSigner{Transport: newLedgerDeviceMock(holder)})
+
+		tx := createTestTransaction(holder.GetBech32())
+		signature, err := lts.SignTransaction(context.Background(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, computeExpectedSignature(t, holder, tx), signature)
+
+		tx.Options = transaction.MaskSignedWithHash
+		signature, err = lts.SignTransaction(context.Background(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, computeExpectedSignature(t, holder, tx), signature)
+	})
+	t.Run("user denied should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createTestCryptoHolder(t)
+		device := newLedgerDeviceMock(holder)
+		lts, _ := NewLedgerTransactionSigner(ArgsLedgerTransactionSigner{Transport: device})
+		device.statusWord = 0x6985
+
+		signature, err := lts.SignTransaction(context.Background(), createTestTransaction(holder.GetBech32()))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(err, ErrLedgerStatus))
+	})
+	t.Run("canceled context should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createTestCryptoHolder(t)
+		lts, _ := NewLedgerTransactionSigner(ArgsLedgerTransactionSigner{Transport: newLedgerDeviceMock(holder)})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		signature, err := lts.SignTransaction(ctx, createTestTransaction(holder.GetBech32()))
+		assert.Nil(t, signature)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
@@ -0,0 +1,87 @@
+package signers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// remoteSignerHandler is an http.Handler implementing the remote signing service protocol on top of a set of
+// transaction signers. It can be used to build a signing service or as a local stand-in in tests
+type remoteSignerHandler struct {
+	signers map[string]core.TransactionSigner
+}
+
+// NewRemoteSignerHandler creates a new instance of type remoteSignerHandler
+func NewRemoteSignerHandler(txSigners ...core.TransactionSigner) (*remoteSignerHandler, error) {
+	if len(txSigners) == 0 {
+		return nil, ErrNoTransactionSigners
+	}
+
+	handler := &remoteSignerHandler{
+		signers: make(map[string]core.TransactionSigner, len(txSigners)),
+	}
+	for index, txSigner := range txSigners {
+		if check.IfNil(txSigner) {
+			return nil, fmt.Errorf("%w at index %d", ErrNilSigner, index)
+		}
+
+		handler.signers[txSigner.GetBech32()] = txSigner
+	}
+
+	return handler, nil
+}
+
+// ServeHTTP handles the sign requests
+func (handler *remoteSignerHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost || request.URL.Path != "/"+SignEndpoint {
+		writeSignResponse(writer, http.StatusNotFound, &SignResponse{Error: "not found"})
+		return
+	}
+
+	signRequest := &SignRequest{}
+	err := json.NewDecoder(request.Body).Decode(signRequest)
+	if err != nil {
+		writeSignResponse(writer, http.StatusBadRequest, &SignResponse{Error: err.Error()})
+		return
+	}
+	if signRequest.Transaction == nil {
+		writeSignResponse(writer, http.StatusBadRequest, &SignResponse{Error: ErrNilTransaction.Error()})
+		return
+	}
+
+	txSigner, found := handler.signers[signRequest.Address]
+	if !found {
+		writeSignResponse(writer, http.StatusNotFound, &SignResponse{Error: fmt.Sprintf("%s: %s", ErrUnknownAddress.Error(), signRequest.Address)})
+		return
+	}
+
+	tx := signRequest.Transaction
+	if tx.Sender != signRequest.Address && tx.GuardianAddr != signRequest.Address {
+		writeSignResponse(writer, http.StatusBadRequest, &SignResponse{Error: ErrAddressNotInTransaction.Error()})
+		return
+	}
+
+	signature, err := txSigner.SignTransaction(request.Context(), tx)
+	if err != nil {
+		writeSignResponse(writer, http.StatusInternalServerError, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	writeSignResponse(writer, http.StatusOK, &SignResponse{Signature: hex.EncodeToString(signature)})
+}
+
+func writeSignResponse(writer http.ResponseWriter, code int, response *SignResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(code)
+	_ = json.NewEncoder(writer).Encode(response)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *remoteSignerHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,118 @@
+package signers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+var keyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+// ArgsRemoteTransactionSigner is the argument DTO for the NewRemoteTransactionSigner constructor function
+type ArgsRemoteTransactionSigner struct {
+	URL string
+	// Address is the bech32 address whose key is held by the remote signing service
+	Address string
+	// Client is optional, it can be used to customize the requests, like adding authentication headers
+	Client sdkHttp.Client
+}
+
+// remoteTransactionSigner is able to sign transactions by calling a remote signing service, so the private key
+// never enters the process. The received signatures are verified against the address public key
+type remoteTransactionSigner struct {
+	httpClientWrapper sdkHttp.ClientWrapper
+	addressHandler    core.AddressHandler
+	bech32Address     string
+	signer            Signer
+}
+
+// NewRemoteTransactionSigner creates a new instance of type remoteTransactionSigner
+func NewRemoteTransactionSigner(args ArgsRemoteTransactionSigner) (*remoteTransactionSigner, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+
+	addressHandler, err := data.NewAddressFromBech32String(args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keyGen.PublicKeyFromByteArray(addressHandler.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteTransactionSigner{
+		httpClientWrapper: sdkHttp.NewHttpClientWrapper(args.Client, args.URL),
+		addressHandler:    addressHandler,
+		bech32Address:     args.Address,
+		signer:            cryptoProvider.NewSigner(),
+	}, nil
+}
+
+// SignTransaction requests the signature of the provided transaction from the remote signing service
+func (rts *remoteTransactionSigner) SignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	request := &SignRequest{
+		Address:     rts.bech32Address,
+		Transaction: tx,
+	}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	buff, code, err := rts.httpClientWrapper.PostHTTP(ctx, SignEndpoint, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignResponse{}
+	errUnmarshal := json.Unmarshal(buff, response)
+	if code != http.StatusOK {
+		return nil, fmt.Errorf("%w: status code %d, message: %s", ErrRemoteSigner, code, response.Error)
+	}
+	if errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+
+	signature, err := hex.DecodeString(response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	err = VerifyTransactionSignature(rts.signer, tx, rts.addressHandler, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// GetBech32 returns the bech32 address of the signer
+func (rts *remoteTransactionSigner) GetBech32() string {
+	return rts.bech32Address
+}
+
+// GetAddressHandler returns the address of the signer
+func (rts *remoteTransactionSigner) GetAddressHandler() core.AddressHandler {
+	return rts.addressHandler
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rts *remoteTransactionSigner) IsInterfaceNil() bool {
+	return rts == nil
+}
@@ -0,0 +1,141 @@
+package signers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const otherTestAddress = "erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw"
+
+func TestNewRemoteTransactionSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		rts, err := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{Address: otherTestAddress})
+		assert.Nil(t, rts)
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		rts, err := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: "http://localhost", Address: "invalid"})
+		assert.Nil(t, rts)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rts, err := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: "http://localhost", Address: otherTestAddress})
+		assert.Nil(t, err)
+		assert.False(t, rts.IsInterfaceNil())
+		assert.Equal(t, otherTestAddress, rts.GetBech32())
+		bech32, _ := rts.GetAddressHandler().AddressAsBech32String()
+		assert.Equal(t, otherTestAddress, bech32)
+	})
+}
+
+func TestNewRemoteSignerHandler(t *testing.T) {
+	t.Parallel()
+
+	handler, err := NewRemoteSignerHandler()
+	assert.Nil(t, handler)
+	assert.Equal(t, ErrNoTransactionSigners, err)
+
+	handler, err = NewRemoteSignerHandler(nil)
+	assert.Nil(t, handler)
+	assert.True(t, errors.Is(err, ErrNilSigner))
+}
+
+func TestRemoteTransactionSigner_SignTransaction(t *testing.T) {
+	t.Parallel()
+
+	holder := createTestCryptoHolder(t)
+	imts, _ := NewInMemoryTransactionSigner(holder, cryptoProvider.NewSigner())
+	handler, err := NewRemoteSignerHandler(imts)
+	require.Nil(t, err)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rts, _ := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: server.URL, Address: holder.GetBech32()})
+		tx := createTestTransaction(holder.GetBech32())
+		signature, errSign := rts.SignTransaction(context.Background(), tx)
+		assert.Nil(t, errSign)
+		assert.Equal(t, computeExpectedSignature(t, holder, tx), signature)
+	})
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		rts, _ := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: server.URL, Address: holder.GetBech32()})
+		signature, errSign := rts.SignTransaction(context.Background(), nil)
+		assert.Nil(t, signature)
+		assert.Equal(t, ErrNilTransaction, errSign)
+	})
+	t.Run("unknown address should error", func(t *testing.T) {
+		t.Parallel()
+
+		rts, _ := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: server.URL, Address: otherTestAddress})
+		signature, errSign := rts.SignTransaction(context.Background(), createTestTransaction(otherTestAddress))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(errSign, ErrRemoteSigner))
+		assert.Contains(t, errSign.Error(), ErrUnknownAddress.Error())
+	})
+	t.Run("address not in transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		rts, _ := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: server.URL, Address: holder.GetBech32()})
+		signature, errSign := rts.SignTransaction(context.Background(), createTestTransaction(otherTestAddress))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(errSign, ErrRemoteSigner))
+		assert.Contains(t, errSign.Error(), ErrAddressNotInTransaction.Error())
+	})
+	t.Run("invalid signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		badServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			_ = json.NewEncoder(writer).Encode(&SignResponse{Signature: hex.EncodeToString(make([]byte, 64))})
+		}))
+		defer badServer.Close()
+
+		rts, _ := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: badServer.URL, Address: holder.GetBech32()})
+		signature, errSign := rts.SignTransaction(context.Background(), createTestTransaction(holder.GetBech32()))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(errSign, ErrInvalidSignature))
+	})
+	t.Run("signer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		failingSigner := &testsCommon.TransactionSignerStub{
+			SignTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error) {
+				return nil, errors.New("device locked")
+			},
+			GetBech32Called: func() string {
+				return otherTestAddress
+			},
+		}
+		failingHandler, _ := NewRemoteSignerHandler(failingSigner)
+		failingServer := httptest.NewServer(failingHandler)
+		defer failingServer.Close()
+
+		rts, _ := NewRemoteTransactionSigner(ArgsRemoteTransactionSigner{URL: failingServer.URL, Address: otherTestAddress})
+		signature, errSign := rts.SignTransaction(context.Background(), createTestTransaction(otherTestAddress))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(errSign, ErrRemoteSigner))
+		assert.Contains(t, errSign.Error(), "device locked")
+	})
+}
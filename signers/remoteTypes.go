@@ -0,0 +1,19 @@
+package signers
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// SignEndpoint is the endpoint of the remote signing service
+const SignEndpoint = "sign"
+
+// SignRequest is the request sent to the remote signing service. The service should sign the transaction with the
+// key of the provided address, that can be either the sender or the guardian of the transaction
+type SignRequest struct {
+	Address     string                           `json:"address"`
+	Transaction *transaction.FrontendTransaction `json:"transaction"`
+}
+
+// SignResponse is the response of the remote signing service
+type SignResponse struct {
+	Signature string `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
@@ -0,0 +1,30 @@
+package signers
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// VerifyTransactionSignature checks that the provided signature of the transaction was created with the private key
+// of the provided address. It is used to check the signatures received from the remote signing services
+func VerifyTransactionSignature(signer Signer, tx *transaction.FrontendTransaction, address core.AddressHandler, signature []byte) error {
+	publicKey, err := keyGen.PublicKeyFromByteArray(address.AddressBytes())
+	if err != nil {
+		return err
+	}
+
+	message, err := builders.ComputeSigningMessage(tx)
+	if err != nil {
+		return err
+	}
+
+	err = signer.VerifyByteSlice(message, publicKey, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package signers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyTransactionSignature(t *testing.T) {
+	t.Parallel()
+
+	holder := createTestCryptoHolder(t)
+	tx := createTestTransaction(holder.GetBech32())
+	signature := computeExpectedSignature(t, holder, tx)
+
+	t.Run("signature of another address should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherAddress, err := data.NewAddressFromBech32String(otherTestAddress)
+		require.Nil(t, err)
+
+		err = VerifyTransactionSignature(cryptoProvider.NewSigner(), tx, otherAddress, signature)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+	t.Run("signature of another transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherTx := *tx
+		otherTx.Nonce++
+
+		err := VerifyTransactionSignature(cryptoProvider.NewSigner(), &otherTx, holder.GetAddressHandler(), signature)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := VerifyTransactionSignature(cryptoProvider.NewSigner(), tx, holder.GetAddressHandler(), signature)
+		assert.Nil(t, err)
+	})
+}
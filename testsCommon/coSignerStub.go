@@ -0,0 +1,26 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// CoSignerStub -
+type CoSignerStub struct {
+	CoSignTransactionCalled func(ctx context.Context, tx *transaction.FrontendTransaction, code string) ([]byte, error)
+}
+
+// CoSignTransaction -
+func (stub *CoSignerStub) CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction, code string) ([]byte, error) {
+	if stub.CoSignTransactionCalled != nil {
+		return stub.CoSignTransactionCalled(ctx, tx, code)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *CoSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,36 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+)
+
+// HeaderVerifierStub -
+type HeaderVerifierStub struct {
+	VerifyHeaderSignatureByHashCalled func(ctx context.Context, shardId uint32, hash string) (bool, error)
+	VerifyHeaderCalled                func(ctx context.Context, header data.HeaderHandler) (bool, error)
+}
+
+// VerifyHeaderSignatureByHash -
+func (stub *HeaderVerifierStub) VerifyHeaderSignatureByHash(ctx context.Context, shardId uint32, hash string) (bool, error) {
+	if stub.VerifyHeaderSignatureByHashCalled != nil {
+		return stub.VerifyHeaderSignatureByHashCalled(ctx, shardId, hash)
+	}
+
+	return true, nil
+}
+
+// VerifyHeader -
+func (stub *HeaderVerifierStub) VerifyHeader(ctx context.Context, header data.HeaderHandler) (bool, error) {
+	if stub.VerifyHeaderCalled != nil {
+		return stub.VerifyHeaderCalled(ctx, header)
+	}
+
+	return true, nil
+}
+
+// IsInterfaceNil -
+func (stub *HeaderVerifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
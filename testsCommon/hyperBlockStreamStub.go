@@ -0,0 +1,36 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// HyperBlockStreamStub -
+type HyperBlockStreamStub struct {
+	NextCalled   func(ctx context.Context) (*data.HyperBlock, error)
+	CommitCalled func()
+}
+
+// Next -
+func (stub *HyperBlockStreamStub) Next(ctx context.Context) (*data.HyperBlock, error) {
+	if stub.NextCalled != nil {
+		return stub.NextCalled(ctx)
+	}
+
+	<-ctx.Done()
+
+	return nil, ctx.Err()
+}
+
+// Commit -
+func (stub *HyperBlockStreamStub) Commit() {
+	if stub.CommitCalled != nil {
+		stub.CommitCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *HyperBlockStreamStub) IsInterfaceNil() bool {
+	return stub == nil
+}
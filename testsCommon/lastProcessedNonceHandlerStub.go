@@ -0,0 +1,28 @@
+package testsCommon
+
+// LastProcessedNonceHandlerStub -
+type LastProcessedNonceHandlerStub struct {
+	ProcessedNonceCalled        func(nonce uint64)
+	GetLastProcessedNonceCalled func() uint64
+}
+
+// ProcessedNonce -
+func (stub *LastProcessedNonceHandlerStub) ProcessedNonce(nonce uint64) {
+	if stub.ProcessedNonceCalled != nil {
+		stub.ProcessedNonceCalled(nonce)
+	}
+}
+
+// GetLastProcessedNonce -
+func (stub *LastProcessedNonceHandlerStub) GetLastProcessedNonce() uint64 {
+	if stub.GetLastProcessedNonceCalled != nil {
+		return stub.GetLastProcessedNonceCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *LastProcessedNonceHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,28 @@
+package testsCommon
+
+// LogCursorHandlerStub -
+type LogCursorHandlerStub struct {
+	ProcessedNonceCalled        func(shardID uint32, nonce uint64)
+	GetLastProcessedNonceCalled func(shardID uint32) uint64
+}
+
+// ProcessedNonce -
+func (stub *LogCursorHandlerStub) ProcessedNonce(shardID uint32, nonce uint64) {
+	if stub.ProcessedNonceCalled != nil {
+		stub.ProcessedNonceCalled(shardID, nonce)
+	}
+}
+
+// GetLastProcessedNonce -
+func (stub *LogCursorHandlerStub) GetLastProcessedNonce(shardID uint32) uint64 {
+	if stub.GetLastProcessedNonceCalled != nil {
+		return stub.GetLastProcessedNonceCalled(shardID)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *LogCursorHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
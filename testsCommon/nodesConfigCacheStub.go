@@ -0,0 +1,32 @@
+package testsCommon
+
+import "github.com/multiversx/mx-sdk-go/data"
+
+// NodesConfigCacheStub -
+type NodesConfigCacheStub struct {
+	GetCalled func(epoch uint32) (*data.EpochNodesConfig, bool)
+	PutCalled func(config *data.EpochNodesConfig) error
+}
+
+// Get -
+func (stub *NodesConfigCacheStub) Get(epoch uint32) (*data.EpochNodesConfig, bool) {
+	if stub.GetCalled != nil {
+		return stub.GetCalled(epoch)
+	}
+
+	return nil, false
+}
+
+// Put -
+func (stub *NodesConfigCacheStub) Put(config *data.EpochNodesConfig) error {
+	if stub.PutCalled != nil {
+		return stub.PutCalled(config)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *NodesConfigCacheStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -36,6 +36,13 @@ type ProxyStub struct {
 	GetValidatorsInfoByEpochCalled       func(ctx context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error)
 	GetGuardianDataCalled                func(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error)
 	FilterLogsCalled                     func(ctx context.Context, filter *sdkCore.FilterQuery) ([]string, error)
+	GetBlockWithTxsAndLogsByNonceCalled  func(ctx context.Context, shardID uint32, nonce uint64) (*api.Block, error)
+	GetESDTTokenDataCalled               func(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, queryOptions api.AccountQueryOptions) (*data.ESDTFungibleTokenData, error)
+	GetNFTTokenDataCalled                func(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, nonce uint64, queryOptions api.AccountQueryOptions) (*data.ESDTNFTTokenData, error)
+	GetTransactionStatusCalled           func(ctx context.Context, hash string) (string, error)
+	GetTransactionInfoWithResultsCalled  func(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	GetProofCalled                       func(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler) (*data.AccountProof, error)
+	GetProofDataTrieCalled               func(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler, key []byte) (*data.DataTrieProof, error)
 }
 
 // ExecuteVMQuery -
@@ -171,6 +178,14 @@ func (stub *ProxyStub) GetShardOfAddress(ctx context.Context, bech32Address stri
 	return core.AllShardId, nil
 }
 
+// GetBlockWithTxsAndLogsByNonce -
+func (stub *ProxyStub) GetBlockWithTxsAndLogsByNonce(ctx context.Context, shardID uint32, nonce uint64) (*api.Block, error) {
+	if stub.GetBlockWithTxsAndLogsByNonceCalled != nil {
+		return stub.GetBlockWithTxsAndLogsByNonceCalled(ctx, shardID, nonce)
+	}
+	return &api.Block{}, nil
+}
+
 // GetRestAPIEntityType -
 func (stub *ProxyStub) GetRestAPIEntityType() sdkCore.RestAPIEntityType {
 	if stub.GetRestAPIEntityTypeCalled != nil {
@@ -239,6 +254,60 @@ func (stub *ProxyStub) FilterLogs(ctx context.Context, filter *sdkCore.FilterQue
 	return nil, nil
 }
 
+// GetESDTTokenData -
+func (stub *ProxyStub) GetESDTTokenData(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, queryOptions api.AccountQueryOptions) (*data.ESDTFungibleTokenData, error) {
+	if stub.GetESDTTokenDataCalled != nil {
+		return stub.GetESDTTokenDataCalled(ctx, address, tokenIdentifier, queryOptions)
+	}
+
+	return &data.ESDTFungibleTokenData{}, nil
+}
+
+// GetNFTTokenData -
+func (stub *ProxyStub) GetNFTTokenData(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, nonce uint64, queryOptions api.AccountQueryOptions) (*data.ESDTNFTTokenData, error) {
+	if stub.GetNFTTokenDataCalled != nil {
+		return stub.GetNFTTokenDataCalled(ctx, address, tokenIdentifier, nonce, queryOptions)
+	}
+
+	return &data.ESDTNFTTokenData{}, nil
+}
+
+// GetTransactionStatus -
+func (stub *ProxyStub) GetTransactionStatus(ctx context.Context, hash string) (string, error) {
+	if stub.GetTransactionStatusCalled != nil {
+		return stub.GetTransactionStatusCalled(ctx, hash)
+	}
+
+	return "", nil
+}
+
+// GetTransactionInfoWithResults -
+func (stub *ProxyStub) GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+	if stub.GetTransactionInfoWithResultsCalled != nil {
+		return stub.GetTransactionInfoWithResultsCalled(ctx, hash)
+	}
+
+	return &data.TransactionInfo{}, nil
+}
+
+// GetProof -
+func (stub *ProxyStub) GetProof(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler) (*data.AccountProof, error) {
+	if stub.GetProofCalled != nil {
+		return stub.GetProofCalled(ctx, rootHash, address)
+	}
+
+	return &data.AccountProof{}, nil
+}
+
+// GetProofDataTrie -
+func (stub *ProxyStub) GetProofDataTrie(ctx context.Context, rootHash []byte, address sdkCore.AddressHandler, key []byte) (*data.DataTrieProof, error) {
+	if stub.GetProofDataTrieCalled != nil {
+		return stub.GetProofDataTrieCalled(ctx, rootHash, address, key)
+	}
+
+	return &data.DataTrieProof{}, nil
+}
+
 // IsInterfaceNil -
 func (stub *ProxyStub) IsInterfaceNil() bool {
 	return stub == nil
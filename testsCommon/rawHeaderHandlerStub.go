@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
@@ -12,6 +13,9 @@ type RawHeaderHandlerStub struct {
 	GetMetaBlockByHashCalled        func(hash string) (data.MetaHeaderHandler, error)
 	GetShardBlockByHashCalled       func(shardId uint32, hash string) (data.HeaderHandler, error)
 	GetValidatorsInfoPerEpochCalled func(epoch uint32) ([]*state.ShardValidatorInfo, []byte, error)
+	GetStartOfEpochMetaBlockCalled  func(epoch uint32) (data.MetaHeaderHandler, error)
+	GetMiniBlockByHashCalled        func(shardId uint32, hash []byte, epoch uint32) (*block.MiniBlock, error)
+	GetValidatorsInfoByEpochCalled  func(epoch uint32) ([]*state.ShardValidatorInfo, error)
 }
 
 // GetMetaBlockByHash -
@@ -32,12 +36,36 @@ func (rh *RawHeaderHandlerStub) GetShardBlockByHash(_ context.Context, shardId u
 
 // GetValidatorsInfoPerEpoch -
 func (rh *RawHeaderHandlerStub) GetValidatorsInfoPerEpoch(_ context.Context, epoch uint32) ([]*state.ShardValidatorInfo, []byte, error) {
-	if rh.GetMetaBlockByHashCalled != nil {
+	if rh.GetValidatorsInfoPerEpochCalled != nil {
 		return rh.GetValidatorsInfoPerEpochCalled(epoch)
 	}
 	return nil, nil, nil
 }
 
+// GetStartOfEpochMetaBlock -
+func (rh *RawHeaderHandlerStub) GetStartOfEpochMetaBlock(_ context.Context, epoch uint32) (data.MetaHeaderHandler, error) {
+	if rh.GetStartOfEpochMetaBlockCalled != nil {
+		return rh.GetStartOfEpochMetaBlockCalled(epoch)
+	}
+	return nil, nil
+}
+
+// GetMiniBlockByHash -
+func (rh *RawHeaderHandlerStub) GetMiniBlockByHash(_ context.Context, shardId uint32, hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	if rh.GetMiniBlockByHashCalled != nil {
+		return rh.GetMiniBlockByHashCalled(shardId, hash, epoch)
+	}
+	return nil, nil
+}
+
+// GetValidatorsInfoByEpoch -
+func (rh *RawHeaderHandlerStub) GetValidatorsInfoByEpoch(_ context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error) {
+	if rh.GetValidatorsInfoByEpochCalled != nil {
+		return rh.GetValidatorsInfoByEpochCalled(epoch)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (rh *RawHeaderHandlerStub) IsInterfaceNil() bool {
 	return rh == nil
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// WebSocketServerMock is a local WebSocket server that records the received messages and can push messages
+// to all the connected clients
+type WebSocketServerMock struct {
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+
+	mut              sync.Mutex
+	conns            []*websocket.Conn
+	receivedMessages [][]byte
+	numConnections   int
+	ignorePings      bool
+	chConnected      chan struct{}
+	chReceived       chan struct{}
+}
+
+// NewWebSocketServerMock -
+func NewWebSocketServerMock() *WebSocketServerMock {
+	mock := &WebSocketServerMock{
+		chConnected: make(chan struct{}, 100),
+		chReceived:  make(chan struct{}, 100),
+	}
+	mock.server = httptest.NewServer(http.HandlerFunc(mock.handle))
+
+	return mock
+}
+
+func (mock *WebSocketServerMock) handle(writer http.ResponseWriter, request *http.Request) {
+	conn, err := mock.upgrader.Upgrade(writer, request, nil)
+	if err != nil {
+		return
+	}
+
+	mock.mut.Lock()
+	mock.conns = append(mock.conns, conn)
+	mock.numConnections++
+	if mock.ignorePings {
+		conn.SetPingHandler(func(_ string) error {
+			return nil
+		})
+	}
+	mock.mut.Unlock()
+	mock.notify(mock.chConnected)
+
+	for {
+		_, message, errRead := conn.ReadMessage()
+		if errRead != nil {
+			return
+		}
+
+		mock.mut.Lock()
+		mock.receivedMessages = append(mock.receivedMessages, message)
+		mock.mut.Unlock()
+		mock.notify(mock.chReceived)
+	}
+}
+
+func (mock *WebSocketServerMock) notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// URL returns the ws:// URL of the server
+func (mock *WebSocketServerMock) URL() string {
+	return "ws" + strings.TrimPrefix(mock.server.URL, "http")
+}
+
+// Connected returns a channel that receives a value on each new connection
+func (mock *WebSocketServerMock) Connected() <-chan struct{} {
+	return mock.chConnected
+}
+
+// Received returns a channel that receives a value on each received message
+func (mock *WebSocketServerMock) Received() <-chan struct{} {
+	return mock.chReceived
+}
+
+// ReceivedMessages -
+func (mock *WebSocketServerMock) ReceivedMessages() [][]byte {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	result := make([][]byte, len(mock.receivedMessages))
+	copy(result, mock.receivedMessages)
+
+	return result
+}
+
+// NumConnections returns the number of connections accepted so far
+func (mock *WebSocketServerMock) NumConnections() int {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	return mock.numConnections
+}
+
+// IgnorePings makes the new connections drop the received pings, as a half-open connection would
+func (mock *WebSocketServerMock) IgnorePings() {
+	mock.mut.Lock()
+	mock.ignorePings = true
+	mock.mut.Unlock()
+}
+
+// SendMessage sends the message to all the connected clients
+func (mock *WebSocketServerMock) SendMessage(message []byte) {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	for _, conn := range mock.conns {
+		_ = conn.WriteMessage(websocket.TextMessage, message)
+	}
+}
+
+// CloseConnections closes all the active connections
+func (mock *WebSocketServerMock) CloseConnections() {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	for _, conn := range mock.conns {
+		_ = conn.Close()
+	}
+	mock.conns = nil
+}
+
+// Close closes the connections and stops the server
+func (mock *WebSocketServerMock) Close() {
+	mock.CloseConnections()
+	mock.server.Close()
+}
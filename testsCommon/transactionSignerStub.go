@@ -0,0 +1,47 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// TransactionSignerStub -
+type TransactionSignerStub struct {
+	SignTransactionCalled   func(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error)
+	GetBech32Called         func() string
+	GetAddressHandlerCalled func() core.AddressHandler
+}
+
+// SignTransaction -
+func (stub *TransactionSignerStub) SignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) ([]byte, error) {
+	if stub.SignTransactionCalled != nil {
+		return stub.SignTransactionCalled(ctx, tx)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// GetBech32 -
+func (stub *TransactionSignerStub) GetBech32() string {
+	if stub.GetBech32Called != nil {
+		return stub.GetBech32Called()
+	}
+
+	return ""
+}
+
+// GetAddressHandler -
+func (stub *TransactionSignerStub) GetAddressHandler() core.AddressHandler {
+	if stub.GetAddressHandlerCalled != nil {
+		return stub.GetAddressHandlerCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -8,6 +8,7 @@ import (
 // TxBuilderStub -
 type TxBuilderStub struct {
 	ApplyUserSignatureCalled func(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ComputeTxHashCalled      func(tx *transaction.FrontendTransaction) ([]byte, error)
 }
 
 // ApplyUserSignature -
@@ -19,6 +20,15 @@ func (stub *TxBuilderStub) ApplyUserSignature(cryptoHolder sdkCore.CryptoCompone
 	return nil
 }
 
+// ComputeTxHash -
+func (stub *TxBuilderStub) ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error) {
+	if stub.ComputeTxHashCalled != nil {
+		return stub.ComputeTxHashCalled(tx)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (stub *TxBuilderStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,376 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/events"
+)
+
+// depositsDeduplicationWindow is the number of hyper blocks for which the reported deposits are remembered. A cross
+// shard transfer, or a smart contract result, might be included in more than one hyper block
+const depositsDeduplicationWindow = 100
+
+const minimumDepositTrackerRetryInterval = time.Millisecond
+
+type keyedDeposit struct {
+	key string
+	*data.Deposit
+}
+
+// DepositTrackerArgs is the argument DTO for the NewDepositTracker constructor function
+type DepositTrackerArgs struct {
+	TrackableAddressesProvider TrackableAddressesProvider
+	// HyperBlockStream provides the final hyper blocks, in order, and persists the processing progress
+	HyperBlockStream HyperBlockStream
+	// RetryInterval is the time waited before requesting the next hyper block again, after the stream failed
+	RetryInterval time.Duration
+	// MinimumAmounts holds the minimum deposit amount for each token identifier, use data.EGLDTokenIdentifier for EGLD.
+	// The deposits of tokens not found in this map are always reported
+	MinimumAmounts map[string]*big.Int
+}
+
+// depositTracker is able to detect the EGLD, ESDT, NFT and multi-token transfers received by a set of tracked
+// addresses. It parses the final hyper blocks one by one, checking the value of each transaction and smart contract
+// result along with the token transfer events found in their logs
+type depositTracker struct {
+	accumulator                *addressesAccumulator
+	trackableAddressesProvider TrackableAddressesProvider
+	hyperBlockStream           HyperBlockStream
+	retryInterval              time.Duration
+	minimumAmounts             map[string]*big.Int
+	decoder                    events.EventDecoder
+	cancelFunc                 func()
+
+	reportedDeposits map[string]uint64
+
+	mutHandlers       sync.RWMutex
+	handlerNewDeposit func(deposit data.Deposit)
+}
+
+// NewDepositTracker will create a new depositTracker instance. It automatically starts an inner
+// processLoop go routine that can be stopped by calling the Close method
+func NewDepositTracker(args DepositTrackerArgs) (*depositTracker, error) {
+	err := checkDepositTrackerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	minimumAmounts := make(map[string]*big.Int, len(args.MinimumAmounts))
+	for token, amount := range args.MinimumAmounts {
+		minimumAmounts[token] = big.NewInt(0).Set(amount)
+	}
+
+	dt := &depositTracker{
+		accumulator:                newAddressesAccumulator(),
+		trackableAddressesProvider: args.TrackableAddressesProvider,
+		hyperBlockStream:           args.HyperBlockStream,
+		retryInterval:              args.RetryInterval,
+		minimumAmounts:             minimumAmounts,
+		decoder:                    events.NewEventDecoderRegistry(),
+		reportedDeposits:           make(map[string]uint64),
+	}
+
+	var ctx context.Context
+	ctx, dt.cancelFunc = context.WithCancel(context.Background())
+	go dt.processLoop(ctx)
+
+	return dt, nil
+}
+
+func checkDepositTrackerArgs(args DepositTrackerArgs) error {
+	if check.IfNil(args.TrackableAddressesProvider) {
+		return ErrNilTrackableAddressesProvider
+	}
+	if check.IfNil(args.HyperBlockStream) {
+		return ErrNilHyperBlockStream
+	}
+	if args.RetryInterval < minimumDepositTrackerRetryInterval {
+		return fmt.Errorf("%w for RetryInterval", ErrInvalidValue)
+	}
+	for token, amount := range args.MinimumAmounts {
+		if amount == nil {
+			return fmt.Errorf("%w for token %s", ErrNilMinimumBalance, token)
+		}
+	}
+
+	return nil
+}
+
+func (dt *depositTracker) processLoop(ctx context.Context) {
+	log.Debug("depositTracker.processLoop started")
+
+	for {
+		block, err := dt.hyperBlockStream.Next(ctx)
+		if ctx.Err() != nil || errors.Is(err, ErrHyperBlockStreamClosed) {
+			log.Debug("terminating depositTracker.processLoop...")
+			return
+		}
+		if err != nil {
+			log.Warn("depositTracker: error fetching the next hyper block, retrying", "error", err)
+			if !dt.waitRetryInterval(ctx) {
+				log.Debug("terminating depositTracker.processLoop...")
+				return
+			}
+			continue
+		}
+
+		dt.processHyperBlock(block)
+		dt.hyperBlockStream.Commit()
+
+		log.Debug("depositTracker: processed hyper block", "nonce", block.Nonce, "hash", block.Hash, "num txs", block.NumTxs)
+	}
+}
+
+func (dt *depositTracker) waitRetryInterval(ctx context.Context) bool {
+	timer := time.NewTimer(dt.retryInterval)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (dt *depositTracker) processHyperBlock(block *data.HyperBlock) {
+	for _, tx := range block.Transactions {
+		if tx.Status == string(transaction.TxStatusFail) || tx.Status == string(transaction.TxStatusInvalid) {
+			continue
+		}
+
+		deposits := dt.extractDeposits(tx)
+		for _, deposit := range deposits {
+			if !dt.shouldReport(deposit) {
+				continue
+			}
+
+			dt.reportedDeposits[deposit.key] = block.Nonce
+			deposit.HyperBlockNonce = block.Nonce
+			dt.notifyNewDeposit(*deposit.Deposit)
+			dt.accumulator.push(deposit.Receiver)
+		}
+	}
+
+	dt.removeOldReportedDeposits(block.Nonce)
+}
+
+// extractDeposits returns the deposits found in the value and logs of the transaction and of its smart contract
+// results. Each deposit is identified by the logical transfer: the transaction hash, the receiver, the token and the
+// amount. A cross shard transfer event can be found both on the source and on the destination side, so it will be
+// reported only once
+func (dt *depositTracker) extractDeposits(tx data.TransactionOnNetwork) []*keyedDeposit {
+	deposits := make([]*keyedDeposit, 0)
+	deposits = append(deposits, keyDeposits(dt.extractValueDeposit(tx.Hash, tx.Sender, tx.Receiver, tx.Value))...)
+	deposits = append(deposits, keyDeposits(dt.extractLogDeposits(tx.Hash, tx.Hash, tx.Logs))...)
+
+	for _, scr := range tx.ScResults {
+		if scr == nil || scr.IsRefund {
+			continue
+		}
+
+		value := ""
+		if scr.Value != nil {
+			value = scr.Value.String()
+		}
+		deposits = append(deposits, keyDeposits(dt.extractValueDeposit(tx.Hash, scr.SndAddr, scr.RcvAddr, value))...)
+		deposits = append(deposits, keyDeposits(dt.extractLogDeposits(scr.Hash, tx.Hash, scr.Logs))...)
+	}
+
+	return deposits
+}
+
+// keyDeposits computes the keys of the deposits produced by the same transaction or smart contract result. Identical
+// transfers from the same source are told apart by their occurrence index, so that all of them are reported
+func keyDeposits(deposits []*data.Deposit) []*keyedDeposit {
+	occurrences := make(map[string]int)
+	keyedDeposits := make([]*keyedDeposit, 0, len(deposits))
+	for _, deposit := range deposits {
+		transferKey := fmt.Sprintf("%s-%s-%s-%d-%s", deposit.TxHash, deposit.Receiver, deposit.Token, deposit.Nonce, deposit.Amount.String())
+		keyedDeposits = append(keyedDeposits, &keyedDeposit{
+			key:     fmt.Sprintf("%s-%d", transferKey, occurrences[transferKey]),
+			Deposit: deposit,
+		})
+		occurrences[transferKey]++
+	}
+
+	return keyedDeposits
+}
+
+func (dt *depositTracker) extractValueDeposit(txHash string, sender string, receiver string, value string) []*data.Deposit {
+	amount, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || amount.Sign() <= 0 {
+		return nil
+	}
+
+	return []*data.Deposit{
+		{
+			Token:    data.EGLDTokenIdentifier,
+			Amount:   amount,
+			Sender:   sender,
+			Receiver: receiver,
+			TxHash:   txHash,
+		},
+	}
+}
+
+func (dt *depositTracker) extractLogDeposits(sourceHash string, txHash string, logs *transaction.ApiLogs) []*data.Deposit {
+	if logs == nil {
+		return nil
+	}
+
+	deposits := make([]*data.Deposit, 0)
+	for index, event := range logs.Events {
+		if event == nil || !isTokenTransferEvent(event.Identifier) {
+			continue
+		}
+
+		decoded, err := dt.decoder.Decode(event)
+		if err != nil {
+			log.Warn("depositTracker: error decoding transfer event, ignoring",
+				"hash", sourceHash, "event index", index, "error", err)
+			continue
+		}
+
+		newDeposits, err := convertTransferEventToDeposits(decoded, txHash)
+		if err != nil {
+			log.Warn("depositTracker: error converting transfer event, ignoring",
+				"hash", sourceHash, "event index", index, "error", err)
+			continue
+		}
+		deposits = append(deposits, newDeposits...)
+	}
+
+	return deposits
+}
+
+func isTokenTransferEvent(identifier string) bool {
+	switch identifier {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+func convertTransferEventToDeposits(decoded interface{}, txHash string) ([]*data.Deposit, error) {
+	switch event := decoded.(type) {
+	case *events.ESDTTransfer:
+		return createDeposits(event.Sender, event.Receiver, txHash, events.TokenTransfer{
+			Token:  event.Token,
+			Amount: event.Amount,
+		})
+	case *events.ESDTNFTTransfer:
+		return createDeposits(event.Sender, event.Receiver, txHash, events.TokenTransfer{
+			Token:  event.Token,
+			Nonce:  event.Nonce,
+			Amount: event.Amount,
+		})
+	case *events.MultiESDTNFTTransfer:
+		return createDeposits(event.Sender, event.Receiver, txHash, event.Transfers...)
+	default:
+		return nil, fmt.Errorf("%w, unexpected decoded event type %T", ErrInvalidValue, decoded)
+	}
+}
+
+func createDeposits(sender sdkCore.AddressHandler, receiver sdkCore.AddressHandler, txHash string, transfers ...events.TokenTransfer) ([]*data.Deposit, error) {
+	senderBech32, err := sender.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+	receiverBech32, err := receiver.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]*data.Deposit, 0, len(transfers))
+	for _, transfer := range transfers {
+		deposits = append(deposits, &data.Deposit{
+			Token:    transfer.Token,
+			Nonce:    transfer.Nonce,
+			Amount:   transfer.Amount,
+			Sender:   senderBech32,
+			Receiver: receiverBech32,
+			TxHash:   txHash,
+		})
+	}
+
+	return deposits, nil
+}
+
+func (dt *depositTracker) shouldReport(deposit *keyedDeposit) bool {
+	if !dt.trackableAddressesProvider.IsTrackableAddresses(deposit.Receiver) {
+		return false
+	}
+
+	minimumAmount, found := dt.minimumAmounts[deposit.Token]
+	if found && deposit.Amount.Cmp(minimumAmount) < 0 {
+		// deposits with very small amounts are ignored (possible attack vector as someone
+		// can trigger millions of these transfers as to consume the owner's balance through fees)
+		return false
+	}
+
+	_, alreadyReported := dt.reportedDeposits[deposit.key]
+
+	return !alreadyReported
+}
+
+func (dt *depositTracker) removeOldReportedDeposits(nonce uint64) {
+	if nonce < depositsDeduplicationWindow {
+		return
+	}
+
+	for key, reportedNonce := range dt.reportedDeposits {
+		if reportedNonce <= nonce-depositsDeduplicationWindow {
+			delete(dt.reportedDeposits, key)
+		}
+	}
+}
+
+func (dt *depositTracker) notifyNewDeposit(deposit data.Deposit) {
+	dt.mutHandlers.RLock()
+	defer dt.mutHandlers.RUnlock()
+
+	if dt.handlerNewDeposit != nil {
+		dt.handlerNewDeposit(deposit)
+	}
+}
+
+// SetHandlerForNewDeposit will set the handler that will get notified each time a new deposit
+// is found on a hyper block
+func (dt *depositTracker) SetHandlerForNewDeposit(handler func(deposit data.Deposit)) {
+	if handler == nil {
+		return
+	}
+
+	dt.mutHandlers.Lock()
+	dt.handlerNewDeposit = handler
+	dt.mutHandlers.Unlock()
+}
+
+// GetLatestTrackedAddresses returns the accumulated addresses that received deposits
+func (dt *depositTracker) GetLatestTrackedAddresses() []string {
+	return dt.accumulator.pop()
+}
+
+// Close will close the process loop go routine
+func (dt *depositTracker) Close() error {
+	dt.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dt *depositTracker) IsInterfaceNil() bool {
+	return dt == nil
+}
@@ -0,0 +1,435 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	trackedAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	otherAddress   = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+	senderAddress  = "erd1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq6mjse8"
+)
+
+func createMockDepositTrackerArgs() DepositTrackerArgs {
+	return DepositTrackerArgs{
+		TrackableAddressesProvider: &testsCommon.TrackableAddressesProviderStub{
+			IsTrackableAddressesCalled: func(addressAsBech32 string) bool {
+				return addressAsBech32 == trackedAddress
+			},
+		},
+		HyperBlockStream: &testsCommon.HyperBlockStreamStub{},
+		RetryInterval:    time.Hour,
+	}
+}
+
+func addressBytes(tb testing.TB, bech32 string) []byte {
+	address, err := data.NewAddressFromBech32String(bech32)
+	require.Nil(tb, err)
+
+	return address.AddressBytes()
+}
+
+func createESDTTransferEvent(tb testing.TB, token string, nonce uint64, amount int64, receiver string) *transaction.Events {
+	identifier := "ESDTTransfer"
+	if nonce > 0 {
+		identifier = "ESDTNFTTransfer"
+	}
+
+	return &transaction.Events{
+		Address:    senderAddress,
+		Identifier: identifier,
+		Topics: [][]byte{
+			[]byte(token),
+			big.NewInt(0).SetUint64(nonce).Bytes(),
+			big.NewInt(amount).Bytes(),
+			addressBytes(tb, receiver),
+		},
+	}
+}
+
+type depositsRecorder struct {
+	mut      sync.Mutex
+	deposits []data.Deposit
+}
+
+func (recorder *depositsRecorder) handle(deposit data.Deposit) {
+	recorder.mut.Lock()
+	recorder.deposits = append(recorder.deposits, deposit)
+	recorder.mut.Unlock()
+}
+
+func (recorder *depositsRecorder) get() []data.Deposit {
+	recorder.mut.Lock()
+	defer recorder.mut.Unlock()
+
+	return append(make([]data.Deposit, 0, len(recorder.deposits)), recorder.deposits...)
+}
+
+func TestNewDepositTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trackable addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDepositTrackerArgs()
+		args.TrackableAddressesProvider = nil
+		dt, err := NewDepositTracker(args)
+		assert.True(t, check.IfNil(dt))
+		assert.Equal(t, ErrNilTrackableAddressesProvider, err)
+	})
+	t.Run("nil hyper block stream should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDepositTrackerArgs()
+		args.HyperBlockStream = nil
+		dt, err := NewDepositTracker(args)
+		assert.True(t, check.IfNil(dt))
+		assert.Equal(t, ErrNilHyperBlockStream, err)
+	})
+	t.Run("invalid retry interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDepositTrackerArgs()
+		args.RetryInterval = 0
+		dt, err := NewDepositTracker(args)
+		assert.True(t, check.IfNil(dt))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+	})
+	t.Run("nil minimum amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDepositTrackerArgs()
+		args.MinimumAmounts = map[string]*big.Int{"TKN-abcdef": nil}
+		dt, err := NewDepositTracker(args)
+		assert.True(t, check.IfNil(dt))
+		assert.ErrorIs(t, err, ErrNilMinimumBalance)
+		assert.Contains(t, err.Error(), "TKN-abcdef")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dt, err := NewDepositTracker(createMockDepositTrackerArgs())
+		assert.False(t, check.IfNil(dt))
+		assert.Nil(t, err)
+		assert.Nil(t, dt.Close())
+	})
+}
+
+func TestDepositTracker_ProcessHyperBlock(t *testing.T) {
+	t.Parallel()
+
+	scrValue, _ := big.NewInt(0).SetString("5000000000000000000", 10)
+	block := &data.HyperBlock{
+		Nonce: 10,
+		Transactions: []data.TransactionOnNetwork{
+			{
+				Hash:     "egld-tx",
+				Sender:   senderAddress,
+				Receiver: trackedAddress,
+				Value:    "1000",
+				Status:   "success",
+			},
+			{
+				Hash:     "egld-tx-other-receiver",
+				Sender:   senderAddress,
+				Receiver: otherAddress,
+				Value:    "1000",
+			},
+			{
+				Hash:     "failed-tx",
+				Sender:   senderAddress,
+				Receiver: trackedAddress,
+				Value:    "1000",
+				Status:   "fail",
+			},
+			{
+				Hash:     "esdt-tx",
+				Sender:   senderAddress,
+				Receiver: trackedAddress,
+				Value:    "0",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						createESDTTransferEvent(t, "TKN-abcdef", 0, 250, trackedAddress),
+						{Address: trackedAddress, Identifier: "writeLog"},
+						{Address: senderAddress, Identifier: "ESDTTransfer", Topics: [][]byte{[]byte("malformed")}},
+					},
+				},
+			},
+			{
+				Hash:     "multi-tx",
+				Sender:   senderAddress,
+				Receiver: senderAddress,
+				Value:    "0",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{
+							Address:    senderAddress,
+							Identifier: "MultiESDTNFTTransfer",
+							Topics: [][]byte{
+								[]byte("TKN-abcdef"), nil, big.NewInt(7).Bytes(),
+								[]byte("NFT-abcdef"), {0x03}, big.NewInt(1).Bytes(),
+								addressBytes(t, trackedAddress),
+							},
+						},
+					},
+				},
+			},
+			{
+				Hash:     "sc-call-tx",
+				Sender:   senderAddress,
+				Receiver: otherAddress,
+				Value:    "0",
+				ScResults: []*transaction.ApiSmartContractResult{
+					{
+						Hash:     "refund-scr",
+						SndAddr:  otherAddress,
+						RcvAddr:  trackedAddress,
+						Value:    big.NewInt(100),
+						IsRefund: true,
+					},
+					{
+						Hash:    "egld-scr",
+						SndAddr: otherAddress,
+						RcvAddr: trackedAddress,
+						Value:   scrValue,
+					},
+					{
+						Hash:    "nft-scr",
+						SndAddr: otherAddress,
+						RcvAddr: trackedAddress,
+						Value:   big.NewInt(0),
+						Logs: &transaction.ApiLogs{
+							Events: []*transaction.Events{
+								createESDTTransferEvent(t, "NFT-abcdef", 9, 1, trackedAddress),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dt, _ := NewDepositTracker(createMockDepositTrackerArgs())
+	defer func() {
+		_ = dt.Close()
+	}()
+	recorder := &depositsRecorder{}
+	dt.SetHandlerForNewDeposit(recorder.handle)
+
+	dt.processHyperBlock(block)
+
+	expected := []data.Deposit{
+		{Token: "EGLD", Amount: big.NewInt(1000), Sender: senderAddress, Receiver: trackedAddress, TxHash: "egld-tx", HyperBlockNonce: 10},
+		{Token: "TKN-abcdef", Amount: big.NewInt(250), Sender: senderAddress, Receiver: trackedAddress, TxHash: "esdt-tx", HyperBlockNonce: 10},
+		{Token: "TKN-abcdef", Amount: big.NewInt(7), Sender: senderAddress, Receiver: trackedAddress, TxHash: "multi-tx", HyperBlockNonce: 10},
+		{Token: "NFT-abcdef", Nonce: 3, Amount: big.NewInt(1), Sender: senderAddress, Receiver: trackedAddress, TxHash: "multi-tx", HyperBlockNonce: 10},
+		{Token: "EGLD", Amount: scrValue, Sender: otherAddress, Receiver: trackedAddress, TxHash: "sc-call-tx", HyperBlockNonce: 10},
+		{Token: "NFT-abcdef", Nonce: 9, Amount: big.NewInt(1), Sender: senderAddress, Receiver: trackedAddress, TxHash: "sc-call-tx", HyperBlockNonce: 10},
+	}
+	assert.Equal(t, expected, recorder.get())
+	assert.Equal(t, []string{trackedAddress}, dt.GetLatestTrackedAddresses())
+	assert.Empty(t, dt.GetLatestTrackedAddresses())
+}
+
+func TestDepositTracker_MinimumAmounts(t *testing.T) {
+	t.Parallel()
+
+	args := createMockDepositTrackerArgs()
+	args.MinimumAmounts = map[string]*big.Int{
+		data.EGLDTokenIdentifier: big.NewInt(1000),
+		"TKN-abcdef":             big.NewInt(100),
+	}
+	dt, _ := NewDepositTracker(args)
+	defer func() {
+		_ = dt.Close()
+	}()
+	recorder := &depositsRecorder{}
+	dt.SetHandlerForNewDeposit(recorder.handle)
+
+	dt.processHyperBlock(&data.HyperBlock{
+		Nonce: 1,
+		Transactions: []data.TransactionOnNetwork{
+			{Hash: "small-egld", Sender: senderAddress, Receiver: trackedAddress, Value: "999"},
+			{Hash: "egld", Sender: senderAddress, Receiver: trackedAddress, Value: "1000"},
+			{
+				Hash:  "tokens",
+				Value: "0",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						createESDTTransferEvent(t, "TKN-abcdef", 0, 99, trackedAddress),
+						createESDTTransferEvent(t, "TKN-abcdef", 0, 100, trackedAddress),
+						createESDTTransferEvent(t, "OTHER-abcdef", 0, 1, trackedAddress),
+					},
+				},
+			},
+		},
+	})
+
+	deposits := recorder.get()
+	require.Equal(t, 3, len(deposits))
+	assert.Equal(t, "egld", deposits[0].TxHash)
+	assert.Equal(t, big.NewInt(100), deposits[1].Amount)
+	assert.Equal(t, "OTHER-abcdef", deposits[2].Token)
+}
+
+func TestDepositTracker_DeduplicatesDeposits(t *testing.T) {
+	t.Parallel()
+
+	scr := &transaction.ApiSmartContractResult{
+		Hash:    "cross-shard-scr",
+		SndAddr: otherAddress,
+		RcvAddr: trackedAddress,
+		Value:   big.NewInt(0),
+		Logs: &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				createESDTTransferEvent(t, "TKN-abcdef", 0, 5, trackedAddress),
+			},
+		},
+	}
+	createBlock := func(nonce uint64) *data.HyperBlock {
+		return &data.HyperBlock{
+			Nonce: nonce,
+			Transactions: []data.TransactionOnNetwork{
+				{Hash: "tx", Value: "0", ScResults: []*transaction.ApiSmartContractResult{scr}},
+			},
+		}
+	}
+
+	dt, _ := NewDepositTracker(createMockDepositTrackerArgs())
+	defer func() {
+		_ = dt.Close()
+	}()
+	recorder := &depositsRecorder{}
+	dt.SetHandlerForNewDeposit(recorder.handle)
+
+	dt.processHyperBlock(createBlock(200))
+	dt.processHyperBlock(createBlock(201))
+	assert.Equal(t, 1, len(recorder.get()))
+
+	dt.processHyperBlock(createBlock(300))
+	assert.Equal(t, 1, len(recorder.get()))
+
+	dt.processHyperBlock(createBlock(401))
+	assert.Equal(t, 2, len(recorder.get()))
+}
+
+func TestDepositTracker_DeduplicatesCrossShardTransfers(t *testing.T) {
+	t.Parallel()
+
+	dt, _ := NewDepositTracker(createMockDepositTrackerArgs())
+	defer func() {
+		_ = dt.Close()
+	}()
+	recorder := &depositsRecorder{}
+	dt.SetHandlerForNewDeposit(recorder.handle)
+
+	// the same transfer event is logged on the source shard and by the smart contract result on the destination shard
+	dt.processHyperBlock(&data.HyperBlock{
+		Nonce: 1,
+		Transactions: []data.TransactionOnNetwork{
+			{
+				Hash:  "cross-shard-tx",
+				Value: "0",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						createESDTTransferEvent(t, "TKN-abcdef", 0, 5, trackedAddress),
+					},
+				},
+				ScResults: []*transaction.ApiSmartContractResult{
+					{
+						Hash:    "destination-scr",
+						SndAddr: senderAddress,
+						RcvAddr: trackedAddress,
+						Value:   big.NewInt(0),
+						Logs: &transaction.ApiLogs{
+							Events: []*transaction.Events{
+								createESDTTransferEvent(t, "TKN-abcdef", 0, 5, trackedAddress),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.Equal(t, 1, len(recorder.get()))
+
+	// identical transfers of the same transaction are all reported
+	dt.processHyperBlock(&data.HyperBlock{
+		Nonce: 2,
+		Transactions: []data.TransactionOnNetwork{
+			{
+				Hash:  "double-transfer-tx",
+				Value: "0",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						createESDTTransferEvent(t, "TKN-abcdef", 0, 5, trackedAddress),
+						createESDTTransferEvent(t, "TKN-abcdef", 0, 5, trackedAddress),
+					},
+				},
+			},
+		},
+	})
+	deposits := recorder.get()
+	require.Equal(t, 3, len(deposits))
+	assert.Equal(t, "cross-shard-tx", deposits[0].TxHash)
+	assert.Equal(t, "double-transfer-tx", deposits[1].TxHash)
+	assert.Equal(t, "double-transfer-tx", deposits[2].TxHash)
+}
+
+func TestDepositTracker_ProcessLoop(t *testing.T) {
+	t.Parallel()
+
+	handlerSet := int32(0)
+	numBlocks := int32(0)
+	numCommits := int32(0)
+	args := createMockDepositTrackerArgs()
+	args.RetryInterval = time.Millisecond
+	args.HyperBlockStream = &testsCommon.HyperBlockStreamStub{
+		NextCalled: func(ctx context.Context) (*data.HyperBlock, error) {
+			if atomic.LoadInt32(&handlerSet) == 0 {
+				return nil, errors.New("temporary error")
+			}
+			if atomic.LoadInt32(&numBlocks) == 2 {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+
+			return &data.HyperBlock{
+				Nonce: uint64(atomic.AddInt32(&numBlocks, 1)) + 4,
+				Transactions: []data.TransactionOnNetwork{
+					{Hash: "tx", Sender: senderAddress, Receiver: trackedAddress, Value: "1"},
+				},
+			}, nil
+		},
+		CommitCalled: func() {
+			atomic.AddInt32(&numCommits, 1)
+		},
+	}
+
+	recorder := &depositsRecorder{}
+	dt, _ := NewDepositTracker(args)
+	dt.SetHandlerForNewDeposit(recorder.handle)
+	atomic.StoreInt32(&handlerSet, 1)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numCommits) == 2
+	}, time.Second*5, time.Millisecond)
+
+	require.Nil(t, dt.Close())
+	deposits := recorder.get()
+	require.Equal(t, 1, len(deposits))
+	assert.Equal(t, uint64(5), deposits[0].HyperBlockNonce)
+}
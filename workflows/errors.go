@@ -22,3 +22,51 @@ var ErrNilMinimumBalance = errors.New("nil minimum balance")
 
 // ErrNilTransactionInteractor signals that a nil transaction interactor was provided
 var ErrNilTransactionInteractor = errors.New("nil transaction interactor")
+
+// ErrNilFinalityProvider signals that a nil finality provider was provided
+var ErrNilFinalityProvider = errors.New("nil finality provider")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilHyperBlockStream signals that a nil hyper block stream was provided
+var ErrNilHyperBlockStream = errors.New("nil hyper block stream")
+
+// ErrHyperBlockStreamClosed signals that the hyper block stream was closed
+var ErrHyperBlockStreamClosed = errors.New("hyper block stream closed")
+
+// ErrNilHyperBlock signals that a nil hyper block was received
+var ErrNilHyperBlock = errors.New("nil hyper block")
+
+// ErrHyperBlockNonceMismatch signals that the received hyper block does not have the requested nonce
+var ErrHyperBlockNonceMismatch = errors.New("hyper block nonce mismatch")
+
+// ErrHyperBlockHashMismatch signals that the received hyper block does not link to the previously emitted hyper block
+var ErrHyperBlockHashMismatch = errors.New("hyper block previous hash mismatch")
+
+// ErrNilSweepState signals that a nil sweep state was provided
+var ErrNilSweepState = errors.New("nil sweep state")
+
+// ErrNilSweepStateStorage signals that a nil sweep state storage was provided
+var ErrNilSweepStateStorage = errors.New("nil sweep state storage")
+
+// ErrNilTxBuilder signals that a nil transaction builder was provided
+var ErrNilTxBuilder = errors.New("nil tx builder")
+
+// ErrNilHotWallet signals that a nil hot wallet was provided
+var ErrNilHotWallet = errors.New("nil hot wallet")
+
+// ErrInvalidGasLimitPerTransfer signals that an invalid gas limit per transfer was provided
+var ErrInvalidGasLimitPerTransfer = errors.New("invalid gas limit per transfer")
+
+// ErrUntrackedAddress signals that the provided address is not tracked
+var ErrUntrackedAddress = errors.New("untracked address")
+
+// ErrNilNetworkConfigs signals that nil network configs were received
+var ErrNilNetworkConfigs = errors.New("nil network configs")
+
+// ErrNilAccount signals that a nil account was received
+var ErrNilAccount = errors.New("nil account")
+
+// ErrDepositHandlerFailed signals that the deposit handler returned an error
+var ErrDepositHandlerFailed = errors.New("deposit handler failed")
@@ -0,0 +1,303 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const minimumStreamInterval = time.Millisecond
+
+// ArgsHyperBlockStream is the argument DTO for the NewHyperBlockStream constructor function
+type ArgsHyperBlockStream struct {
+	Proxy            HyperBlockProvider
+	FinalityProvider FinalityProvider
+	NonceHandler     LastProcessedNonceHandler
+	// StartNonce is the first nonce that will be emitted if the nonce handler does not hold any progress
+	StartNonce uint64
+	// ConfirmationBlocks is the number of hyper blocks the stream will keep behind the latest network nonce
+	ConfirmationBlocks uint64
+	// MaxNoncesDelta is the value used when checking the metachain finalization status
+	MaxNoncesDelta    uint64
+	NumPrefetchBlocks int
+	PollingInterval   time.Duration
+	RetryInterval     time.Duration
+	MaxRetries        int
+}
+
+type hyperBlockFetchResult struct {
+	done  chan struct{}
+	block *data.HyperBlock
+	err   error
+}
+
+// hyperBlockStream is able to emit hyper blocks, in order, starting from a checkpoint. A hyper block is emitted
+// only after the metachain is in sync and the block is at least ConfirmationBlocks behind the network's latest nonce.
+// The next NumPrefetchBlocks hyper blocks are fetched concurrently
+type hyperBlockStream struct {
+	proxy              HyperBlockProvider
+	finalityProvider   FinalityProvider
+	nonceHandler       LastProcessedNonceHandler
+	confirmationBlocks uint64
+	maxNoncesDelta     uint64
+	numPrefetchBlocks  int
+	pollingInterval    time.Duration
+	retryInterval      time.Duration
+	maxRetries         int
+
+	mutEmitted       sync.RWMutex
+	lastEmittedNonce uint64
+
+	mutNext          sync.Mutex
+	lastEmittedHash  string
+	latestFinalNonce uint64
+	pendingFetches   map[uint64]*hyperBlockFetchResult
+
+	ctx       context.Context
+	cancelCtx func()
+}
+
+// NewHyperBlockStream creates a new instance of the hyperBlockStream struct
+func NewHyperBlockStream(args ArgsHyperBlockStream) (*hyperBlockStream, error) {
+	err := checkArgsHyperBlockStream(args)
+	if err != nil {
+		return nil, err
+	}
+
+	lastProcessedNonce := args.NonceHandler.GetLastProcessedNonce()
+	if lastProcessedNonce == 0 && args.StartNonce > 0 {
+		lastProcessedNonce = args.StartNonce - 1
+	}
+
+	stream := &hyperBlockStream{
+		proxy:              args.Proxy,
+		finalityProvider:   args.FinalityProvider,
+		nonceHandler:       args.NonceHandler,
+		confirmationBlocks: args.ConfirmationBlocks,
+		maxNoncesDelta:     args.MaxNoncesDelta,
+		numPrefetchBlocks:  args.NumPrefetchBlocks,
+		pollingInterval:    args.PollingInterval,
+		retryInterval:      args.RetryInterval,
+		maxRetries:         args.MaxRetries,
+		lastEmittedNonce:   lastProcessedNonce,
+		pendingFetches:     make(map[uint64]*hyperBlockFetchResult),
+	}
+	stream.ctx, stream.cancelCtx = context.WithCancel(context.Background())
+
+	return stream, nil
+}
+
+func checkArgsHyperBlockStream(args ArgsHyperBlockStream) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilProxy
+	}
+	if check.IfNil(args.FinalityProvider) {
+		return ErrNilFinalityProvider
+	}
+	if check.IfNil(args.NonceHandler) {
+		return ErrNilLastProcessedNonceHandler
+	}
+	if args.MaxNoncesDelta < sdkCore.MinAllowedDeltaToFinal {
+		return fmt.Errorf("%w for MaxNoncesDelta, provided: %d, minimum: %d",
+			ErrInvalidValue, args.MaxNoncesDelta, sdkCore.MinAllowedDeltaToFinal)
+	}
+	if args.NumPrefetchBlocks < 1 {
+		return fmt.Errorf("%w for NumPrefetchBlocks, provided: %d", ErrInvalidValue, args.NumPrefetchBlocks)
+	}
+	if args.PollingInterval < minimumStreamInterval {
+		return fmt.Errorf("%w for PollingInterval", ErrInvalidValue)
+	}
+	if args.RetryInterval < minimumStreamInterval {
+		return fmt.Errorf("%w for RetryInterval", ErrInvalidValue)
+	}
+	if args.MaxRetries < 0 {
+		return fmt.Errorf("%w for MaxRetries, provided: %d", ErrInvalidValue, args.MaxRetries)
+	}
+
+	return nil
+}
+
+// Next blocks until the next final hyper block is available and returns it. Temporary errors are retried for
+// MaxRetries times before being returned. Calling Next does not persist the progress, use Commit for that
+func (stream *hyperBlockStream) Next(ctx context.Context) (*data.HyperBlock, error) {
+	stream.mutNext.Lock()
+	defer stream.mutNext.Unlock()
+
+	nonce := stream.getLastEmittedNonce() + 1
+	numFailures := 0
+	for {
+		if stream.ctx.Err() != nil {
+			return nil, ErrHyperBlockStreamClosed
+		}
+
+		block, isFinal, err := stream.tryGetBlock(ctx, nonce)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err == nil && isFinal {
+			stream.mutEmitted.Lock()
+			stream.lastEmittedNonce = block.Nonce
+			stream.mutEmitted.Unlock()
+			stream.lastEmittedHash = block.Hash
+
+			return block, nil
+		}
+
+		interval := stream.pollingInterval
+		if err != nil {
+			numFailures++
+			if numFailures > stream.maxRetries {
+				return nil, err
+			}
+
+			log.Debug("hyperBlockStream.Next: temporary error, retrying",
+				"nonce", nonce, "num failures", numFailures, "error", err)
+			interval = stream.retryInterval
+		}
+
+		err = stream.wait(ctx, interval)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (stream *hyperBlockStream) tryGetBlock(ctx context.Context, nonce uint64) (*data.HyperBlock, bool, error) {
+	if nonce > stream.latestFinalNonce {
+		err := stream.updateLatestFinalNonce(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if nonce > stream.latestFinalNonce {
+			return nil, false, nil
+		}
+	}
+
+	stream.schedulePrefetch(nonce)
+	result := stream.pendingFetches[nonce]
+	select {
+	case <-result.done:
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+	delete(stream.pendingFetches, nonce)
+
+	if result.err != nil {
+		return nil, false, result.err
+	}
+
+	err := stream.checkBlock(nonce, result.block)
+	if err != nil {
+		// the prefetched blocks might be on the same wrong chain, fetch them again
+		stream.pendingFetches = make(map[uint64]*hyperBlockFetchResult)
+		return nil, false, err
+	}
+
+	return result.block, true, nil
+}
+
+func (stream *hyperBlockStream) updateLatestFinalNonce(ctx context.Context) error {
+	err := stream.finalityProvider.CheckShardFinalization(ctx, core.MetachainShardId, stream.maxNoncesDelta)
+	if err != nil {
+		return err
+	}
+
+	latestNonce, err := stream.proxy.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		return err
+	}
+	if latestNonce < stream.confirmationBlocks {
+		return nil
+	}
+
+	latestFinalNonce := latestNonce - stream.confirmationBlocks
+	if latestFinalNonce > stream.latestFinalNonce {
+		stream.latestFinalNonce = latestFinalNonce
+	}
+
+	return nil
+}
+
+func (stream *hyperBlockStream) schedulePrefetch(nonce uint64) {
+	lastNonce := nonce + uint64(stream.numPrefetchBlocks) - 1
+	if lastNonce > stream.latestFinalNonce {
+		lastNonce = stream.latestFinalNonce
+	}
+
+	for n := nonce; n <= lastNonce; n++ {
+		_, found := stream.pendingFetches[n]
+		if found {
+			continue
+		}
+
+		result := &hyperBlockFetchResult{
+			done: make(chan struct{}),
+		}
+		stream.pendingFetches[n] = result
+		go stream.fetch(n, result)
+	}
+}
+
+func (stream *hyperBlockStream) fetch(nonce uint64, result *hyperBlockFetchResult) {
+	result.block, result.err = stream.proxy.GetHyperBlockByNonce(stream.ctx, nonce)
+	close(result.done)
+}
+
+func (stream *hyperBlockStream) checkBlock(nonce uint64, block *data.HyperBlock) error {
+	if block == nil {
+		return fmt.Errorf("%w for nonce %d", ErrNilHyperBlock, nonce)
+	}
+	if block.Nonce != nonce {
+		return fmt.Errorf("%w, requested %d, received %d", ErrHyperBlockNonceMismatch, nonce, block.Nonce)
+	}
+	if len(stream.lastEmittedHash) > 0 && block.PrevBlockHash != stream.lastEmittedHash {
+		return fmt.Errorf("%w for nonce %d, expected %s, received %s",
+			ErrHyperBlockHashMismatch, nonce, stream.lastEmittedHash, block.PrevBlockHash)
+	}
+
+	return nil
+}
+
+func (stream *hyperBlockStream) wait(ctx context.Context, interval time.Duration) error {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-stream.ctx.Done():
+		return ErrHyperBlockStreamClosed
+	}
+}
+
+func (stream *hyperBlockStream) getLastEmittedNonce() uint64 {
+	stream.mutEmitted.RLock()
+	defer stream.mutEmitted.RUnlock()
+
+	return stream.lastEmittedNonce
+}
+
+// Commit persists the nonce of the last emitted hyper block through the LastProcessedNonceHandler
+func (stream *hyperBlockStream) Commit() {
+	stream.nonceHandler.ProcessedNonce(stream.getLastEmittedNonce())
+}
+
+// Close stops the stream and any prefetch go routines
+func (stream *hyperBlockStream) Close() error {
+	stream.cancelCtx()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (stream *hyperBlockStream) IsInterfaceNil() bool {
+	return stream == nil
+}
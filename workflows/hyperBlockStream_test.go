@@ -0,0 +1,360 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createMockArgsHyperBlockStream() ArgsHyperBlockStream {
+	return ArgsHyperBlockStream{
+		Proxy:             &testsCommon.ProxyStub{},
+		FinalityProvider:  &testsCommon.FinalityProviderStub{},
+		NonceHandler:      &testsCommon.LastProcessedNonceHandlerStub{},
+		MaxNoncesDelta:    1,
+		NumPrefetchBlocks: 4,
+		PollingInterval:   time.Millisecond,
+		RetryInterval:     time.Millisecond,
+		MaxRetries:        2,
+	}
+}
+
+func createHyperBlock(nonce uint64) *data.HyperBlock {
+	return &data.HyperBlock{
+		Nonce:         nonce,
+		Hash:          fmt.Sprintf("hash%d", nonce),
+		PrevBlockHash: fmt.Sprintf("hash%d", nonce-1),
+	}
+}
+
+func TestNewHyperBlockStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		args.Proxy = nil
+		stream, err := NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil finality provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		args.FinalityProvider = nil
+		stream, err := NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.Equal(t, ErrNilFinalityProvider, err)
+	})
+	t.Run("nil nonce handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		args.NonceHandler = nil
+		stream, err := NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.Equal(t, ErrNilLastProcessedNonceHandler, err)
+	})
+	t.Run("invalid values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		args.MaxNoncesDelta = 0
+		stream, err := NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxNoncesDelta")
+
+		args = createMockArgsHyperBlockStream()
+		args.NumPrefetchBlocks = 0
+		stream, err = NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "NumPrefetchBlocks")
+
+		args = createMockArgsHyperBlockStream()
+		args.PollingInterval = 0
+		stream, err = NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "PollingInterval")
+
+		args = createMockArgsHyperBlockStream()
+		args.RetryInterval = 0
+		stream, err = NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "RetryInterval")
+
+		args = createMockArgsHyperBlockStream()
+		args.MaxRetries = -1
+		stream, err = NewHyperBlockStream(args)
+		assert.True(t, check.IfNil(stream))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxRetries")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		stream, err := NewHyperBlockStream(createMockArgsHyperBlockStream())
+		assert.False(t, check.IfNil(stream))
+		assert.Nil(t, err)
+		assert.Nil(t, stream.Close())
+	})
+}
+
+func TestHyperBlockStream_Next(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should emit final blocks in order starting from the checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		args.ConfirmationBlocks = 2
+		args.NonceHandler = &testsCommon.LastProcessedNonceHandlerStub{
+			GetLastProcessedNonceCalled: func() uint64 {
+				return 10
+			},
+		}
+		mutFetched := sync.Mutex{}
+		fetched := make(map[uint64]int)
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 20, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				mutFetched.Lock()
+				fetched[nonce]++
+				mutFetched.Unlock()
+
+				return createHyperBlock(nonce), nil
+			},
+		}
+		stream, _ := NewHyperBlockStream(args)
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		for nonce := uint64(11); nonce <= 18; nonce++ {
+			block, err := stream.Next(context.Background())
+			require.Nil(t, err)
+			assert.Equal(t, nonce, block.Nonce)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+		block, err := stream.Next(ctx)
+		assert.Nil(t, block)
+		assert.Equal(t, context.DeadlineExceeded, err)
+
+		mutFetched.Lock()
+		defer mutFetched.Unlock()
+		assert.Equal(t, 8, len(fetched))
+		for _, numFetches := range fetched {
+			assert.Equal(t, 1, numFetches)
+		}
+	})
+	t.Run("should start from the provided start nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		args.StartNonce = 100
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 200, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				return createHyperBlock(nonce), nil
+			},
+		}
+		stream, _ := NewHyperBlockStream(args)
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		block, err := stream.Next(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(100), block.Nonce)
+	})
+	t.Run("should wait for the shard to be final", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		numChecks := uint32(0)
+		args.FinalityProvider = &testsCommon.FinalityProviderStub{
+			CheckShardFinalizationCalled: func(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error {
+				assert.Equal(t, core.MetachainShardId, targetShardID)
+				if atomic.AddUint32(&numChecks, 1) < 3 {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 5, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				return createHyperBlock(nonce), nil
+			},
+		}
+		stream, _ := NewHyperBlockStream(args)
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		block, err := stream.Next(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), block.Nonce)
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numChecks))
+	})
+	t.Run("should retry temporary errors and gaps", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		numCalls := uint32(0)
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 5, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				if nonce == 2 {
+					switch atomic.AddUint32(&numCalls, 1) {
+					case 1:
+						return nil, expectedErr
+					case 2:
+						return createHyperBlock(3), nil
+					}
+				}
+
+				return createHyperBlock(nonce), nil
+			},
+		}
+		stream, _ := NewHyperBlockStream(args)
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			block, err := stream.Next(context.Background())
+			require.Nil(t, err)
+			assert.Equal(t, nonce, block.Nonce)
+		}
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("should return the error after max retries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		numCalls := uint32(0)
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 5, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				atomic.AddUint32(&numCalls, 1)
+				return nil, expectedErr
+			},
+		}
+		args.NumPrefetchBlocks = 1
+		stream, _ := NewHyperBlockStream(args)
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		block, err := stream.Next(context.Background())
+		assert.Nil(t, block)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("previous hash mismatch should refetch the block", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperBlockStream()
+		numCalls := uint32(0)
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 5, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				block := createHyperBlock(nonce)
+				if nonce == 2 && atomic.AddUint32(&numCalls, 1) == 1 {
+					block.PrevBlockHash = "fork"
+				}
+
+				return block, nil
+			},
+		}
+		stream, _ := NewHyperBlockStream(args)
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		block, err := stream.Next(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), block.Nonce)
+
+		block, err = stream.Next(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), block.Nonce)
+		assert.Equal(t, "hash1", block.PrevBlockHash)
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("closed stream should error", func(t *testing.T) {
+		t.Parallel()
+
+		stream, _ := NewHyperBlockStream(createMockArgsHyperBlockStream())
+		_ = stream.Close()
+
+		block, err := stream.Next(context.Background())
+		assert.Nil(t, block)
+		assert.Equal(t, ErrHyperBlockStreamClosed, err)
+	})
+}
+
+func TestHyperBlockStream_Commit(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHyperBlockStream()
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return 5, nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			return createHyperBlock(nonce), nil
+		},
+	}
+	processedNonces := make([]uint64, 0)
+	args.NonceHandler = &testsCommon.LastProcessedNonceHandlerStub{
+		ProcessedNonceCalled: func(nonce uint64) {
+			processedNonces = append(processedNonces, nonce)
+		},
+	}
+	stream, _ := NewHyperBlockStream(args)
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	_, _ = stream.Next(context.Background())
+	_, _ = stream.Next(context.Background())
+	assert.Empty(t, processedNonces)
+
+	stream.Commit()
+	assert.Equal(t, []uint64{2}, processedNonces)
+}
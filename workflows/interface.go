@@ -3,6 +3,7 @@ package workflows
 import (
 	"context"
 
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
@@ -33,9 +34,58 @@ type ProxyHandler interface {
 	IsInterfaceNil() bool
 }
 
+// HyperBlockProvider defines the behavior of a component able to provide hyper blocks
+type HyperBlockProvider interface {
+	GetLatestHyperBlockNonce(ctx context.Context) (uint64, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperBlock, error)
+	IsInterfaceNil() bool
+}
+
+// HyperBlockStream is able to provide the final hyper blocks, in order, and to persist the processing progress
+type HyperBlockStream interface {
+	Next(ctx context.Context) (*data.HyperBlock, error)
+	Commit()
+	IsInterfaceNil() bool
+}
+
+// FinalityProvider is able to check the shard finalization status
+type FinalityProvider interface {
+	CheckShardFinalization(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error
+	IsInterfaceNil() bool
+}
+
 // TransactionInteractor defines the transaction interactor behavior used in workflows
 type TransactionInteractor interface {
 	AddTransaction(tx *transaction.FrontendTransaction)
 	ApplyUserSignature(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
 	IsInterfaceNil() bool
 }
+
+// TokenSweeperProxy defines the proxy operations used by the token sweeper
+type TokenSweeperProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetAccount(ctx context.Context, address sdkCore.AddressHandler) (*data.Account, error)
+	GetESDTTokenData(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, queryOptions api.AccountQueryOptions) (*data.ESDTFungibleTokenData, error)
+	GetNFTTokenData(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, nonce uint64, queryOptions api.AccountQueryOptions) (*data.ESDTNFTTokenData, error)
+	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	GetTransactionStatus(ctx context.Context, hash string) (string, error)
+	IsInterfaceNil() bool
+}
+
+// SignedTxBuilder defines the component able to sign a transaction and compute its hash
+type SignedTxBuilder interface {
+	ApplyUserSignature(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// SweepStateStorage is able to persist the sweep state of each address, along with the nonce of the next funding
+// transaction of the hot wallet, so a sweep can be resumed after an application restart. Get should return a nil
+// state if none was stored for the provided address and GetNextHotWalletNonce should return 0 if no nonce was stored
+type SweepStateStorage interface {
+	Get(address string) (*SweepState, error)
+	Put(state *SweepState) error
+	GetNextHotWalletNonce() (uint64, error)
+	PutNextHotWalletNonce(nonce uint64) error
+	IsInterfaceNil() bool
+}
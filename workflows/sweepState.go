@@ -0,0 +1,96 @@
+package workflows
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// SweepStep is the step of the sweep state machine an address is in
+type SweepStep string
+
+const (
+	// SweepStepIdle means no sweep transaction is in progress for the address
+	SweepStepIdle SweepStep = "idle"
+	// SweepStepFunding means the hot wallet sent the gas funding transaction and the sweeper waits for its execution
+	SweepStepFunding SweepStep = "funding"
+	// SweepStepSweeping means the sweep transaction was sent and the sweeper waits for its execution
+	SweepStepSweeping SweepStep = "sweeping"
+)
+
+// SweepToken identifies a token that should be swept. Nonce is 0 for fungible tokens
+type SweepToken struct {
+	Token string
+	Nonce uint64
+}
+
+// SweepState holds the sweep progress of an address. Tx is the signed transaction that was sent in the current
+// step, kept so it can be sent again, unchanged, if its status can not be determined
+type SweepState struct {
+	Address string
+	Step    SweepStep
+	TxHash  string
+	Tx      *transaction.FrontendTransaction
+}
+
+// inMemorySweepStateStorage is a SweepStateStorage implementation that does not persist the states
+type inMemorySweepStateStorage struct {
+	mut                sync.RWMutex
+	states             map[string]SweepState
+	nextHotWalletNonce uint64
+}
+
+// NewInMemorySweepStateStorage creates a new instance of the inMemorySweepStateStorage type
+func NewInMemorySweepStateStorage() *inMemorySweepStateStorage {
+	return &inMemorySweepStateStorage{
+		states: make(map[string]SweepState),
+	}
+}
+
+// Get returns a copy of the stored state for the provided address or nil if no state was stored
+func (storage *inMemorySweepStateStorage) Get(address string) (*SweepState, error) {
+	storage.mut.RLock()
+	defer storage.mut.RUnlock()
+
+	state, found := storage.states[address]
+	if !found {
+		return nil, nil
+	}
+
+	return &state, nil
+}
+
+// Put stores a copy of the provided state
+func (storage *inMemorySweepStateStorage) Put(state *SweepState) error {
+	if state == nil {
+		return ErrNilSweepState
+	}
+
+	storage.mut.Lock()
+	storage.states[state.Address] = *state
+	storage.mut.Unlock()
+
+	return nil
+}
+
+// GetNextHotWalletNonce returns the stored nonce of the next funding transaction of the hot wallet
+func (storage *inMemorySweepStateStorage) GetNextHotWalletNonce() (uint64, error) {
+	storage.mut.RLock()
+	defer storage.mut.RUnlock()
+
+	return storage.nextHotWalletNonce, nil
+}
+
+// PutNextHotWalletNonce stores the nonce of the next funding transaction of the hot wallet
+func (storage *inMemorySweepStateStorage) PutNextHotWalletNonce(nonce uint64) error {
+	storage.mut.Lock()
+	storage.nextHotWalletNonce = nonce
+	storage.mut.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storage *inMemorySweepStateStorage) IsInterfaceNil() bool {
+	return storage == nil
+}
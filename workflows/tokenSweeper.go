@@ -0,0 +1,427 @@
+package workflows
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// TokenSweeperArgs is the argument DTO for the NewTokenSweeper constructor function
+type TokenSweeperArgs struct {
+	Proxy                      TokenSweeperProxy
+	TxBuilder                  SignedTxBuilder
+	TrackableAddressesProvider TrackableAddressesProvider
+	StateStorage               SweepStateStorage
+	// HotWallet is the account that funds the gas of the deposit addresses that do not hold enough EGLD
+	HotWallet       sdkCore.CryptoComponentsHolder
+	ReceiverAddress string
+	// GasLimitPerTransfer is the gas limit added for each transferred token, on top of the move balance cost
+	GasLimitPerTransfer uint64
+}
+
+type tokenTransfer struct {
+	token  string
+	nonce  uint64
+	amount *big.Int
+}
+
+// tokenSweeper is able to move the ESDT, SFT, MetaESDT and NFT balances of the tracked addresses to the receiver
+// address. Each address goes through a state machine: if it does not hold enough EGLD for the sweep transaction,
+// the hot wallet funds it first, then the tokens are sent. Every signed transaction is stored before being sent
+// so a retried call will only send the same transaction again, never a new one, while a step is in progress
+type tokenSweeper struct {
+	proxy                      TokenSweeperProxy
+	txBuilder                  SignedTxBuilder
+	trackableAddressesProvider TrackableAddressesProvider
+	stateStorage               SweepStateStorage
+	hotWallet                  sdkCore.CryptoComponentsHolder
+	receiverAddress            sdkCore.AddressHandler
+	gasLimitPerTransfer        uint64
+
+	mut sync.Mutex
+}
+
+// NewTokenSweeper creates a new instance of the tokenSweeper struct
+func NewTokenSweeper(args TokenSweeperArgs) (*tokenSweeper, error) {
+	err := checkTokenSweeperArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverAddress, err := data.NewAddressFromBech32String(args.ReceiverAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for ReceiverAddress", err)
+	}
+
+	return &tokenSweeper{
+		proxy:                      args.Proxy,
+		txBuilder:                  args.TxBuilder,
+		trackableAddressesProvider: args.TrackableAddressesProvider,
+		stateStorage:               args.StateStorage,
+		hotWallet:                  args.HotWallet,
+		receiverAddress:            receiverAddress,
+		gasLimitPerTransfer:        args.GasLimitPerTransfer,
+	}, nil
+}
+
+func checkTokenSweeperArgs(args TokenSweeperArgs) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilProxy
+	}
+	if check.IfNil(args.TxBuilder) {
+		return ErrNilTxBuilder
+	}
+	if check.IfNil(args.TrackableAddressesProvider) {
+		return ErrNilTrackableAddressesProvider
+	}
+	if check.IfNil(args.StateStorage) {
+		return ErrNilSweepStateStorage
+	}
+	if check.IfNil(args.HotWallet) {
+		return ErrNilHotWallet
+	}
+	if args.GasLimitPerTransfer == 0 {
+		return ErrInvalidGasLimitPerTransfer
+	}
+
+	return nil
+}
+
+// Sweep advances the sweep state machine of the provided address and returns the step the address is in. While a
+// funding or sweep transaction is in progress, the call only checks its status. Once the address is idle, the
+// balances of the provided tokens are fetched and, if not empty, the funding or the sweep transaction is sent.
+// The caller should call Sweep again, e.g. after each processed block, until the address returns to the idle step
+func (ts *tokenSweeper) Sweep(ctx context.Context, address string, tokens []SweepToken) (SweepStep, error) {
+	ts.mut.Lock()
+	defer ts.mut.Unlock()
+
+	if !ts.trackableAddressesProvider.IsTrackableAddresses(address) {
+		return SweepStepIdle, fmt.Errorf("%w %s", ErrUntrackedAddress, address)
+	}
+
+	state, err := ts.stateStorage.Get(address)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+	if state == nil {
+		state = &SweepState{
+			Address: address,
+			Step:    SweepStepIdle,
+		}
+	}
+
+	if state.Step != SweepStepIdle {
+		isDone, errCheck := ts.checkTransactionInProgress(ctx, state)
+		if errCheck != nil || !isDone {
+			return state.Step, errCheck
+		}
+	}
+
+	return ts.startStep(ctx, state, tokens)
+}
+
+// checkTransactionInProgress returns true if the transaction of the current step was executed, successfully or not.
+// If the transaction status can not be fetched, the same signed transaction is sent again
+func (ts *tokenSweeper) checkTransactionInProgress(ctx context.Context, state *SweepState) (bool, error) {
+	status, err := ts.proxy.GetTransactionStatus(ctx, state.TxHash)
+	if err != nil {
+		log.Debug("tokenSweeper: can not get the transaction status, sending it again",
+			"address", state.Address, "step", state.Step, "hash", state.TxHash, "error", err)
+		_, err = ts.proxy.SendTransaction(ctx, state.Tx)
+
+		return false, err
+	}
+
+	switch transaction.TxStatus(status) {
+	case transaction.TxStatusSuccess:
+		log.Debug("tokenSweeper: transaction executed", "address", state.Address, "step", state.Step, "hash", state.TxHash)
+	case transaction.TxStatusFail, transaction.TxStatusInvalid:
+		log.Warn("tokenSweeper: transaction failed", "address", state.Address, "step", state.Step,
+			"hash", state.TxHash, "status", status)
+	default:
+		return false, nil
+	}
+
+	state.Step = SweepStepIdle
+	state.TxHash = ""
+	state.Tx = nil
+	err = ts.stateStorage.Put(state)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (ts *tokenSweeper) startStep(ctx context.Context, state *SweepState, tokens []SweepToken) (SweepStep, error) {
+	addressHandler, err := data.NewAddressFromBech32String(state.Address)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+
+	transfers, err := ts.fetchBalances(ctx, addressHandler, tokens)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+	if len(transfers) == 0 {
+		return SweepStepIdle, nil
+	}
+
+	networkConfigs, err := ts.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+	if networkConfigs == nil {
+		return SweepStepIdle, ErrNilNetworkConfigs
+	}
+
+	account, err := ts.proxy.GetAccount(ctx, addressHandler)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+	if account == nil {
+		return SweepStepIdle, ErrNilAccount
+	}
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+	if !ok {
+		return SweepStepIdle, ErrInvalidAvailableBalanceValue
+	}
+
+	sweepTx, err := ts.createSweepTransaction(state.Address, account.Nonce, transfers, networkConfigs)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+
+	fee := big.NewInt(0).SetUint64(sweepTx.GasPrice)
+	fee.Mul(fee, big.NewInt(0).SetUint64(sweepTx.GasLimit))
+	if balance.Cmp(fee) < 0 {
+		fundingTx, errCreate := ts.createFundingTransaction(ctx, state.Address, fee.Sub(fee, balance), networkConfigs)
+		if errCreate != nil {
+			return SweepStepIdle, errCreate
+		}
+
+		return ts.signAndSendFunding(ctx, state, fundingTx)
+	}
+
+	skBytes := ts.trackableAddressesProvider.PrivateKeyOfBech32Address(state.Address)
+	cryptoHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, skBytes)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+
+	return ts.signAndSend(ctx, state, SweepStepSweeping, sweepTx, cryptoHolder)
+}
+
+func (ts *tokenSweeper) fetchBalances(ctx context.Context, address sdkCore.AddressHandler, tokens []SweepToken) ([]*tokenTransfer, error) {
+	transfers := make([]*tokenTransfer, 0, len(tokens))
+	for _, token := range tokens {
+		balanceString, err := ts.fetchBalance(ctx, address, token)
+		if err != nil {
+			return nil, fmt.Errorf("%w for token %s, nonce %d", err, token.Token, token.Nonce)
+		}
+		if len(balanceString) == 0 {
+			continue
+		}
+
+		balance, ok := big.NewInt(0).SetString(balanceString, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w for token %s, nonce %d", ErrInvalidAvailableBalanceValue, token.Token, token.Nonce)
+		}
+		if balance.Sign() <= 0 {
+			continue
+		}
+
+		transfers = append(transfers, &tokenTransfer{
+			token:  token.Token,
+			nonce:  token.Nonce,
+			amount: balance,
+		})
+	}
+
+	return transfers, nil
+}
+
+func (ts *tokenSweeper) fetchBalance(ctx context.Context, address sdkCore.AddressHandler, token SweepToken) (string, error) {
+	if token.Nonce == 0 {
+		tokenData, err := ts.proxy.GetESDTTokenData(ctx, address, token.Token, api.AccountQueryOptions{})
+		if err != nil || tokenData == nil {
+			return "", err
+		}
+
+		return tokenData.Balance, nil
+	}
+
+	tokenData, err := ts.proxy.GetNFTTokenData(ctx, address, token.Token, token.Nonce, api.AccountQueryOptions{})
+	if err != nil || tokenData == nil {
+		return "", err
+	}
+
+	return tokenData.Balance, nil
+}
+
+// createSweepTransaction creates an ESDTTransfer transaction for a single fungible token and a
+// MultiESDTNFTTransfer transaction, sent to self, otherwise
+func (ts *tokenSweeper) createSweepTransaction(
+	address string,
+	nonce uint64,
+	transfers []*tokenTransfer,
+	networkConfigs *data.NetworkConfig,
+) (*transaction.FrontendTransaction, error) {
+	txDataBuilder := builders.NewTxDataBuilder()
+	receiver, err := ts.receiverAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transfers) == 1 && transfers[0].nonce == 0 {
+		txDataBuilder.Function(core.BuiltInFunctionESDTTransfer).
+			ArgBytes([]byte(transfers[0].token)).
+			ArgBigInt(transfers[0].amount)
+	} else {
+		receiver = address
+		txDataBuilder.Function(core.BuiltInFunctionMultiESDTNFTTransfer).
+			ArgAddress(ts.receiverAddress).
+			ArgInt64(int64(len(transfers)))
+		for _, transfer := range transfers {
+			txDataBuilder.ArgBytes([]byte(transfer.token)).
+				ArgBigInt(big.NewInt(0).SetUint64(transfer.nonce)).
+				ArgBigInt(transfer.amount)
+		}
+	}
+
+	txData, err := txDataBuilder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := networkConfigs.MinGasLimit + uint64(len(txData))*networkConfigs.GasPerDataByte +
+		uint64(len(transfers))*ts.gasLimitPerTransfer
+
+	return &transaction.FrontendTransaction{
+		Nonce:    nonce,
+		Value:    "0",
+		Receiver: receiver,
+		Sender:   address,
+		GasPrice: networkConfigs.MinGasPrice,
+		GasLimit: gasLimit,
+		Data:     txData,
+		ChainID:  networkConfigs.ChainID,
+		Version:  networkConfigs.MinTransactionVersion,
+	}, nil
+}
+
+func (ts *tokenSweeper) createFundingTransaction(
+	ctx context.Context,
+	address string,
+	value *big.Int,
+	networkConfigs *data.NetworkConfig,
+) (*transaction.FrontendTransaction, error) {
+	hotWalletAccount, err := ts.proxy.GetAccount(ctx, ts.hotWallet.GetAddressHandler())
+	if err != nil {
+		return nil, err
+	}
+	if hotWalletAccount == nil {
+		return nil, ErrNilAccount
+	}
+
+	// the funding transactions of more addresses might be sent before the previous ones are executed, so the account
+	// nonce does not account for the ones still pending. The stored nonce does, even after an application restart
+	nonce := hotWalletAccount.Nonce
+	nextHotWalletNonce, err := ts.stateStorage.GetNextHotWalletNonce()
+	if err != nil {
+		return nil, err
+	}
+	if nextHotWalletNonce > nonce {
+		nonce = nextHotWalletNonce
+	}
+
+	return &transaction.FrontendTransaction{
+		Nonce:    nonce,
+		Value:    value.String(),
+		Receiver: address,
+		Sender:   ts.hotWallet.GetBech32(),
+		GasPrice: networkConfigs.MinGasPrice,
+		GasLimit: networkConfigs.MinGasLimit,
+		ChainID:  networkConfigs.ChainID,
+		Version:  networkConfigs.MinTransactionVersion,
+	}, nil
+}
+
+// signAndSendFunding stores the signed funding transaction before storing the next nonce of the hot wallet, so
+// the nonce is never skipped: once stored, the funding transaction is sent again until it is executed
+func (ts *tokenSweeper) signAndSendFunding(ctx context.Context, state *SweepState, tx *transaction.FrontendTransaction) (SweepStep, error) {
+	err := ts.signAndStore(state, SweepStepFunding, tx, ts.hotWallet)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+
+	err = ts.stateStorage.PutNextHotWalletNonce(tx.Nonce + 1)
+	if err != nil {
+		return SweepStepFunding, err
+	}
+
+	return ts.send(ctx, state)
+}
+
+// signAndSend stores the signed transaction along with the new step before sending it
+func (ts *tokenSweeper) signAndSend(
+	ctx context.Context,
+	state *SweepState,
+	step SweepStep,
+	tx *transaction.FrontendTransaction,
+	cryptoHolder sdkCore.CryptoComponentsHolder,
+) (SweepStep, error) {
+	err := ts.signAndStore(state, step, tx, cryptoHolder)
+	if err != nil {
+		return SweepStepIdle, err
+	}
+
+	return ts.send(ctx, state)
+}
+
+func (ts *tokenSweeper) signAndStore(
+	state *SweepState,
+	step SweepStep,
+	tx *transaction.FrontendTransaction,
+	cryptoHolder sdkCore.CryptoComponentsHolder,
+) error {
+	err := ts.txBuilder.ApplyUserSignature(cryptoHolder, tx)
+	if err != nil {
+		return err
+	}
+
+	txHash, err := ts.txBuilder.ComputeTxHash(tx)
+	if err != nil {
+		return err
+	}
+
+	state.Step = step
+	state.TxHash = hex.EncodeToString(txHash)
+	state.Tx = tx
+
+	return ts.stateStorage.Put(state)
+}
+
+func (ts *tokenSweeper) send(ctx context.Context, state *SweepState) (SweepStep, error) {
+	log.Debug("tokenSweeper: sending transaction", "address", state.Address, "step", state.Step,
+		"hash", state.TxHash, "sender", state.Tx.Sender, "receiver", state.Tx.Receiver, "value", state.Tx.Value)
+	_, err := ts.proxy.SendTransaction(ctx, state.Tx)
+
+	return state.Step, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *tokenSweeper) IsInterfaceNil() bool {
+	return ts == nil
+}
@@ -0,0 +1,507 @@
+package workflows
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	depositAddressSk       = bytes32(1)
+	hotWalletSk            = bytes32(2)
+	receiverSk             = bytes32(3)
+	secondDepositAddressSk = bytes32(4)
+)
+
+func bytes32(value byte) []byte {
+	buff := make([]byte, 32)
+	buff[31] = value
+
+	return buff
+}
+
+func createCryptoHolder(tb testing.TB, sk []byte) sdkCore.CryptoComponentsHolder {
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(tb, err)
+
+	return holder
+}
+
+// sweeperChainMock holds the accounts and transactions statuses used by the sweeper tests
+type sweeperChainMock struct {
+	mut          sync.Mutex
+	accounts     map[string]*data.Account
+	tokens       map[string]string
+	statuses     map[string]string
+	sentTxs      []*transaction.FrontendTransaction
+	statusErrors bool
+}
+
+func newSweeperChainMock() *sweeperChainMock {
+	return &sweeperChainMock{
+		accounts: make(map[string]*data.Account),
+		tokens:   make(map[string]string),
+		statuses: make(map[string]string),
+	}
+}
+
+func (chain *sweeperChainMock) tokenKey(address string, token string, nonce uint64) string {
+	return address + "-" + token + "-" + big.NewInt(0).SetUint64(nonce).String()
+}
+
+func (chain *sweeperChainMock) setTokenBalance(address string, token string, nonce uint64, balance string) {
+	chain.mut.Lock()
+	chain.tokens[chain.tokenKey(address, token, nonce)] = balance
+	chain.mut.Unlock()
+}
+
+func (chain *sweeperChainMock) setAccount(address string, nonce uint64, balance string) {
+	chain.mut.Lock()
+	chain.accounts[address] = &data.Account{Address: address, Nonce: nonce, Balance: balance}
+	chain.mut.Unlock()
+}
+
+func (chain *sweeperChainMock) setAllStatuses(status string) {
+	chain.mut.Lock()
+	for hash := range chain.statuses {
+		chain.statuses[hash] = status
+	}
+	chain.mut.Unlock()
+}
+
+func (chain *sweeperChainMock) getSentTxs() []*transaction.FrontendTransaction {
+	chain.mut.Lock()
+	defer chain.mut.Unlock()
+
+	return append(make([]*transaction.FrontendTransaction, 0), chain.sentTxs...)
+}
+
+func (chain *sweeperChainMock) createProxy(tb testing.TB, txBuilder SignedTxBuilder) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{
+				ChainID:               "T",
+				MinGasLimit:           50000,
+				GasPerDataByte:        1500,
+				MinGasPrice:           1000000000,
+				MinTransactionVersion: 1,
+			}, nil
+		},
+		GetAccountCalled: func(address sdkCore.AddressHandler) (*data.Account, error) {
+			chain.mut.Lock()
+			defer chain.mut.Unlock()
+
+			bech32, _ := address.AddressAsBech32String()
+			account, found := chain.accounts[bech32]
+			if !found {
+				return &data.Account{Address: bech32, Balance: "0"}, nil
+			}
+
+			return account, nil
+		},
+		GetESDTTokenDataCalled: func(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, queryOptions api.AccountQueryOptions) (*data.ESDTFungibleTokenData, error) {
+			chain.mut.Lock()
+			defer chain.mut.Unlock()
+
+			bech32, _ := address.AddressAsBech32String()
+			return &data.ESDTFungibleTokenData{
+				TokenIdentifier: tokenIdentifier,
+				Balance:         chain.tokens[chain.tokenKey(bech32, tokenIdentifier, 0)],
+			}, nil
+		},
+		GetNFTTokenDataCalled: func(ctx context.Context, address sdkCore.AddressHandler, tokenIdentifier string, nonce uint64, queryOptions api.AccountQueryOptions) (*data.ESDTNFTTokenData, error) {
+			chain.mut.Lock()
+			defer chain.mut.Unlock()
+
+			bech32, _ := address.AddressAsBech32String()
+			return &data.ESDTNFTTokenData{
+				TokenIdentifier: tokenIdentifier,
+				Nonce:           nonce,
+				Balance:         chain.tokens[chain.tokenKey(bech32, tokenIdentifier, nonce)],
+			}, nil
+		},
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			txHash, err := txBuilder.ComputeTxHash(tx)
+			require.Nil(tb, err)
+
+			chain.mut.Lock()
+			defer chain.mut.Unlock()
+
+			hash := hex.EncodeToString(txHash)
+			chain.sentTxs = append(chain.sentTxs, tx)
+			chain.statuses[hash] = string(transaction.TxStatusPending)
+
+			return hash, nil
+		},
+		GetTransactionStatusCalled: func(ctx context.Context, hash string) (string, error) {
+			chain.mut.Lock()
+			defer chain.mut.Unlock()
+
+			if chain.statusErrors {
+				return "", expectedErr
+			}
+
+			return chain.statuses[hash], nil
+		},
+	}
+}
+
+func createMockTokenSweeperArgs(tb testing.TB, chain *sweeperChainMock) TokenSweeperArgs {
+	txBuilder, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	require.Nil(tb, err)
+
+	depositAddress := createCryptoHolder(tb, depositAddressSk).GetBech32()
+	return TokenSweeperArgs{
+		Proxy:     chain.createProxy(tb, txBuilder),
+		TxBuilder: txBuilder,
+		TrackableAddressesProvider: &testsCommon.TrackableAddressesProviderStub{
+			IsTrackableAddressesCalled: func(addressAsBech32 string) bool {
+				return addressAsBech32 == depositAddress
+			},
+			PrivateKeyOfBech32AddressCalled: func(addressAsBech32 string) []byte {
+				return depositAddressSk
+			},
+		},
+		StateStorage:        NewInMemorySweepStateStorage(),
+		HotWallet:           createCryptoHolder(tb, hotWalletSk),
+		ReceiverAddress:     createCryptoHolder(tb, receiverSk).GetBech32(),
+		GasLimitPerTransfer: 200000,
+	}
+}
+
+func decodeDataArgs(tb testing.TB, txData []byte) (string, []string) {
+	parts := strings.Split(string(txData), "@")
+	args := make([]string, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		buff, err := hex.DecodeString(part)
+		require.Nil(tb, err)
+		args = append(args, string(buff))
+	}
+
+	return parts[0], args
+}
+
+func TestNewTokenSweeper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTokenSweeperArgs(t, newSweeperChainMock())
+		args.Proxy = nil
+		ts, err := NewTokenSweeper(args)
+		assert.True(t, check.IfNil(ts))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil tx builder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTokenSweeperArgs(t, newSweeperChainMock())
+		args.TxBuilder = nil
+		ts, err := NewTokenSweeper(args)
+		assert.True(t, check.IfNil(ts))
+		assert.Equal(t, ErrNilTxBuilder, err)
+	})
+	t.Run("nil trackable addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTokenSweeperArgs(t, newSweeperChainMock())
+		args.TrackableAddressesProvider = nil
+		ts, err := NewTokenSweeper(args)
+		assert.True(t, check.IfNil(ts))
+		assert.Equal(t, ErrNilTrackableAddressesProvider, err)
+	})
+	t.Run("nil state storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTokenSweeperArgs(t, newSweeperChainMock())
+		args.StateStorage = nil
+		ts, err := NewTokenSweeper(args)
+		assert.True(t, check.IfNil(ts))
+		assert.Equal(t, ErrNilSweepStateStorage, err)
+	})
+	t.Run("nil hot wallet should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTokenSweeperArgs(t, newSweeperChainMock())
+		args.HotWallet = nil
+		ts, err := NewTokenSweeper(args)
+		assert.True(t, check.IfNil(ts))
+		assert.Equal(t, ErrNilHotWallet, err)
+	})
+	t.Run("zero gas limit per transfer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTokenSweeperArgs(t, newSweeperChainMock())
+		args.GasLimitPerTransfer = 0
+		ts, err := NewTokenSweeper(args)
+		assert.True(t, check.IfNil(ts))
+		assert.Equal(t, ErrInvalidGasLimitPerTransfer, err)
+	})
+	t.Run("invalid receiver address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTokenSweeperArgs(t, newSweeperChainMock())
+		args.ReceiverAddress = "invalid"
+		ts, err := NewTokenSweeper(args)
+		assert.True(t, check.IfNil(ts))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "ReceiverAddress")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ts, err := NewTokenSweeper(createMockTokenSweeperArgs(t, newSweeperChainMock()))
+		assert.False(t, check.IfNil(ts))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokenSweeper_Sweep(t *testing.T) {
+	t.Parallel()
+
+	depositAddress := createCryptoHolder(t, depositAddressSk).GetBech32()
+	hotWalletAddress := createCryptoHolder(t, hotWalletSk).GetBech32()
+	receiverAddress := createCryptoHolder(t, receiverSk).GetBech32()
+
+	t.Run("untracked address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTokenSweeper(createMockTokenSweeperArgs(t, newSweeperChainMock()))
+		step, err := ts.Sweep(context.Background(), receiverAddress, nil)
+		assert.Equal(t, SweepStepIdle, step)
+		assert.ErrorIs(t, err, ErrUntrackedAddress)
+	})
+	t.Run("nothing to sweep should not send transactions", func(t *testing.T) {
+		t.Parallel()
+
+		chain := newSweeperChainMock()
+		chain.setTokenBalance(depositAddress, "NFT-abcdef", 1, "0")
+		ts, _ := NewTokenSweeper(createMockTokenSweeperArgs(t, chain))
+
+		step, err := ts.Sweep(context.Background(), depositAddress, []SweepToken{{Token: "TKN-abcdef"}, {Token: "NFT-abcdef", Nonce: 1}})
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepIdle, step)
+		assert.Empty(t, chain.getSentTxs())
+	})
+	t.Run("single fungible token with enough EGLD should send ESDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		chain := newSweeperChainMock()
+		chain.setAccount(depositAddress, 7, "1000000000000000000")
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "1500")
+		args := createMockTokenSweeperArgs(t, chain)
+		ts, _ := NewTokenSweeper(args)
+
+		step, err := ts.Sweep(context.Background(), depositAddress, []SweepToken{{Token: "TKN-abcdef"}})
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepSweeping, step)
+
+		sentTxs := chain.getSentTxs()
+		require.Equal(t, 1, len(sentTxs))
+		tx := sentTxs[0]
+		assert.Equal(t, uint64(7), tx.Nonce)
+		assert.Equal(t, depositAddress, tx.Sender)
+		assert.Equal(t, receiverAddress, tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, "ESDTTransfer@544b4e2d616263646566@05dc", string(tx.Data))
+		assert.Equal(t, uint64(50000+1500*uint64(len(tx.Data))+200000), tx.GasLimit)
+		assert.NotEmpty(t, tx.Signature)
+
+		state, _ := args.StateStorage.Get(depositAddress)
+		assert.Equal(t, SweepStepSweeping, state.Step)
+		assert.Equal(t, tx, state.Tx)
+
+		chain.setAllStatuses(string(transaction.TxStatusSuccess))
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "0")
+		step, err = ts.Sweep(context.Background(), depositAddress, []SweepToken{{Token: "TKN-abcdef"}})
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepIdle, step)
+		assert.Equal(t, 1, len(chain.getSentTxs()))
+	})
+	t.Run("more tokens should send MultiESDTNFTTransfer to self", func(t *testing.T) {
+		t.Parallel()
+
+		chain := newSweeperChainMock()
+		chain.setAccount(depositAddress, 0, "1000000000000000000")
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "10")
+		chain.setTokenBalance(depositAddress, "NFT-abcdef", 3, "1")
+		ts, _ := NewTokenSweeper(createMockTokenSweeperArgs(t, chain))
+
+		step, err := ts.Sweep(context.Background(), depositAddress, []SweepToken{
+			{Token: "TKN-abcdef"},
+			{Token: "EMPTY-abcdef"},
+			{Token: "NFT-abcdef", Nonce: 3},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepSweeping, step)
+
+		sentTxs := chain.getSentTxs()
+		require.Equal(t, 1, len(sentTxs))
+		assert.Equal(t, depositAddress, sentTxs[0].Receiver)
+		function, dataArgs := decodeDataArgs(t, sentTxs[0].Data)
+		assert.Equal(t, "MultiESDTNFTTransfer", function)
+		receiver, _ := data.NewAddressFromBech32String(receiverAddress)
+		expectedArgs := []string{
+			string(receiver.AddressBytes()), "\x02",
+			"TKN-abcdef", "\x00", "\x0a",
+			"NFT-abcdef", "\x03", "\x01",
+		}
+		assert.Equal(t, expectedArgs, dataArgs)
+		assert.Equal(t, uint64(50000+1500*uint64(len(sentTxs[0].Data))+2*200000), sentTxs[0].GasLimit)
+	})
+	t.Run("not enough EGLD should fund from the hot wallet and then sweep", func(t *testing.T) {
+		t.Parallel()
+
+		chain := newSweeperChainMock()
+		chain.setAccount(hotWalletAddress, 42, "100000000000000000000")
+		chain.setAccount(depositAddress, 0, "1000")
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "1500")
+		ts, _ := NewTokenSweeper(createMockTokenSweeperArgs(t, chain))
+		tokens := []SweepToken{{Token: "TKN-abcdef"}}
+
+		step, err := ts.Sweep(context.Background(), depositAddress, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepFunding, step)
+
+		sentTxs := chain.getSentTxs()
+		require.Equal(t, 1, len(sentTxs))
+		fundingTx := sentTxs[0]
+		assert.Equal(t, uint64(42), fundingTx.Nonce)
+		assert.Equal(t, hotWalletAddress, fundingTx.Sender)
+		assert.Equal(t, depositAddress, fundingTx.Receiver)
+		sweepGasLimit := uint64(50000 + 1500*len("ESDTTransfer@544b4e2d616263646566@05dc") + 200000)
+		expectedValue := big.NewInt(0).SetUint64(sweepGasLimit * 1000000000)
+		expectedValue.Sub(expectedValue, big.NewInt(1000))
+		assert.Equal(t, expectedValue.String(), fundingTx.Value)
+
+		// funding still pending, retried calls should not send anything
+		for i := 0; i < 3; i++ {
+			step, err = ts.Sweep(context.Background(), depositAddress, tokens)
+			assert.Nil(t, err)
+			assert.Equal(t, SweepStepFunding, step)
+		}
+		assert.Equal(t, 1, len(chain.getSentTxs()))
+
+		chain.setAllStatuses(string(transaction.TxStatusSuccess))
+		chain.setAccount(depositAddress, 0, big.NewInt(0).SetUint64(sweepGasLimit*1000000000).String())
+		step, err = ts.Sweep(context.Background(), depositAddress, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepSweeping, step)
+
+		sentTxs = chain.getSentTxs()
+		require.Equal(t, 2, len(sentTxs))
+		assert.Equal(t, depositAddress, sentTxs[1].Sender)
+		assert.Equal(t, sweepGasLimit, sentTxs[1].GasLimit)
+	})
+	t.Run("failed funding should fund again with the next hot wallet nonce", func(t *testing.T) {
+		t.Parallel()
+
+		chain := newSweeperChainMock()
+		chain.setAccount(hotWalletAddress, 42, "100000000000000000000")
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "1500")
+		ts, _ := NewTokenSweeper(createMockTokenSweeperArgs(t, chain))
+		tokens := []SweepToken{{Token: "TKN-abcdef"}}
+
+		step, _ := ts.Sweep(context.Background(), depositAddress, tokens)
+		assert.Equal(t, SweepStepFunding, step)
+
+		chain.setAllStatuses(string(transaction.TxStatusFail))
+		step, err := ts.Sweep(context.Background(), depositAddress, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepFunding, step)
+
+		sentTxs := chain.getSentTxs()
+		require.Equal(t, 2, len(sentTxs))
+		assert.Equal(t, uint64(42), sentTxs[0].Nonce)
+		assert.Equal(t, uint64(43), sentTxs[1].Nonce)
+	})
+	t.Run("unknown status should send the same transaction again", func(t *testing.T) {
+		t.Parallel()
+
+		chain := newSweeperChainMock()
+		chain.setAccount(depositAddress, 7, "1000000000000000000")
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "1500")
+		ts, _ := NewTokenSweeper(createMockTokenSweeperArgs(t, chain))
+		tokens := []SweepToken{{Token: "TKN-abcdef"}}
+
+		_, _ = ts.Sweep(context.Background(), depositAddress, tokens)
+		chain.mut.Lock()
+		chain.statusErrors = true
+		chain.mut.Unlock()
+
+		step, err := ts.Sweep(context.Background(), depositAddress, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepSweeping, step)
+
+		sentTxs := chain.getSentTxs()
+		require.Equal(t, 2, len(sentTxs))
+		assert.Equal(t, sentTxs[0], sentTxs[1])
+	})
+	t.Run("a new sweeper with the same storage should resume the step", func(t *testing.T) {
+		t.Parallel()
+
+		chain := newSweeperChainMock()
+		chain.setAccount(depositAddress, 7, "1000000000000000000")
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "1500")
+		args := createMockTokenSweeperArgs(t, chain)
+		tokens := []SweepToken{{Token: "TKN-abcdef"}}
+
+		ts, _ := NewTokenSweeper(args)
+		_, _ = ts.Sweep(context.Background(), depositAddress, tokens)
+
+		restartedSweeper, _ := NewTokenSweeper(args)
+		step, err := restartedSweeper.Sweep(context.Background(), depositAddress, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepSweeping, step)
+		assert.Equal(t, 1, len(chain.getSentTxs()))
+	})
+	t.Run("a restarted sweeper should fund after the pending funding transactions", func(t *testing.T) {
+		t.Parallel()
+
+		secondDepositAddress := createCryptoHolder(t, secondDepositAddressSk).GetBech32()
+		chain := newSweeperChainMock()
+		chain.setAccount(hotWalletAddress, 42, "100000000000000000000")
+		chain.setTokenBalance(depositAddress, "TKN-abcdef", 0, "1500")
+		chain.setTokenBalance(secondDepositAddress, "TKN-abcdef", 0, "1500")
+		args := createMockTokenSweeperArgs(t, chain)
+		args.TrackableAddressesProvider = &testsCommon.TrackableAddressesProviderStub{
+			IsTrackableAddressesCalled: func(addressAsBech32 string) bool {
+				return addressAsBech32 == depositAddress || addressAsBech32 == secondDepositAddress
+			},
+		}
+		tokens := []SweepToken{{Token: "TKN-abcdef"}}
+
+		ts, _ := NewTokenSweeper(args)
+		step, err := ts.Sweep(context.Background(), depositAddress, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepFunding, step)
+
+		// the first funding transaction is still pending, so the hot wallet account nonce was not increased
+		restartedSweeper, _ := NewTokenSweeper(args)
+		step, err = restartedSweeper.Sweep(context.Background(), secondDepositAddress, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, SweepStepFunding, step)
+
+		sentTxs := chain.getSentTxs()
+		require.Equal(t, 2, len(sentTxs))
+		assert.Equal(t, depositAddress, sentTxs[0].Receiver)
+		assert.Equal(t, uint64(42), sentTxs[0].Nonce)
+		assert.Equal(t, secondDepositAddress, sentTxs[1].Receiver)
+		assert.Equal(t, uint64(43), sentTxs[1].Nonce)
+	})
+}
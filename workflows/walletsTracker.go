@@ -3,6 +3,7 @@ package workflows
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"sync"
 	"time"
@@ -35,7 +36,13 @@ type walletTracker struct {
 	minimumBalance             *big.Int
 
 	mutHandlers                  sync.RWMutex
-	handlerNewDepositTransaction func(transaction data.TransactionOnNetwork)
+	handlerNewDepositTransaction func(transaction data.TransactionOnNetwork) error
+
+	mutProcess sync.Mutex
+	// pendingNonce and notifiedTxs hold the hyper block that could not be fully processed and the hashes of its
+	// transactions already notified, so a retry will not notify them again
+	pendingNonce uint64
+	notifiedTxs  map[string]struct{}
 }
 
 // NewWalletTracker will create a new walletTracker instance. It automatically starts an inner
@@ -61,6 +68,7 @@ func NewWalletTracker(args WalletTrackerArgs) (*walletTracker, error) {
 		nonceHandler:               args.NonceHandler,
 		checkInterval:              args.CheckInterval,
 		minimumBalance:             args.MinimumBalance,
+		notifiedTxs:                make(map[string]struct{}),
 	}
 
 	var ctx context.Context
@@ -91,6 +99,9 @@ func (wt *walletTracker) processLoop(ctx context.Context) {
 }
 
 func (wt *walletTracker) fetchAndProcessHyperBlocks(ctx context.Context) error {
+	wt.mutProcess.Lock()
+	defer wt.mutProcess.Unlock()
+
 	lastProcessedNonce := wt.nonceHandler.GetLastProcessedNonce()
 	networkNonce, err := wt.proxy.GetLatestHyperBlockNonce(ctx)
 	if err != nil {
@@ -98,7 +109,12 @@ func (wt *walletTracker) fetchAndProcessHyperBlocks(ctx context.Context) error {
 	}
 
 	for nonce := lastProcessedNonce + 1; nonce <= networkNonce; nonce++ {
-		err = wt.fetchAndProcessHyperBlock(ctx, nonce)
+		if nonce != wt.pendingNonce {
+			wt.pendingNonce = nonce
+			wt.notifiedTxs = make(map[string]struct{})
+		}
+
+		err = wt.fetchAndProcessHyperBlock(ctx, nonce, wt.notifiedTxs)
 		if err != nil {
 			return err
 		}
@@ -109,64 +125,117 @@ func (wt *walletTracker) fetchAndProcessHyperBlocks(ctx context.Context) error {
 	return nil
 }
 
-func (wt *walletTracker) fetchAndProcessHyperBlock(ctx context.Context, nonce uint64) error {
+func (wt *walletTracker) fetchAndProcessHyperBlock(ctx context.Context, nonce uint64, notifiedTxs map[string]struct{}) error {
 	block, err := wt.proxy.GetHyperBlockByNonce(ctx, nonce)
 	if err != nil {
 		return err
 	}
+	if block == nil {
+		return fmt.Errorf("%w for nonce %d", ErrNilHyperBlock, nonce)
+	}
 
-	wt.processHyperBlock(block)
+	err = wt.processHyperBlock(block, notifiedTxs)
+	if err != nil {
+		return fmt.Errorf("%w for hyper block nonce %d", err, nonce)
+	}
 
 	log.Debug("processed hyper block", "nonce", nonce, "hash", block.Hash, "num txs", block.NumTxs)
 
 	return nil
 }
 
-func (wt *walletTracker) processHyperBlock(block *data.HyperBlock) {
+// processHyperBlock stops at the first handler error. The transactions found in the notifiedTxs map are skipped
+// and the ones successfully notified are added to it
+func (wt *walletTracker) processHyperBlock(block *data.HyperBlock, notifiedTxs map[string]struct{}) error {
 	for _, transaction := range block.Transactions {
-		err := wt.processTransaction(transaction)
+		_, alreadyNotified := notifiedTxs[transaction.Hash]
+		if alreadyNotified {
+			continue
+		}
+
+		isDeposit, err := wt.isDepositTransaction(transaction)
 		if err != nil {
 			transactionString, _ := json.Marshal(&transaction)
 			log.Warn("error processing transaction, ignoring",
 				"transaction", transactionString, "error", err)
+			continue
 		}
+		if !isDeposit {
+			continue
+		}
+
+		err = wt.notifyNewDepositTransactionFound(transaction)
+		if err != nil {
+			return fmt.Errorf("%w for transaction %s: %w", ErrDepositHandlerFailed, transaction.Hash, err)
+		}
+		notifiedTxs[transaction.Hash] = struct{}{}
+		wt.accumulator.push(transaction.Receiver)
 	}
+
+	return nil
 }
 
-func (wt *walletTracker) processTransaction(transaction data.TransactionOnNetwork) error {
+func (wt *walletTracker) isDepositTransaction(transaction data.TransactionOnNetwork) (bool, error) {
 	value, ok := big.NewInt(0).SetString(transaction.Value, 10)
 	if !ok {
-		return ErrInvalidTransactionValue
+		return false, ErrInvalidTransactionValue
 	}
 
 	if !wt.trackableAddressesProvider.IsTrackableAddresses(transaction.Receiver) {
-		return nil
+		return false, nil
 	}
 
 	if value.Cmp(wt.minimumBalance) < 0 {
 		// transaction has a very small value transfer (possible attack vector as someone
 		// can trigger millions of these transactions as to consume the owner's balance through fees)
-		return nil
+		return false, nil
 	}
 
-	wt.notifyNewDepositTransactionFound(transaction)
-	wt.accumulator.push(transaction.Receiver)
+	return true, nil
+}
+
+// Replay re-scans the hyper blocks in the provided nonces range, both ends included, and notifies the handler
+// about each deposit transaction found. It can be used for reconciliation as the last processed nonce is
+// not changed. The replay stops at the first error
+func (wt *walletTracker) Replay(ctx context.Context, fromNonce uint64, toNonce uint64) error {
+	if fromNonce > toNonce {
+		return fmt.Errorf("%w, fromNonce %d is greater than toNonce %d", ErrInvalidValue, fromNonce, toNonce)
+	}
+
+	wt.mutProcess.Lock()
+	defer wt.mutProcess.Unlock()
+
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		err := wt.fetchAndProcessHyperBlock(ctx, nonce, make(map[string]struct{}))
+		if err != nil {
+			return err
+		}
+		if nonce == toNonce {
+			// avoid the overflow when toNonce is the maximum uint64 value
+			break
+		}
+	}
 
 	return nil
 }
 
-func (wt *walletTracker) notifyNewDepositTransactionFound(transaction data.TransactionOnNetwork) {
+func (wt *walletTracker) notifyNewDepositTransactionFound(transaction data.TransactionOnNetwork) error {
 	wt.mutHandlers.RLock()
 	defer wt.mutHandlers.RUnlock()
 
 	if wt.handlerNewDepositTransaction != nil {
-		wt.handlerNewDepositTransaction(transaction)
+		return wt.handlerNewDepositTransaction(transaction)
 	}
+
+	return nil
 }
 
 // SetHandlerForNewDepositTransactionFound will set the handler that will get notified each time a new deposit
-// transaction is found on a hyper block
-func (wt *walletTracker) SetHandlerForNewDepositTransactionFound(handler func(tx data.TransactionOnNetwork)) {
+// transaction is found on a hyper block. If the handler returns an error, the hyper block is not marked as processed
+// and will be processed again on the next check, skipping the transactions already notified. The already notified
+// transactions are only kept in memory so, after an application restart, the handler might receive a transaction
+// again and should deduplicate the deposits by the transaction hash
+func (wt *walletTracker) SetHandlerForNewDepositTransactionFound(handler func(tx data.TransactionOnNetwork) error) {
 	if handler == nil {
 		return
 	}
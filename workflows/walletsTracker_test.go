@@ -0,0 +1,288 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockWalletTrackerArgs() WalletTrackerArgs {
+	return WalletTrackerArgs{
+		TrackableAddressesProvider: &testsCommon.TrackableAddressesProviderStub{
+			IsTrackableAddressesCalled: func(addressAsBech32 string) bool {
+				return addressAsBech32 == trackedAddress
+			},
+		},
+		Proxy:          &testsCommon.ProxyStub{},
+		NonceHandler:   &testsCommon.LastProcessedNonceHandlerStub{},
+		CheckInterval:  time.Hour,
+		MinimumBalance: big.NewInt(10),
+	}
+}
+
+func createWalletTrackerHyperBlocks() map[uint64]*data.HyperBlock {
+	return map[uint64]*data.HyperBlock{
+		1: {
+			Nonce: 1,
+			Transactions: []data.TransactionOnNetwork{
+				{Hash: "hash1", Receiver: trackedAddress, Value: "100"},
+				{Hash: "hash2", Receiver: otherAddress, Value: "100"},
+			},
+		},
+		2: {
+			Nonce: 2,
+			Transactions: []data.TransactionOnNetwork{
+				{Hash: "hash3", Receiver: trackedAddress, Value: "1"},
+				{Hash: "hash4", Receiver: trackedAddress, Value: "200"},
+				{Hash: "hash5", Receiver: trackedAddress, Value: "300"},
+			},
+		},
+	}
+}
+
+func createWalletTrackerProxy(blocks map[uint64]*data.HyperBlock) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return uint64(len(blocks)), nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			block, found := blocks[nonce]
+			if !found {
+				return nil, errors.New("missing block")
+			}
+
+			return block, nil
+		},
+	}
+}
+
+func TestNewWalletTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trackable addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.TrackableAddressesProvider = nil
+		wt, err := NewWalletTracker(args)
+		assert.Nil(t, wt)
+		assert.Equal(t, ErrNilTrackableAddressesProvider, err)
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.Proxy = nil
+		wt, err := NewWalletTracker(args)
+		assert.Nil(t, wt)
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil nonce handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.NonceHandler = nil
+		wt, err := NewWalletTracker(args)
+		assert.Nil(t, wt)
+		assert.Equal(t, ErrNilLastProcessedNonceHandler, err)
+	})
+	t.Run("nil minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.MinimumBalance = nil
+		wt, err := NewWalletTracker(args)
+		assert.Nil(t, wt)
+		assert.Equal(t, ErrNilMinimumBalance, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wt, err := NewWalletTracker(createMockWalletTrackerArgs())
+		assert.Nil(t, err)
+		assert.False(t, wt.IsInterfaceNil())
+
+		_ = wt.Close()
+	})
+}
+
+func TestWalletTracker_FetchAndProcessHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should notify the deposits and advance the processed nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.Proxy = createWalletTrackerProxy(createWalletTrackerHyperBlocks())
+		processedNonces := make([]uint64, 0)
+		args.NonceHandler = &testsCommon.LastProcessedNonceHandlerStub{
+			ProcessedNonceCalled: func(nonce uint64) {
+				processedNonces = append(processedNonces, nonce)
+			},
+		}
+		wt, _ := NewWalletTracker(args)
+		defer func() {
+			_ = wt.Close()
+		}()
+
+		notifiedHashes := make([]string, 0)
+		wt.SetHandlerForNewDepositTransactionFound(func(tx data.TransactionOnNetwork) error {
+			notifiedHashes = append(notifiedHashes, tx.Hash)
+			return nil
+		})
+
+		err := wt.fetchAndProcessHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash1", "hash4", "hash5"}, notifiedHashes)
+		assert.Equal(t, []uint64{1, 2}, processedNonces)
+		assert.Equal(t, []string{trackedAddress}, wt.GetLatestTrackedAddresses())
+	})
+	t.Run("handler error should not advance the processed nonce and should not notify twice", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.Proxy = createWalletTrackerProxy(createWalletTrackerHyperBlocks())
+		lastProcessedNonce := uint64(0)
+		args.NonceHandler = &testsCommon.LastProcessedNonceHandlerStub{
+			ProcessedNonceCalled: func(nonce uint64) {
+				lastProcessedNonce = nonce
+			},
+			GetLastProcessedNonceCalled: func() uint64 {
+				return lastProcessedNonce
+			},
+		}
+		wt, _ := NewWalletTracker(args)
+		defer func() {
+			_ = wt.Close()
+		}()
+
+		expectedErr := errors.New("expected error")
+		shouldFail := true
+		notifiedHashes := make([]string, 0)
+		wt.SetHandlerForNewDepositTransactionFound(func(tx data.TransactionOnNetwork) error {
+			if tx.Hash == "hash5" && shouldFail {
+				return expectedErr
+			}
+
+			notifiedHashes = append(notifiedHashes, tx.Hash)
+			return nil
+		})
+
+		err := wt.fetchAndProcessHyperBlocks(context.Background())
+		assert.True(t, errors.Is(err, ErrDepositHandlerFailed))
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Equal(t, uint64(1), lastProcessedNonce)
+		assert.Equal(t, []string{"hash1", "hash4"}, notifiedHashes)
+
+		err = wt.fetchAndProcessHyperBlocks(context.Background())
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Equal(t, uint64(1), lastProcessedNonce)
+		assert.Equal(t, []string{"hash1", "hash4"}, notifiedHashes)
+
+		shouldFail = false
+		err = wt.fetchAndProcessHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2), lastProcessedNonce)
+		assert.Equal(t, []string{"hash1", "hash4", "hash5"}, notifiedHashes)
+	})
+	t.Run("invalid transaction value should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		blocks := map[uint64]*data.HyperBlock{
+			1: {
+				Transactions: []data.TransactionOnNetwork{
+					{Hash: "hash1", Receiver: trackedAddress, Value: "invalid"},
+					{Hash: "hash2", Receiver: trackedAddress, Value: "100"},
+				},
+			},
+		}
+		args := createMockWalletTrackerArgs()
+		args.Proxy = createWalletTrackerProxy(blocks)
+		wt, _ := NewWalletTracker(args)
+		defer func() {
+			_ = wt.Close()
+		}()
+
+		notifiedHashes := make([]string, 0)
+		wt.SetHandlerForNewDepositTransactionFound(func(tx data.TransactionOnNetwork) error {
+			notifiedHashes = append(notifiedHashes, tx.Hash)
+			return nil
+		})
+
+		err := wt.fetchAndProcessHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash2"}, notifiedHashes)
+	})
+}
+
+func TestWalletTracker_Replay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		wt, _ := NewWalletTracker(createMockWalletTrackerArgs())
+		defer func() {
+			_ = wt.Close()
+		}()
+
+		err := wt.Replay(context.Background(), 2, 1)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("fetch error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.Proxy = createWalletTrackerProxy(createWalletTrackerHyperBlocks())
+		wt, _ := NewWalletTracker(args)
+		defer func() {
+			_ = wt.Close()
+		}()
+
+		err := wt.Replay(context.Background(), 2, 3)
+		assert.NotNil(t, err)
+	})
+	t.Run("should notify again without changing the processed nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.Proxy = createWalletTrackerProxy(createWalletTrackerHyperBlocks())
+		args.NonceHandler = &testsCommon.LastProcessedNonceHandlerStub{
+			ProcessedNonceCalled: func(nonce uint64) {
+				require.Fail(t, "should have not called ProcessedNonce")
+			},
+			GetLastProcessedNonceCalled: func() uint64 {
+				return 2
+			},
+		}
+		wt, _ := NewWalletTracker(args)
+		defer func() {
+			_ = wt.Close()
+		}()
+
+		notifiedHashes := make([]string, 0)
+		wt.SetHandlerForNewDepositTransactionFound(func(tx data.TransactionOnNetwork) error {
+			notifiedHashes = append(notifiedHashes, tx.Hash)
+			return nil
+		})
+
+		err := wt.fetchAndProcessHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, notifiedHashes)
+
+		err = wt.Replay(context.Background(), 1, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash1", "hash4", "hash5"}, notifiedHashes)
+
+		err = wt.Replay(context.Background(), 2, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash1", "hash4", "hash5", "hash4", "hash5"}, notifiedHashes)
+	})
+}